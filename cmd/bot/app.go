@@ -7,8 +7,9 @@ import (
 	"net/http"
 	"os"
 	"path/filepath"
-	"runtime/debug"
 	"strconv"
+	"strings"
+	"sync/atomic"
 	"time"
 
 	"github.com/brpaz/echozap"
@@ -17,16 +18,14 @@ import (
 	"github.com/go-faster/sdk/app"
 	"github.com/go-faster/sdk/zctx"
 	"github.com/go-redis/redis/v8"
-	"github.com/google/go-github/v42/github"
 	"github.com/gotd/contrib/oteltg"
-	tgredis "github.com/gotd/contrib/redis"
 	"github.com/gotd/td/bin"
 	"github.com/gotd/td/telegram"
 	"github.com/gotd/td/telegram/downloader"
 	"github.com/gotd/td/telegram/message"
-	"github.com/gotd/td/telegram/message/peer"
 	"github.com/gotd/td/telegram/message/styling"
 	"github.com/gotd/td/tg"
+	"github.com/jackc/pgx/v5/pgxpool"
 	"github.com/labstack/echo/v4"
 	"github.com/labstack/echo/v4/middleware"
 	"go.uber.org/multierr"
@@ -39,7 +38,10 @@ import (
 	"github.com/gotd/bot/internal/dispatch"
 	"github.com/gotd/bot/internal/docs"
 	"github.com/gotd/bot/internal/entdb"
+	"github.com/gotd/bot/internal/fileidreport"
+	"github.com/gotd/bot/internal/health"
 	"github.com/gotd/bot/internal/oas"
+	"github.com/gotd/bot/internal/secret"
 	"github.com/gotd/bot/internal/storage"
 	"github.com/gotd/bot/internal/tgmanager"
 )
@@ -58,12 +60,24 @@ type App struct {
 	mux     dispatch.MessageMux
 	bot     *dispatch.Bot
 
-	github  *github.Client
+	github  *githubApp
 	http    *http.Client
 	logger  *zap.Logger
 	cache   *redis.Client
+	pg      *pgxpool.Pool
 	manager *tgmanager.Manager
 	srv     *oas.Server
+	health  *health.Checker
+	build   iapp.BuildInfo
+	fileIDs *fileidreport.Report
+	metrics *iapp.Metrics
+	dd      *downloader.Downloader
+	media   iapp.Middleware
+	// commands is per-command statistics reported by /stat.
+	commands *iapp.CommandStats
+
+	// ready is set while bot is authorized.
+	ready atomic.Bool
 }
 
 func InitApp(m *app.Telemetry, mm *iapp.Metrics, logger *zap.Logger) (_ *App, rerr error) {
@@ -88,10 +102,6 @@ func InitApp(m *app.Telemetry, mm *iapp.Metrics, logger *zap.Logger) (_ *App, re
 		return nil, errors.New("no BOT_TOKEN provided")
 	}
 
-	r := redis.NewClient(&redis.Options{
-		Addr: "redis:6379",
-	})
-
 	// Setting up session storage.
 	home, err := os.UserHomeDir()
 	if err != nil {
@@ -116,10 +126,26 @@ func InitApp(m *app.Telemetry, mm *iapp.Metrics, logger *zap.Logger) (_ *App, re
 	}()
 	msgIDStore := storage.NewMsgID(db)
 
+	box, err := setupSecret()
+	if err != nil {
+		return nil, errors.Wrap(err, "setup secret")
+	}
+	edb, err := entdb.Open(os.Getenv("DATABASE_URL"))
+	if err != nil {
+		return nil, errors.Wrap(err, "open database")
+	}
+	sessionStorage, r, err := setupSessionStorage(edb, token)
+	if err != nil {
+		return nil, errors.Wrap(err, "setup session storage")
+	}
+	if box != nil {
+		sessionStorage = secret.NewSessionStorage(sessionStorage, box)
+	}
+
 	dispatcher := tg.NewUpdateDispatcher()
 	client := telegram.NewClient(appID, appHash, telegram.Options{
 		Logger:         logger.Named("client"),
-		SessionStorage: tgredis.NewSessionStorage(r, "gotd_bot_session"),
+		SessionStorage: sessionStorage,
 		UpdateHandler:  dispatcher,
 		Middlewares: []telegram.Middleware{
 			telegram.MiddlewareFunc(func(next tg.Invoker) telegram.InvokeFunc {
@@ -139,14 +165,41 @@ func InitApp(m *app.Telemetry, mm *iapp.Metrics, logger *zap.Logger) (_ *App, re
 		Timeout:   15 * time.Second,
 	}
 
-	mux := dispatch.NewMessageMux()
-	var h dispatch.MessageHandler = iapp.NewMiddleware(mux, dd, mm, iapp.MiddlewareOptions{
+	fileIDs := fileidreport.New(edb, fileidreport.Options{
+		Alert: func(ctx context.Context, text string) error {
+			return deployNotify(ctx, sender, styling.Plain(text))
+		},
+		Logger: logger.Named("fileid"),
+	})
+
+	var mirrorChat int64
+	if v, ok := os.LookupEnv("BOTAPI_MIRROR_CHAT"); ok {
+		mirrorChat, err = strconv.ParseInt(v, 10, 64)
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid BOTAPI_MIRROR_CHAT %q", v)
+		}
+	}
+
+	_, replyDiffs := os.LookupEnv("BOTAPI_REPLY_DIFFS")
+
+	commands := iapp.NewCommandStats()
+	mux, err := dispatch.NewMessageMux().
+		WithObserver(commands).
+		WithTelemetry(m.MeterProvider(), m.TracerProvider())
+	if err != nil {
+		return nil, errors.Wrap(err, "mux telemetry")
+	}
+	media := iapp.NewMiddleware(mux, dd, mm, iapp.MiddlewareOptions{
 		BotAPI: botapi.NewClient(token, botapi.Options{
 			HTTPClient: httpClient,
+			BaseURL:    os.Getenv("BOTAPI_URL"),
 		}),
-		Logger: logger.Named("metrics"),
+		MirrorChat:     mirrorChat,
+		ReplyDiffs:     replyDiffs,
+		FileIDReporter: fileIDs,
+		Logger:         logger.Named("metrics"),
 	})
-	h = storage.NewHook(h, msgIDStore)
+	var h dispatch.MessageHandler = storage.NewHook(media, msgIDStore)
 
 	b := dispatch.NewBot(raw).
 		WithSender(sender).
@@ -154,23 +207,33 @@ func InitApp(m *app.Telemetry, mm *iapp.Metrics, logger *zap.Logger) (_ *App, re
 		Register(dispatcher).
 		OnMessage(h)
 
-	edb, err := entdb.Open(os.Getenv("DATABASE_URL"))
+	pg, err := pgxpool.New(context.Background(), os.Getenv("DATABASE_URL"))
 	if err != nil {
-		return nil, errors.Wrap(err, "open database")
+		return nil, errors.Wrap(err, "open postgres pool")
 	}
-	manager, err := tgmanager.NewManager(logger.Named("tgmanager"), edb, m.MeterProvider(), m.TracerProvider())
-	if err != nil {
-		return nil, errors.Wrap(err, "manager")
+	defer func() {
+		if rerr != nil {
+			pg.Close()
+		}
+	}()
+	var gh *githubApp
+	if v, ok := os.LookupEnv("GITHUB_APP_ID"); ok {
+		gh, err = setupGithub(v, httpTransport)
+		if err != nil {
+			return nil, errors.Wrap(err, "setup github")
+		}
 	}
-	handler := api.NewHandler(manager)
-	srv, err := oas.NewServer(handler, handler,
-		oas.WithTracerProvider(m.TracerProvider()),
-		oas.WithMeterProvider(m.MeterProvider()),
-	)
+	managerOpts := tgmanager.Options{
+		Postgres: pg,
+		Secret:   box,
+	}
+	if gh != nil {
+		managerOpts.WorkflowRuns = newWorkflowRuns(gh)
+	}
+	manager, err := tgmanager.NewManager(logger.Named("tgmanager"), edb, m.MeterProvider(), m.TracerProvider(), managerOpts)
 	if err != nil {
-		return nil, errors.Wrap(err, "oas")
+		return nil, errors.Wrap(err, "manager")
 	}
-
 	a := &App{
 		manager:    manager,
 		client:     client,
@@ -185,7 +248,28 @@ func InitApp(m *app.Telemetry, mm *iapp.Metrics, logger *zap.Logger) (_ *App, re
 		http:       httpClient,
 		logger:     logger,
 		cache:      r,
-		srv:        srv,
+		pg:         pg,
+		github:     gh,
+		build:      iapp.GetBuildInfo(),
+		fileIDs:    fileIDs,
+		metrics:    mm,
+		media:      media,
+		commands:   commands,
+		dd:         dd,
+	}
+	a.health = health.New(a.checks()...)
+
+	handler := api.NewHandler(manager, api.Options{
+		AdminToken: os.Getenv("ADMIN_TOKEN"),
+		Health:     a.health,
+		Build:      a.build,
+	})
+	a.srv, err = oas.NewServer(handler, handler,
+		oas.WithTracerProvider(m.TracerProvider()),
+		oas.WithMeterProvider(m.MeterProvider()),
+	)
+	if err != nil {
+		return nil, errors.Wrap(err, "oas")
 	}
 
 	if schemaPath, ok := os.LookupEnv("SCHEMA_PATH"); ok {
@@ -197,19 +281,15 @@ func InitApp(m *app.Telemetry, mm *iapp.Metrics, logger *zap.Logger) (_ *App, re
 		b.OnInline(docs.New(search))
 	}
 
-	if v, ok := os.LookupEnv("GITHUB_APP_ID"); ok {
-		ghClient, err := setupGithub(v, httpTransport)
-		if err != nil {
-			return nil, errors.Wrap(err, "setup github")
-		}
-		a.github = ghClient
-	}
-
 	return a, nil
 }
 
 func (b *App) Close() error {
+	b.pg.Close()
 	err := b.db.Close()
+	if b.cache != nil {
+		err = multierr.Append(err, b.cache.Close())
+	}
 	if b.index != nil {
 		err = multierr.Append(err, b.index.Close())
 	}
@@ -221,6 +301,12 @@ func (b *App) Run(ctx context.Context) error {
 	group.Go(func() error {
 		return b.manager.Run(ctx)
 	})
+	group.Go(func() error {
+		return b.media.Run(ctx)
+	})
+	group.Go(func() error {
+		return b.fileIDs.Run(ctx)
+	})
 
 	httpAddr := os.Getenv("HTTP_ADDR")
 	if httpAddr == "" {
@@ -239,7 +325,17 @@ func (b *App) Run(ctx context.Context) error {
 		return c.String(http.StatusOK, "ok")
 	})
 	e.GET("/probe/ready", func(c echo.Context) error {
-		return c.String(http.StatusOK, "ok")
+		results, ok := b.health.Run(c.Request().Context())
+		if ok {
+			return c.String(http.StatusOK, "ok")
+		}
+		var sb strings.Builder
+		for _, r := range results {
+			if r.Err != nil {
+				fmt.Fprintf(&sb, "%s: %s\n", r.Name, r.Err)
+			}
+		}
+		return c.String(http.StatusServiceUnavailable, sb.String())
 	})
 
 	e.GET("/status", func(c echo.Context) error {
@@ -294,36 +390,23 @@ func (b *App) Run(ctx context.Context) error {
 				)
 			}
 
+			b.ready.Store(true)
+			defer b.ready.Store(false)
+
 			if _, disableRegister := os.LookupEnv("DISABLE_COMMAND_REGISTER"); !disableRegister {
 				if err := b.mux.RegisterCommands(ctx, b.raw); err != nil {
 					return errors.Wrap(err, "register commands")
 				}
 			}
 
-			if deployNotify := os.Getenv("TG_DEPLOY_NOTIFY_GROUP"); deployNotify != "" {
-				p, err := b.sender.ResolveDomain(deployNotify, peer.OnlyChannel).AsInputPeer(ctx)
-				if err != nil {
-					return errors.Wrap(err, "resolve")
-				}
-				info, _ := debug.ReadBuildInfo()
-				var commit string
-				for _, c := range info.Settings {
-					switch c.Key {
-					case "vcs.revision":
-						commit = c.Value[:7]
-					}
-				}
-				var options []message.StyledTextOption
-				options = append(options,
-					styling.Plain("🚀 Started "),
-					styling.Italic(fmt.Sprintf("(%s, %s, layer: %d) ",
-						info.GoVersion, iapp.GetVersion(), tg.Layer),
-					),
-					styling.Code(commit),
-				)
-				if _, err := b.sender.To(p).StyledText(ctx, options...); err != nil {
-					return errors.Wrap(err, "send")
-				}
+			if err := deployNotify(ctx, b.sender,
+				styling.Plain("🚀 Started "),
+				styling.Italic(fmt.Sprintf("(%s, %s, layer: %d) ",
+					b.build.GoVersion, b.build.Version, tg.Layer),
+				),
+				styling.Code(b.build.Commit),
+			); err != nil {
+				return errors.Wrap(err, "deploy notify")
 			}
 
 			b.logger.Info("Bot started")
@@ -2,9 +2,14 @@ package main
 
 import (
 	"context"
+	"os"
+	"strconv"
+
+	"github.com/go-faster/errors"
 
 	"github.com/gotd/bot/internal/app"
 	"github.com/gotd/bot/internal/dispatch"
+	"github.com/gotd/bot/internal/echo"
 	"github.com/gotd/bot/internal/inspect"
 )
 
@@ -46,6 +51,37 @@ func setupBot(a *App) error {
 
 	a.mux.Handle("/pp", "Pretty print replied message", inspect.Pretty())
 	a.mux.Handle("/json", "Print JSON of replied message", inspect.JSON())
-	a.mux.Handle("/stat", "Version", app.NewHandler())
+	a.mux.Handle("/stat", "Bot statistics", app.NewHandler(app.HandlerOptions{
+		Build:    a.build,
+		Metrics:  a.metrics,
+		Commands: a.commands,
+		Accounts: a.manager,
+		Client:   a.client,
+	}))
+	a.mux.Handle("/fileid", "file_id compatibility report", a.fileIDs)
+
+	echoOpts := echo.Options{
+		Throughput: a.metrics.UploadThroughput,
+		Logger:     a.logger.Named("echo"),
+	}
+	if v, ok := os.LookupEnv("UPLOAD_PART_SIZE"); ok {
+		partSize, err := strconv.Atoi(v)
+		if err != nil {
+			return errors.Wrapf(err, "invalid UPLOAD_PART_SIZE %q", v)
+		}
+		echoOpts.PartSize = partSize
+	}
+	if v, ok := os.LookupEnv("UPLOAD_THREADS"); ok {
+		threads, err := strconv.Atoi(v)
+		if err != nil {
+			return errors.Wrapf(err, "invalid UPLOAD_THREADS %q", v)
+		}
+		echoOpts.Threads = threads
+	}
+	h, err := echo.New(a.dd, echoOpts)
+	if err != nil {
+		return errors.Wrap(err, "echo")
+	}
+	a.mux.Handle("/echo", "Upload replied media back", h)
 	return nil
 }
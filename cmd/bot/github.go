@@ -1,17 +1,29 @@
 package main
 
 import (
+	"context"
 	"encoding/base64"
 	"net/http"
 	"os"
 	"strconv"
+	"sync"
 
 	"github.com/bradleyfalzon/ghinstallation"
 	"github.com/go-faster/errors"
 	"github.com/google/go-github/v42/github"
+
+	"github.com/gotd/bot/internal/tgmanager"
 )
 
-func setupGithub(appID string, httpTransport http.RoundTripper) (*github.Client, error) {
+// githubApp is GitHub App client.
+type githubApp struct {
+	client    *github.Client
+	id        int64
+	key       []byte
+	transport http.RoundTripper
+}
+
+func setupGithub(appID string, httpTransport http.RoundTripper) (*githubApp, error) {
 	ghAppID, err := strconv.ParseInt(appID, 10, 64)
 	if err != nil {
 		return nil, errors.Wrap(err, "GITHUB_APP_ID is invalid")
@@ -24,7 +36,64 @@ func setupGithub(appID string, httpTransport http.RoundTripper) (*github.Client,
 	if err != nil {
 		return nil, errors.Wrap(err, "create github transport")
 	}
-	return github.NewClient(&http.Client{
-		Transport: ghTransport,
-	}), nil
+	return &githubApp{
+		client: github.NewClient(&http.Client{
+			Transport: ghTransport,
+		}),
+		id:        ghAppID,
+		key:       key,
+		transport: httpTransport,
+	}, nil
+}
+
+var _ tgmanager.WorkflowRuns = (*workflowRuns)(nil)
+
+// workflowRuns checks status of workflow runs using GitHub App installation
+// of repository owner.
+//
+// Tokens of workflow runs can't be used, they expire when job is completed.
+type workflowRuns struct {
+	app     *githubApp
+	mux     sync.Mutex
+	clients map[string]*github.Client // by owner
+}
+
+func newWorkflowRuns(app *githubApp) *workflowRuns {
+	return &workflowRuns{
+		app:     app,
+		clients: make(map[string]*github.Client),
+	}
+}
+
+func (w *workflowRuns) client(ctx context.Context, run tgmanager.WorkflowRun) (*github.Client, error) {
+	w.mux.Lock()
+	defer w.mux.Unlock()
+
+	if c, ok := w.clients[run.Owner]; ok {
+		return c, nil
+	}
+	inst, _, err := w.app.client.Apps.FindRepositoryInstallation(ctx, run.Owner, run.Repo)
+	if err != nil {
+		return nil, errors.Wrap(err, "find installation")
+	}
+	tr, err := ghinstallation.New(w.app.transport, w.app.id, inst.GetID(), w.app.key)
+	if err != nil {
+		return nil, errors.Wrap(err, "create installation transport")
+	}
+	c := github.NewClient(&http.Client{Transport: tr})
+	w.clients[run.Owner] = c
+	return c, nil
+}
+
+// Completed implements tgmanager.WorkflowRuns.
+func (w *workflowRuns) Completed(ctx context.Context, run tgmanager.WorkflowRun) (bool, error) {
+	c, err := w.client(ctx, run)
+	if err != nil {
+		return false, err
+	}
+	wr, _, err := c.Actions.GetWorkflowRunByID(ctx, run.Owner, run.Repo, run.ID)
+	if err != nil {
+		return false, errors.Wrap(err, "get workflow run")
+	}
+	return wr.GetStatus() == "completed", nil
 }
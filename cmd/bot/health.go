@@ -0,0 +1,56 @@
+package main
+
+import (
+	"context"
+
+	"github.com/cockroachdb/pebble"
+	"github.com/go-faster/errors"
+
+	"github.com/gotd/bot/internal/health"
+)
+
+// healthKey is Pebble key read by health check.
+var healthKey = []byte("health")
+
+// checks returns dependency checks of app.
+func (b *App) checks() []health.Check {
+	checks := []health.Check{
+		{
+			Name: "telegram",
+			Check: func(ctx context.Context) error {
+				if !b.ready.Load() {
+					return errors.New("bot is not authorized")
+				}
+				return b.client.Ping(ctx)
+			},
+		},
+		{
+			Name: "postgres",
+			Check: func(ctx context.Context) error {
+				return b.pg.Ping(ctx)
+			},
+		},
+		{
+			Name: "pebble",
+			Check: func(ctx context.Context) error {
+				_, closer, err := b.db.Get(healthKey)
+				if errors.Is(err, pebble.ErrNotFound) {
+					return nil
+				}
+				if err != nil {
+					return err
+				}
+				return closer.Close()
+			},
+		},
+	}
+	if b.cache != nil {
+		checks = append(checks, health.Check{
+			Name: "redis",
+			Check: func(ctx context.Context) error {
+				return b.cache.Ping(ctx).Err()
+			},
+		})
+	}
+	return checks
+}
@@ -2,11 +2,13 @@ package main
 
 import (
 	"context"
+	"os"
 	"runtime/debug"
 	"time"
 
 	"github.com/go-faster/errors"
 	"github.com/go-faster/sdk/app"
+	"go.opentelemetry.io/otel/metric"
 	"go.uber.org/zap"
 
 	iapp "github.com/gotd/bot/internal/app"
@@ -22,18 +24,60 @@ func main() {
 			lg.Info("Stopping")
 			<-time.After(time.Second)
 		}()
+		if len(os.Args) > 1 && os.Args[1] == "reencrypt" {
+			return runReencrypt(ctx, lg.Named("reencrypt"))
+		}
+
 		mx := &iapp.Metrics{}
 		{
 			var err error
 			meter := m.MeterProvider().Meter("gotd.bot")
-			if mx.Bytes, err = meter.Int64Counter("gotd.bot.bytes"); err != nil {
-				return errors.Wrap(err, "bytes")
+			for _, c := range []struct {
+				Name    string
+				Counter *metric.Int64Counter
+			}{
+				{"gotd.bot.bytes", &mx.Bytes},
+				{"gotd.bot.messages", &mx.Messages},
+				{"gotd.bot.responses", &mx.Responses},
+			} {
+				counter, err := meter.Int64Counter(c.Name)
+				if err != nil {
+					return errors.Wrap(err, c.Name)
+				}
+				// Reported by /stat.
+				*c.Counter = iapp.NewCounter(counter)
+			}
+			if mx.FileIDChecks, err = meter.Int64Counter("gotd.bot.file_id.checks",
+				metric.WithDescription("Results of file_id round-trip checks by file type"),
+			); err != nil {
+				return errors.Wrap(err, "file_id checks")
+			}
+			if mx.MessageDiffs, err = meter.Int64Counter("gotd.bot.message.diffs",
+				metric.WithDescription("Discrepancies and skipped fields in MTProto and BotAPI views of message by field"),
+			); err != nil {
+				return errors.Wrap(err, "message diffs")
+			}
+			if mx.DownloadChecks, err = meter.Int64Counter("gotd.bot.download.checks",
+				metric.WithDescription("Results of download verification by check"),
+			); err != nil {
+				return errors.Wrap(err, "download checks")
+			}
+			if mx.DownloadThroughput, err = meter.Float64Histogram("gotd.bot.download.throughput",
+				metric.WithDescription("Download throughput by DC"),
+				metric.WithUnit("By/s"),
+			); err != nil {
+				return errors.Wrap(err, "download throughput")
 			}
-			if mx.Messages, err = meter.Int64Counter("gotd.bot.messages"); err != nil {
-				return errors.Wrap(err, "messages")
+			if mx.DroppedJobs, err = meter.Int64Counter("gotd.bot.media.dropped",
+				metric.WithDescription("Dropped message processing jobs by reason"),
+			); err != nil {
+				return errors.Wrap(err, "dropped jobs")
 			}
-			if mx.Responses, err = meter.Int64Counter("gotd.bot.responses"); err != nil {
-				return errors.Wrap(err, "responses")
+			if mx.UploadThroughput, err = meter.Float64Histogram("gotd.bot.upload.throughput",
+				metric.WithDescription("Upload throughput by upload path"),
+				metric.WithUnit("By/s"),
+			); err != nil {
+				return errors.Wrap(err, "upload throughput")
 			}
 		}
 		return runBot(ctx, m, mx, lg.Named("bot"))
@@ -0,0 +1,27 @@
+package main
+
+import (
+	"context"
+	"os"
+
+	"github.com/go-faster/errors"
+	"github.com/gotd/td/telegram/message"
+	"github.com/gotd/td/telegram/message/peer"
+)
+
+// deployNotify sends message to TG_DEPLOY_NOTIFY_GROUP, if set.
+func deployNotify(ctx context.Context, sender *message.Sender, options ...message.StyledTextOption) error {
+	deployNotify := os.Getenv("TG_DEPLOY_NOTIFY_GROUP")
+	if deployNotify == "" {
+		return nil
+	}
+
+	p, err := sender.ResolveDomain(deployNotify, peer.OnlyChannel).AsInputPeer(ctx)
+	if err != nil {
+		return errors.Wrap(err, "resolve")
+	}
+	if _, err := sender.To(p).StyledText(ctx, options...); err != nil {
+		return errors.Wrap(err, "send")
+	}
+	return nil
+}
@@ -0,0 +1,56 @@
+package main
+
+import (
+	"context"
+	"os"
+
+	"github.com/go-faster/errors"
+	"go.uber.org/zap"
+
+	"github.com/gotd/bot/internal/entdb"
+	"github.com/gotd/bot/internal/secret"
+	"github.com/gotd/bot/internal/tgmanager"
+)
+
+// runReencrypt re-encrypts all stored sessions and passwords with
+// SECRET_KEY, decrypting ones sealed with SECRET_KEY_PREVIOUS.
+//
+// Run it after rotating SECRET_KEY, then previous keys can be removed.
+func runReencrypt(ctx context.Context, lg *zap.Logger) error {
+	box, err := setupSecret()
+	if err != nil {
+		return errors.Wrap(err, "setup secret")
+	}
+	if box == nil {
+		return errors.New("no SECRET_KEY provided")
+	}
+	lg.Info("Re-encrypting secrets", zap.String("key_id", box.KeyID()))
+
+	db, err := entdb.Open(os.Getenv("DATABASE_URL"))
+	if err != nil {
+		return errors.Wrap(err, "open database")
+	}
+	defer func() { _ = db.Close() }()
+
+	accounts, err := tgmanager.Reencrypt(ctx, lg, db, box)
+	if err != nil {
+		return errors.Wrap(err, "re-encrypt accounts")
+	}
+	lg.Info("Re-encrypted accounts", zap.Int("count", accounts))
+
+	storage, r, err := setupSessionStorage(db, os.Getenv("BOT_TOKEN"))
+	if err != nil {
+		return errors.Wrap(err, "setup session storage")
+	}
+	if r != nil {
+		defer func() { _ = r.Close() }()
+	}
+
+	rotated, err := secret.NewSessionStorage(storage, box).Rotate(ctx)
+	if err != nil {
+		return errors.Wrap(err, "re-encrypt bot session")
+	}
+	lg.Info("Re-encrypted bot session", zap.Bool("rotated", rotated))
+
+	return nil
+}
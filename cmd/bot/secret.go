@@ -0,0 +1,43 @@
+package main
+
+import (
+	"encoding/base64"
+	"os"
+	"strings"
+
+	"github.com/go-faster/errors"
+
+	"github.com/gotd/bot/internal/secret"
+)
+
+// setupSecret creates secret box from SECRET_KEY and optional
+// comma-separated SECRET_KEY_PREVIOUS keys that are still accepted for
+// decryption during rotation.
+//
+// Returns nil if SECRET_KEY is not set.
+func setupSecret() (*secret.Box, error) {
+	v, ok := os.LookupEnv("SECRET_KEY")
+	if !ok {
+		return nil, nil
+	}
+	key, err := base64.StdEncoding.DecodeString(v)
+	if err != nil {
+		return nil, errors.Wrap(err, "SECRET_KEY is invalid")
+	}
+	var previous [][]byte
+	for _, s := range strings.Split(os.Getenv("SECRET_KEY_PREVIOUS"), ",") {
+		if s = strings.TrimSpace(s); s == "" {
+			continue
+		}
+		k, err := base64.StdEncoding.DecodeString(s)
+		if err != nil {
+			return nil, errors.Wrap(err, "SECRET_KEY_PREVIOUS is invalid")
+		}
+		previous = append(previous, k)
+	}
+	box, err := secret.New(key, previous...)
+	if err != nil {
+		return nil, errors.Wrap(err, "SECRET_KEY is invalid")
+	}
+	return box, nil
+}
@@ -0,0 +1,57 @@
+package main
+
+import (
+	"os"
+
+	"github.com/go-faster/errors"
+	"github.com/go-redis/redis/v8"
+	"github.com/google/uuid"
+	tgredis "github.com/gotd/contrib/redis"
+	"github.com/gotd/td/session"
+
+	"github.com/gotd/bot/internal/ent"
+	"github.com/gotd/bot/internal/entdb"
+)
+
+// botSessionKey is Redis key of bot session.
+const botSessionKey = "gotd_bot_session"
+
+// sessionNamespace is namespace of session IDs derived from bot token.
+var sessionNamespace = uuid.MustParse("5f0c3b4e-4a43-4a4c-9a9d-8c6c3f2a9e11")
+
+// sessionID returns ID of bot session in database.
+//
+// Uses SESSION_ID if set, otherwise ID is derived from bot token, so
+// several bots can share one database.
+func sessionID(token string) (uuid.UUID, error) {
+	if v, ok := os.LookupEnv("SESSION_ID"); ok {
+		id, err := uuid.Parse(v)
+		if err != nil {
+			return uuid.Nil, errors.Wrap(err, "SESSION_ID is invalid")
+		}
+		return id, nil
+	}
+	return uuid.NewSHA1(sessionNamespace, []byte(tokHash(token))), nil
+}
+
+// setupSessionStorage creates bot session storage selected by
+// SESSION_STORAGE: "redis" (default) or "postgres".
+//
+// Returned Redis client is nil if Redis is not used.
+func setupSessionStorage(db *ent.Client, token string) (session.Storage, *redis.Client, error) {
+	switch kind := os.Getenv("SESSION_STORAGE"); kind {
+	case "", "redis":
+		r := redis.NewClient(&redis.Options{
+			Addr: "redis:6379",
+		})
+		return tgredis.NewSessionStorage(r, botSessionKey), r, nil
+	case "postgres":
+		id, err := sessionID(token)
+		if err != nil {
+			return nil, nil, err
+		}
+		return entdb.NewSessionStorage(db, id), nil, nil
+	default:
+		return nil, nil, errors.Errorf("unknown SESSION_STORAGE %q", kind)
+	}
+}
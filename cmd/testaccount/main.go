@@ -0,0 +1,127 @@
+// Command testaccount leases test account from gotd bot pool and runs
+// command with it.
+//
+// Lease is kept alive until command exits and then is released. Wrapped
+// command receives lease via environment, so testaccount.Acquire returns it.
+//
+// Usage:
+//
+//	testaccount [-session] [-production] -- go test ./...
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"os/exec"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"github.com/go-faster/errors"
+	"go.uber.org/zap"
+
+	"github.com/gotd/bot/pkg/testaccount"
+)
+
+const (
+	// releaseTimeout limits lease release after command exits.
+	releaseTimeout = time.Second * 30
+	// waitDelay is time given to command to exit after interrupt.
+	waitDelay = time.Second * 10
+)
+
+func run(ctx context.Context, lg *zap.Logger, args []string) (int, error) {
+	set := flag.NewFlagSet("testaccount", flag.ContinueOnError)
+	opts := testaccount.Options{Logger: lg}
+	set.StringVar(&opts.Server, "server", "", "URL of bot API")
+	set.BoolVar(&opts.Session, "session", false, "request pre-authorized session")
+	set.BoolVar(&opts.Production, "production", false, "request account on production DC")
+	set.DurationVar(&opts.AcquireTimeout, "acquire-timeout", 0, "timeout of waiting for free account")
+	if err := set.Parse(args); err != nil {
+		return 0, err
+	}
+	if set.NArg() == 0 {
+		return 0, errors.New("no command provided")
+	}
+
+	c, err := testaccount.NewClient(opts)
+	if err != nil {
+		return 0, errors.Wrap(err, "create client")
+	}
+	lease, err := c.Acquire(ctx)
+	if err != nil {
+		return 0, errors.Wrap(err, "acquire")
+	}
+	lg.Info("Account leased", zap.String("phone", lease.Phone))
+	defer func() {
+		ctx, cancel := context.WithTimeout(context.Background(), releaseTimeout)
+		defer cancel()
+		if err := lease.Release(ctx); err != nil {
+			lg.Error("Release failed", zap.Error(err))
+			return
+		}
+		lg.Info("Account released")
+	}()
+
+	dir, err := os.MkdirTemp("", "testaccount")
+	if err != nil {
+		return 0, errors.Wrap(err, "create temp dir")
+	}
+	defer func() { _ = os.RemoveAll(dir) }()
+	env, err := lease.Environ(dir)
+	if err != nil {
+		return 0, errors.Wrap(err, "lease environment")
+	}
+
+	// Stop command if lease is lost.
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	go func() {
+		select {
+		case <-lease.Done():
+			cancel()
+		case <-ctx.Done():
+		}
+	}()
+
+	cmd := exec.CommandContext(ctx, set.Arg(0), set.Args()[1:]...)
+	cmd.Stdin = os.Stdin
+	cmd.Stdout = os.Stdout
+	cmd.Stderr = os.Stderr
+	cmd.Env = append(os.Environ(), env...)
+	cmd.Cancel = func() error {
+		return cmd.Process.Signal(os.Interrupt)
+	}
+	cmd.WaitDelay = waitDelay
+
+	err = cmd.Run()
+	if lerr := lease.Err(); lerr != nil {
+		return 0, lerr
+	}
+	if exitErr, ok := errors.Into[*exec.ExitError](err); ok {
+		return exitErr.ExitCode(), nil
+	}
+	if err != nil {
+		return 0, errors.Wrap(err, "run command")
+	}
+	return 0, nil
+}
+
+func main() {
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	lg, err := zap.NewDevelopment()
+	if err != nil {
+		panic(err)
+	}
+
+	code, err := run(ctx, lg, os.Args[1:])
+	stop()
+	_ = lg.Sync()
+	if err != nil {
+		_, _ = fmt.Fprintf(os.Stderr, "error: %+v\n", err)
+		os.Exit(1)
+	}
+	os.Exit(code)
+}
@@ -5,72 +5,17 @@ import (
 	"os"
 	"strconv"
 	"testing"
-	"time"
 
-	"github.com/cenkalti/backoff/v4"
 	"github.com/go-faster/errors"
-	"github.com/google/uuid"
 	"github.com/gotd/td/telegram"
 	"github.com/gotd/td/telegram/auth"
 	"github.com/gotd/td/telegram/dcs"
-	"github.com/gotd/td/tg"
 	"github.com/stretchr/testify/require"
 	"go.uber.org/zap/zaptest"
 
-	"github.com/gotd/bot/internal/oas"
+	"github.com/gotd/bot/pkg/testaccount"
 )
 
-type securitySource struct{}
-
-func (s securitySource) TokenAuth(ctx context.Context, operationName oas.OperationName) (oas.TokenAuth, error) {
-	return oas.TokenAuth{
-		APIKey: os.Getenv("GITHUB_TOKEN"),
-	}, nil
-}
-
-// terminalAuth implements auth.UserAuthenticator prompting the terminal for
-// input.
-type codeAuth struct {
-	phone  string
-	token  uuid.UUID
-	client *oas.Client
-}
-
-func (codeAuth) SignUp(ctx context.Context) (auth.UserInfo, error) {
-	return auth.UserInfo{}, errors.New("not implemented")
-}
-
-func (codeAuth) AcceptTermsOfService(ctx context.Context, tos tg.HelpTermsOfService) error {
-	return &auth.SignUpRequired{TermsOfService: tos}
-}
-
-func (a codeAuth) Code(ctx context.Context, sentCode *tg.AuthSentCode) (string, error) {
-	bo := backoff.NewExponentialBackOff()
-	bo.MaxElapsedTime = time.Minute
-	bo.MaxInterval = time.Second
-
-	return backoff.RetryWithData(func() (string, error) {
-		res, err := a.client.ReceiveTelegramCode(ctx, oas.ReceiveTelegramCodeParams{
-			Token: a.token,
-		})
-		if err != nil {
-			return "", err
-		}
-		if res.Code.Value == "" {
-			return "", errors.New("no code")
-		}
-		return res.Code.Value, err
-	}, bo)
-}
-
-func (a codeAuth) Phone(_ context.Context) (string, error) {
-	return a.phone, nil
-}
-
-func (codeAuth) Password(_ context.Context) (string, error) {
-	return "", errors.New("password not supported")
-}
-
 func TestIntegration(t *testing.T) {
 	// Integration tests should be explicitly enabled,
 	// also should be in GitHub actions with token.
@@ -81,52 +26,35 @@ func TestIntegration(t *testing.T) {
 		t.Skip("E2E=1 not set")
 	}
 
-	jobID := os.Getenv("GITHUB_JOB_ID")
-	runID, _ := strconv.ParseInt(os.Getenv("GITHUB_RUN_ID"), 10, 64)
-	attempt, _ := strconv.Atoi(os.Getenv("GITHUB_RUN_ATTEMPT"))
+	// Pre-authorized session skips auth flow.
+	withSession, _ := strconv.ParseBool(os.Getenv("E2E_SESSION"))
 
 	ctx := context.Background()
-	client, err := oas.NewClient("https://bot.gotd.dev", securitySource{})
-	require.NoError(t, err)
-
-	bo := backoff.NewExponentialBackOff()
-	bo.MaxElapsedTime = time.Minute
-	bo.MaxInterval = time.Second
-
-	res, err := backoff.RetryNotifyWithData(func() (*oas.AcquireTelegramAccountOK, error) {
-		return client.AcquireTelegramAccount(ctx, &oas.AcquireTelegramAccountReq{
-			RepoOwner:  "gotd",
-			RepoName:   "bot",
-			RunID:      runID,
-			Job:        jobID,
-			RunAttempt: attempt,
-		})
-	}, bo, func(err error, duration time.Duration) {
-		t.Logf("Error: %v, retrying in %v", err, duration)
+	lg := zaptest.NewLogger(t)
+	client, err := testaccount.NewClient(testaccount.Options{
+		RepoOwner: "gotd",
+		RepoName:  "bot",
+		Job:       os.Getenv("GITHUB_JOB_ID"),
+		Session:   withSession,
+		Logger:    lg.Named("testaccount"),
 	})
 	require.NoError(t, err)
 
-	t.Logf("Acquired account: %v", res.AccountID)
+	lease, err := client.Acquire(ctx)
+	require.NoError(t, err)
+	t.Logf("Acquired account: %v", lease.Phone)
 	t.Cleanup(func() {
-		_ = client.HeartbeatTelegramAccount(ctx, oas.HeartbeatTelegramAccountParams{
-			Token:  res.Token,
-			Forget: oas.NewOptBool(true),
-		})
+		_ = lease.Release(ctx)
 	})
 
-	lg := zaptest.NewLogger(t)
-	au := codeAuth{
-		phone:  string(res.AccountID),
-		token:  res.Token,
-		client: client,
-	}
 	tgc := telegram.NewClient(17349, "344583e45741c457fe1862106095a5eb", telegram.Options{
-		DCList: dcs.Test(),
-		Logger: lg.Named("client"),
+		DCList:         dcs.Test(),
+		Logger:         lg.Named("client"),
+		SessionStorage: lease.SessionStorage(),
 	})
 	require.NoError(t, tgc.Run(ctx, func(ctx context.Context) error {
 		t.Log("Auth")
-		if err := tgc.Auth().IfNecessary(ctx, auth.NewFlow(au, auth.SendCodeOptions{})); err != nil {
+		if err := tgc.Auth().IfNecessary(ctx, auth.NewFlow(lease.Authenticator(), auth.SendCodeOptions{})); err != nil {
 			return errors.Wrap(err, "auth")
 		}
 		t.Log("Auth ok")
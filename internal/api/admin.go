@@ -0,0 +1,125 @@
+package api
+
+import (
+	"context"
+	"crypto/subtle"
+
+	"github.com/go-faster/errors"
+
+	"github.com/gotd/bot/internal/ent/telegramaccount"
+	"github.com/gotd/bot/internal/oas"
+	"github.com/gotd/bot/internal/tgmanager"
+)
+
+func (h Handler) HandleAdminAuth(ctx context.Context, operationName oas.OperationName, t oas.AdminAuth) (context.Context, error) {
+	if h.adminToken == "" {
+		return nil, errors.Wrap(errForbidden, "admin operations are disabled")
+	}
+	if subtle.ConstantTimeCompare([]byte(t.APIKey), []byte(h.adminToken)) != 1 {
+		return nil, errors.Wrap(errUnauthorized, "invalid admin token")
+	}
+	return ctx, nil
+}
+
+func convertAccount(info tgmanager.AccountInfo) oas.TelegramAccount {
+	r := oas.TelegramAccount{
+		ID:          oas.TelegramAccountID(info.ID),
+		State:       oas.TelegramAccountState(info.State),
+		Status:      info.Status,
+		Disabled:    info.Disabled,
+		Running:     info.Running,
+		Healthy:     info.Healthy,
+		Leased:      info.Leased,
+		HasSession:  info.SessionData != nil && len(*info.SessionData) > 0,
+		HasPassword: info.Password != nil && len(*info.Password) > 0,
+		DCList:      oas.TelegramDCList(info.DcList),
+	}
+	if info.Dc != nil {
+		r.DC.SetTo(*info.Dc)
+	}
+	if info.AppID != nil {
+		r.AppID.SetTo(*info.AppID)
+	}
+	if info.CodeAt != nil {
+		r.CodeAt.SetTo(*info.CodeAt)
+	}
+	if h := info.Health; !h.LastProbe.IsZero() {
+		r.LastProbeAt.SetTo(h.LastProbe)
+		if h.Err != nil {
+			r.ProbeError.SetTo(h.Err.Error())
+		}
+	}
+	if h := info.Health; !h.LastSuccess.IsZero() {
+		r.LastSuccessAt.SetTo(h.LastSuccess)
+		r.ProbeLatency.SetTo(h.Latency.Seconds())
+	}
+	return r
+}
+
+func (h Handler) ListTelegramAccounts(ctx context.Context) ([]oas.TelegramAccount, error) {
+	accounts, err := h.manager.Accounts(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "list accounts")
+	}
+	r := make([]oas.TelegramAccount, 0, len(accounts))
+	for _, acc := range accounts {
+		r = append(r, convertAccount(acc))
+	}
+	return r, nil
+}
+
+func (h Handler) AddTelegramAccount(ctx context.Context, req *oas.AddTelegramAccountReq) (*oas.TelegramAccount, error) {
+	info, err := h.manager.AddAccount(ctx, string(req.ID), req.Password.Value, tgmanager.AccountOptions{
+		DCList:  telegramaccount.DcList(req.DCList.Or(oas.TelegramDCListTest)),
+		DC:      req.DC.Value,
+		AppID:   req.AppID.Value,
+		AppHash: req.AppHash.Value,
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "add account")
+	}
+	r := convertAccount(info)
+	return &r, nil
+}
+
+func (h Handler) DeleteTelegramAccount(ctx context.Context, params oas.DeleteTelegramAccountParams) error {
+	if err := h.manager.DeleteAccount(ctx, string(params.ID)); err != nil {
+		return errors.Wrap(err, "delete account")
+	}
+	return nil
+}
+
+func (h Handler) DisableTelegramAccount(ctx context.Context, params oas.DisableTelegramAccountParams) (*oas.TelegramAccount, error) {
+	info, err := h.manager.SetDisabled(ctx, string(params.ID), true)
+	if err != nil {
+		return nil, errors.Wrap(err, "disable account")
+	}
+	r := convertAccount(info)
+	return &r, nil
+}
+
+func (h Handler) EnableTelegramAccount(ctx context.Context, params oas.EnableTelegramAccountParams) (*oas.TelegramAccount, error) {
+	info, err := h.manager.SetDisabled(ctx, string(params.ID), false)
+	if err != nil {
+		return nil, errors.Wrap(err, "enable account")
+	}
+	r := convertAccount(info)
+	return &r, nil
+}
+
+func (h Handler) ReloginTelegramAccount(ctx context.Context, params oas.ReloginTelegramAccountParams) (*oas.TelegramAccount, error) {
+	info, err := h.manager.Relogin(ctx, string(params.ID))
+	if err != nil {
+		return nil, errors.Wrap(err, "relogin account")
+	}
+	r := convertAccount(info)
+	return &r, nil
+}
+
+func (h Handler) ListTelegramServiceMessages(ctx context.Context, params oas.ListTelegramServiceMessagesParams) ([]oas.TelegramServiceMessage, error) {
+	msgs, err := h.manager.AccountMessages(ctx, string(params.ID), params.Limit.Or(100))
+	if err != nil {
+		return nil, errors.Wrap(err, "account messages")
+	}
+	return convertServiceMessages(msgs), nil
+}
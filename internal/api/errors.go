@@ -0,0 +1,77 @@
+package api
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/go-faster/errors"
+	"github.com/google/go-github/v42/github"
+	"github.com/ogen-go/ogen/ogenerrors"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/gotd/bot/internal/ent"
+	"github.com/gotd/bot/internal/oas"
+	"github.com/gotd/bot/internal/tgmanager"
+)
+
+var (
+	// errUnauthorized means that credentials are missing or invalid.
+	errUnauthorized = errors.New("unauthorized")
+	// errForbidden means that credentials are valid but not allowed to
+	// perform operation.
+	errForbidden = errors.New("forbidden")
+)
+
+// githubError wraps error of GitHub API, mapping auth failures.
+func githubError(err error, msg string) error {
+	var ghErr *github.ErrorResponse
+	if errors.As(err, &ghErr) && ghErr.Response != nil {
+		switch ghErr.Response.StatusCode {
+		case http.StatusUnauthorized:
+			return errors.Wrapf(errUnauthorized, "%s: %s", msg, err)
+		case http.StatusForbidden, http.StatusNotFound:
+			return errors.Wrapf(errForbidden, "%s: %s", msg, err)
+		}
+	}
+	return errors.Wrap(err, msg)
+}
+
+// errorStatus returns HTTP status code for error.
+func errorStatus(err error) int {
+	switch {
+	case errors.Is(err, errForbidden),
+		errors.Is(err, tgmanager.ErrLeaseHolder):
+		return http.StatusForbidden
+	case errors.Is(err, errUnauthorized):
+		return http.StatusUnauthorized
+	case errors.Is(err, tgmanager.ErrUnknownToken),
+		ent.IsNotFound(err):
+		return http.StatusNotFound
+	case errors.Is(err, tgmanager.ErrAllLeased),
+		ent.IsConstraintError(err):
+		return http.StatusConflict
+	case errors.Is(err, tgmanager.ErrNoLease):
+		return http.StatusServiceUnavailable
+	case errors.Is(err, tgmanager.ErrRateLimited):
+		return http.StatusTooManyRequests
+	}
+	if _, ok := errors.Into[*ogenerrors.SecurityError](err); ok {
+		// Missing or rejected credentials.
+		return http.StatusUnauthorized
+	}
+	return http.StatusInternalServerError
+}
+
+func (h Handler) NewError(ctx context.Context, err error) *oas.ErrorStatusCode {
+	r := &oas.ErrorStatusCode{
+		StatusCode: errorStatus(err),
+		Response: oas.Error{
+			ErrorMessage: err.Error(),
+		},
+	}
+	if sc := trace.SpanContextFromContext(ctx); sc.IsValid() {
+		r.Response.TraceID.SetTo(oas.TraceID(sc.TraceID().String()))
+		r.Response.SpanID.SetTo(oas.SpanID(sc.SpanID().String()))
+	}
+	return r
+}
@@ -0,0 +1,61 @@
+package api
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/go-faster/errors"
+	"github.com/google/go-github/v42/github"
+	"github.com/ogen-go/ogen/ogenerrors"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/gotd/bot/internal/oas"
+	"github.com/gotd/bot/internal/tgmanager"
+)
+
+func TestErrorStatus(t *testing.T) {
+	for _, tt := range []struct {
+		Name   string
+		Err    error
+		Status int
+	}{
+		{"Internal", errors.New("failed"), http.StatusInternalServerError},
+		{"UnknownToken", tgmanager.ErrUnknownToken, http.StatusNotFound},
+		{"AllLeased", errors.Wrap(tgmanager.ErrAllLeased, "acquire"), http.StatusConflict},
+		{"NoLease", errors.Wrap(tgmanager.ErrNoLease, "acquire"), http.StatusServiceUnavailable},
+		{"RateLimited", tgmanager.ErrRateLimited, http.StatusTooManyRequests},
+		{"LeaseHolder", tgmanager.ErrLeaseHolder, http.StatusForbidden},
+		{"Forbidden", errors.Wrap(errForbidden, "repo"), http.StatusForbidden},
+		{"Security", &ogenerrors.SecurityError{Err: errors.New("bad")}, http.StatusUnauthorized},
+		{"SecurityForbidden", &ogenerrors.SecurityError{Err: errForbidden}, http.StatusForbidden},
+		{"GitHub", githubError(&github.ErrorResponse{
+			Response: &http.Response{StatusCode: http.StatusNotFound},
+		}, "get repo"), http.StatusForbidden},
+	} {
+		t.Run(tt.Name, func(t *testing.T) {
+			require.Equal(t, tt.Status, errorStatus(tt.Err))
+		})
+	}
+}
+
+func TestHandler_NewError(t *testing.T) {
+	a := require.New(t)
+	var h Handler
+
+	r := h.NewError(context.Background(), tgmanager.ErrUnknownToken)
+	a.Equal(http.StatusNotFound, r.StatusCode)
+	a.Equal(tgmanager.ErrUnknownToken.Error(), r.Response.ErrorMessage)
+	a.False(r.Response.TraceID.Set)
+	a.False(r.Response.SpanID.Set)
+
+	sc := trace.NewSpanContext(trace.SpanContextConfig{
+		TraceID: trace.TraceID{1},
+		SpanID:  trace.SpanID{2},
+	})
+	ctx := trace.ContextWithSpanContext(context.Background(), sc)
+	r = h.NewError(ctx, errors.New("failed"))
+	a.Equal(oas.NewOptTraceID(oas.TraceID(sc.TraceID().String())), r.Response.TraceID)
+	a.Equal(oas.NewOptSpanID(oas.SpanID(sc.SpanID().String())), r.Response.SpanID)
+}
@@ -2,6 +2,9 @@ package api
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"time"
 
 	"github.com/go-faster/errors"
 	"github.com/go-faster/sdk/zctx"
@@ -9,16 +12,31 @@ import (
 	"go.uber.org/zap"
 	"golang.org/x/oauth2"
 
+	iapp "github.com/gotd/bot/internal/app"
+	"github.com/gotd/bot/internal/ent"
+	"github.com/gotd/bot/internal/ent/telegramaccount"
+	"github.com/gotd/bot/internal/health"
 	"github.com/gotd/bot/internal/oas"
 	"github.com/gotd/bot/internal/tgmanager"
 )
 
-func NewHandler(manager *tgmanager.Manager) *Handler {
-	return &Handler{manager: manager}
+func NewHandler(manager *tgmanager.Manager, opts Options) *Handler {
+	if opts.Health == nil {
+		opts.Health = health.New()
+	}
+	return &Handler{
+		manager:    manager,
+		adminToken: opts.AdminToken,
+		health:     opts.Health,
+		build:      opts.Build,
+	}
 }
 
 type Handler struct {
-	manager *tgmanager.Manager
+	manager    *tgmanager.Manager
+	adminToken string
+	health     *health.Checker
+	build      iapp.BuildInfo
 }
 
 func (h Handler) AcquireTelegramAccount(ctx context.Context, req *oas.AcquireTelegramAccountReq) (*oas.AcquireTelegramAccountOK, error) {
@@ -27,60 +45,124 @@ func (h Handler) AcquireTelegramAccount(ctx context.Context, req *oas.AcquireTel
 		return nil, errors.New("github client not found")
 	}
 	if req.RepoOwner != "gotd" {
-		return nil, errors.New("unsupported repo owner")
+		return nil, errors.Wrapf(errForbidden, "unsupported repo owner %q", req.RepoOwner)
 	}
 	repo, _, err := client.Repositories.Get(ctx, req.RepoOwner, req.RepoName)
 	if err != nil {
-		return nil, errors.Wrap(err, "get repo")
+		return nil, githubError(err, "get repo")
 	}
 	wr, _, err := client.Actions.GetWorkflowRunByID(ctx, req.RepoOwner, req.RepoName, req.RunID)
 	if err != nil {
-		return nil, errors.Wrap(err, "get job")
+		return nil, githubError(err, "get job")
 	}
 	zctx.From(ctx).Info("AcquireTelegramAccount",
 		zap.String("repo", repo.GetFullName()),
 		zap.String("run", wr.GetName()),
 	)
 
-	lease, err := h.manager.Acquire()
+	var (
+		opts = tgmanager.AcquireOptions{
+			Holder: holderFromContext(ctx),
+			DCList: telegramaccount.DcList(req.DCList.Or(oas.TelegramDCListTest)),
+			Run: &tgmanager.WorkflowRun{
+				Owner: req.RepoOwner,
+				Repo:  req.RepoName,
+				ID:    wr.GetID(),
+			},
+		}
+		lease *tgmanager.Lease
+	)
+	if req.Session.Value {
+		lease, err = h.manager.AcquireSession(ctx, opts)
+	} else {
+		lease, err = h.manager.Acquire(opts)
+	}
 	if err != nil {
 		return nil, errors.Wrap(err, "acquire")
 	}
 
-	return &oas.AcquireTelegramAccountOK{
+	res := &oas.AcquireTelegramAccountOK{
 		AccountID: oas.TelegramAccountID(lease.Account),
 		Token:     lease.Token,
-	}, nil
+	}
+	if s := lease.Session; s != nil {
+		res.Session.SetTo(oas.TelegramSession{
+			DC:        s.DC,
+			Addr:      s.Addr,
+			AuthKey:   s.AuthKey,
+			AuthKeyID: s.AuthKeyID,
+			Salt:      s.Salt,
+		})
+	}
+	return res, nil
 }
 
-type ghClient struct{}
+type (
+	ghClient    struct{}
+	leaseHolder struct{}
+)
+
+// holderFromContext returns lease holder identity of authenticated caller.
+func holderFromContext(ctx context.Context) string {
+	holder, _ := ctx.Value(leaseHolder{}).(string)
+	return holder
+}
 
 func (h Handler) HandleTokenAuth(ctx context.Context, operationName oas.OperationName, t oas.TokenAuth) (context.Context, error) {
+	if t.APIKey == "" {
+		return nil, errors.Wrap(errUnauthorized, "empty token")
+	}
 	ts := oauth2.StaticTokenSource(
 		&oauth2.Token{AccessToken: t.APIKey},
 	)
 	tc := oauth2.NewClient(ctx, ts)
 	client := github.NewClient(tc)
 	ctx = context.WithValue(ctx, ghClient{}, client)
+
+	// Do not keep raw token, hash is enough to match lease holder.
+	sum := sha256.Sum256([]byte(t.APIKey))
+	ctx = context.WithValue(ctx, leaseHolder{}, hex.EncodeToString(sum[:]))
 	return ctx, nil
 }
 
 func (h Handler) GetHealth(ctx context.Context) (*oas.Health, error) {
-	return &oas.Health{
-		Status: "ok",
-	}, nil
+	results, ok := h.health.Run(ctx)
+	r := &oas.Health{
+		Status:    "ok",
+		Version:   h.build.Version,
+		Commit:    h.build.Commit,
+		BuildDate: h.build.Date,
+		Checks:    make([]oas.HealthCheck, 0, len(results)),
+	}
+	if !ok {
+		r.Status = "degraded"
+	}
+	for _, result := range results {
+		c := oas.HealthCheck{
+			Name:     result.Name,
+			Ok:       result.Err == nil,
+			Duration: result.Duration.Seconds(),
+		}
+		if result.Err != nil {
+			c.Error.SetTo(result.Err.Error())
+		}
+		r.Checks = append(r.Checks, c)
+	}
+	return r, nil
 }
 
 func (h Handler) HeartbeatTelegramAccount(ctx context.Context, params oas.HeartbeatTelegramAccountParams) error {
+	holder := holderFromContext(ctx)
 	if params.Forget.Value {
-		h.manager.Forget(params.Token)
-		return nil
+		return h.manager.Forget(params.Token, holder)
 	}
-	return h.manager.Heartbeat(params.Token)
+	return h.manager.Heartbeat(params.Token, holder)
 }
 
 func (h Handler) ReceiveTelegramCode(ctx context.Context, params oas.ReceiveTelegramCodeParams) (*oas.ReceiveTelegramCodeOK, error) {
-	code, err := h.manager.LeaseCode(ctx, params.Token)
+	code, err := h.manager.LeaseCode(ctx, params.Token, holderFromContext(ctx),
+		time.Duration(params.Timeout.Or(0))*time.Second,
+	)
 	if err != nil {
 		return nil, errors.Wrap(err, "lease code")
 	}
@@ -91,13 +173,25 @@ func (h Handler) ReceiveTelegramCode(ctx context.Context, params oas.ReceiveTele
 	return &oas.ReceiveTelegramCodeOK{Code: rc}, nil
 }
 
-func (h Handler) NewError(ctx context.Context, err error) *oas.ErrorStatusCode {
-	return &oas.ErrorStatusCode{
-		StatusCode: 500,
-		Response: oas.Error{
-			ErrorMessage: err.Error(),
-		},
+func convertServiceMessages(msgs []*ent.TelegramServiceMessage) []oas.TelegramServiceMessage {
+	r := make([]oas.TelegramServiceMessage, 0, len(msgs))
+	for _, msg := range msgs {
+		r = append(r, oas.TelegramServiceMessage{
+			ID:   msg.MessageID,
+			Type: oas.TelegramServiceMessageType(msg.Type),
+			Text: msg.Text,
+			Date: msg.Date,
+		})
+	}
+	return r
+}
+
+func (h Handler) ListLeaseServiceMessages(ctx context.Context, params oas.ListLeaseServiceMessagesParams) ([]oas.TelegramServiceMessage, error) {
+	msgs, err := h.manager.LeaseMessages(ctx, params.Token, holderFromContext(ctx))
+	if err != nil {
+		return nil, errors.Wrap(err, "lease messages")
 	}
+	return convertServiceMessages(msgs), nil
 }
 
 var _ oas.Handler = &Handler{}
@@ -0,0 +1,18 @@
+package api
+
+import (
+	iapp "github.com/gotd/bot/internal/app"
+	"github.com/gotd/bot/internal/health"
+)
+
+// Options is Handler options.
+type Options struct {
+	// AdminToken is token required for admin operations.
+	//
+	// If empty, admin operations are disabled.
+	AdminToken string
+	// Health checks dependencies, optional.
+	Health *health.Checker
+	// Build is info of current build.
+	Build iapp.BuildInfo
+}
@@ -0,0 +1,98 @@
+package app
+
+import (
+	"context"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+)
+
+// commandSamples is count of latest latency samples kept per command.
+const commandSamples = 1024
+
+// CommandStat is statistics of single command.
+type CommandStat struct {
+	Command string
+	Count   int64
+	Errors  int64
+	// P50, P90 and P99 are latency percentiles of latest invocations.
+	P50 time.Duration
+	P90 time.Duration
+	P99 time.Duration
+}
+
+type commandStat struct {
+	count   int64
+	errors  int64
+	samples []time.Duration // ring buffer
+	next    int
+}
+
+// CommandStats collects per-command invocation counts and latencies.
+//
+// Implements dispatch.CommandObserver.
+type CommandStats struct {
+	mux      sync.Mutex
+	commands map[string]*commandStat
+}
+
+// NewCommandStats creates new CommandStats.
+func NewCommandStats() *CommandStats {
+	return &CommandStats{commands: map[string]*commandStat{}}
+}
+
+// ObserveCommand implements dispatch.CommandObserver.
+func (c *CommandStats) ObserveCommand(_ context.Context, command string, duration time.Duration, err error) {
+	c.mux.Lock()
+	defer c.mux.Unlock()
+
+	s, ok := c.commands[command]
+	if !ok {
+		s = &commandStat{}
+		c.commands[command] = s
+	}
+	s.count++
+	if err != nil {
+		s.errors++
+	}
+	if len(s.samples) < commandSamples {
+		s.samples = append(s.samples, duration)
+	} else {
+		s.samples[s.next] = duration
+	}
+	s.next = (s.next + 1) % commandSamples
+}
+
+// percentile returns p-th percentile of sorted samples.
+func percentile(sorted []time.Duration, p int) time.Duration {
+	if len(sorted) == 0 {
+		return 0
+	}
+	i := (len(sorted)*p + 99) / 100
+	return sorted[max(i-1, 0)]
+}
+
+// Stats returns statistics of all invoked commands sorted by command.
+func (c *CommandStats) Stats() []CommandStat {
+	c.mux.Lock()
+	defer c.mux.Unlock()
+
+	r := make([]CommandStat, 0, len(c.commands))
+	for command, s := range c.commands {
+		sorted := slices.Clone(s.samples)
+		slices.Sort(sorted)
+		r = append(r, CommandStat{
+			Command: command,
+			Count:   s.count,
+			Errors:  s.errors,
+			P50:     percentile(sorted, 50),
+			P90:     percentile(sorted, 90),
+			P99:     percentile(sorted, 99),
+		})
+	}
+	slices.SortFunc(r, func(a, b CommandStat) int {
+		return strings.Compare(a.Command, b.Command)
+	})
+	return r
+}
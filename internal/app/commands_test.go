@@ -0,0 +1,58 @@
+package app
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestCommandStats(t *testing.T) {
+	a := require.New(t)
+	ctx := context.Background()
+
+	c := NewCommandStats()
+	for i := 1; i <= 100; i++ {
+		c.ObserveCommand(ctx, "/stat", time.Duration(i)*time.Millisecond, nil)
+	}
+	c.ObserveCommand(ctx, "/echo", time.Second, errors.New("failed"))
+
+	a.Equal([]CommandStat{
+		{
+			Command: "/echo",
+			Count:   1,
+			Errors:  1,
+			P50:     time.Second,
+			P90:     time.Second,
+			P99:     time.Second,
+		},
+		{
+			Command: "/stat",
+			Count:   100,
+			P50:     50 * time.Millisecond,
+			P90:     90 * time.Millisecond,
+			P99:     99 * time.Millisecond,
+		},
+	}, c.Stats())
+}
+
+func TestCommandStatsSamples(t *testing.T) {
+	a := require.New(t)
+	ctx := context.Background()
+
+	c := NewCommandStats()
+	for range commandSamples {
+		c.ObserveCommand(ctx, "/stat", time.Second, nil)
+	}
+	// Oldest samples are replaced.
+	for range commandSamples {
+		c.ObserveCommand(ctx, "/stat", time.Millisecond, nil)
+	}
+
+	stats := c.Stats()
+	a.Len(stats, 1)
+	a.Equal(int64(2*commandSamples), stats[0].Count)
+	a.Equal(time.Millisecond, stats[0].P99)
+}
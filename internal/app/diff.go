@@ -0,0 +1,379 @@
+package app
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"unicode/utf16"
+
+	"github.com/gotd/td/tg"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+	"go.uber.org/zap"
+
+	"github.com/gotd/bot/internal/botapi"
+	"github.com/gotd/bot/internal/dispatch"
+)
+
+// messageDiff is discrepancy between MTProto and BotAPI views of message.
+type messageDiff struct {
+	Field   string
+	MTProto string
+	BotAPI  string
+}
+
+func (d messageDiff) String() string {
+	return fmt.Sprintf("%s: MTProto %q, BotAPI %q", d.Field, d.MTProto, d.BotAPI)
+}
+
+// entityType maps MTProto entity to BotAPI entity type.
+//
+// Entities which are not exposed by BotAPI are not listed.
+func entityType(e tg.MessageEntityClass) (string, bool) {
+	switch e := e.(type) {
+	case *tg.MessageEntityMention:
+		return "mention", true
+	case *tg.MessageEntityHashtag:
+		return "hashtag", true
+	case *tg.MessageEntityCashtag:
+		return "cashtag", true
+	case *tg.MessageEntityBotCommand:
+		return "bot_command", true
+	case *tg.MessageEntityURL:
+		return "url", true
+	case *tg.MessageEntityEmail:
+		return "email", true
+	case *tg.MessageEntityPhone:
+		return "phone_number", true
+	case *tg.MessageEntityBold:
+		return "bold", true
+	case *tg.MessageEntityItalic:
+		return "italic", true
+	case *tg.MessageEntityUnderline:
+		return "underline", true
+	case *tg.MessageEntityStrike:
+		return "strikethrough", true
+	case *tg.MessageEntitySpoiler:
+		return "spoiler", true
+	case *tg.MessageEntityBlockquote:
+		if e.Collapsed {
+			return "expandable_blockquote", true
+		}
+		return "blockquote", true
+	case *tg.MessageEntityCode:
+		return "code", true
+	case *tg.MessageEntityPre:
+		return "pre", true
+	case *tg.MessageEntityTextURL:
+		return "text_link", true
+	case *tg.MessageEntityMentionName:
+		return "text_mention", true
+	case *tg.MessageEntityCustomEmoji:
+		return "custom_emoji", true
+	default:
+		return "", false
+	}
+}
+
+func formatEntities(entities []string) string {
+	sort.Strings(entities)
+	return strings.Join(entities, ", ")
+}
+
+// diffEntities compares entities, offsets and lengths are in UTF-16 code units
+// in both APIs.
+func diffEntities(text string, entities []tg.MessageEntityClass, b []botapi.MessageEntity) []messageDiff {
+	var (
+		ours   []string
+		theirs []string
+		bounds []string
+		length = len(utf16.Encode([]rune(text)))
+	)
+	for _, e := range entities {
+		typ, ok := entityType(e)
+		if !ok {
+			continue
+		}
+		ours = append(ours, fmt.Sprintf("%s %d:%d", typ, e.GetOffset(), e.GetLength()))
+		if e.GetOffset() < 0 || e.GetLength() <= 0 || e.GetOffset()+e.GetLength() > length {
+			bounds = append(bounds, fmt.Sprintf("%s %d:%d", typ, e.GetOffset(), e.GetLength()))
+		}
+	}
+	for _, e := range b {
+		theirs = append(theirs, fmt.Sprintf("%s %d:%d", e.Type, e.Offset, e.Length))
+	}
+
+	var r []messageDiff
+	if a, b := formatEntities(ours), formatEntities(theirs); a != b {
+		r = append(r, messageDiff{Field: "entities", MTProto: a, BotAPI: b})
+	}
+	if len(bounds) > 0 {
+		r = append(r, messageDiff{
+			Field:   "entity_bounds",
+			MTProto: formatEntities(bounds),
+			BotAPI:  fmt.Sprintf("text length %d", length),
+		})
+	}
+	return r
+}
+
+// forwardOrigin returns canonical representation of MTProto forward header.
+func forwardOrigin(h tg.MessageFwdHeader) string {
+	switch from := h.FromID.(type) {
+	case *tg.PeerUser:
+		return fmt.Sprintf("user %d at %d", from.UserID, h.Date)
+	case *tg.PeerChannel:
+		if h.ChannelPost != 0 {
+			return fmt.Sprintf("channel %d/%d at %d", from.ChannelID, h.ChannelPost, h.Date)
+		}
+		return fmt.Sprintf("chat %d at %d", from.ChannelID, h.Date)
+	case *tg.PeerChat:
+		return fmt.Sprintf("chat %d at %d", from.ChatID, h.Date)
+	}
+	if h.FromName != "" {
+		return fmt.Sprintf("hidden user %q at %d", h.FromName, h.Date)
+	}
+	return fmt.Sprintf("unknown at %d", h.Date)
+}
+
+// botAPIPlainID returns MTProto ID from BotAPI chat ID.
+func botAPIPlainID(id int) int64 {
+	const channelShift = 1000000000000
+	switch {
+	case id <= -channelShift:
+		return int64(-id - channelShift)
+	case id < 0:
+		return int64(-id)
+	default:
+		return int64(id)
+	}
+}
+
+// botAPIForwardOrigin returns canonical representation of BotAPI forward origin.
+func botAPIForwardOrigin(o botapi.MessageOrigin) string {
+	switch {
+	case o.Type == "user" && o.SenderUser != nil:
+		return fmt.Sprintf("user %d at %d", o.SenderUser.ID, o.Date)
+	case o.Type == "channel" && o.Chat != nil:
+		return fmt.Sprintf("channel %d/%d at %d", botAPIPlainID(o.Chat.ID), o.MessageID, o.Date)
+	case o.Type == "chat" && o.SenderChat != nil:
+		return fmt.Sprintf("chat %d at %d", botAPIPlainID(o.SenderChat.ID), o.Date)
+	case o.Type == "hidden_user":
+		return fmt.Sprintf("hidden user %q at %d", o.SenderUserName, o.Date)
+	}
+	return fmt.Sprintf("unknown at %d", o.Date)
+}
+
+// documentKind returns BotAPI kind of document.
+func documentKind(doc *tg.Document) string {
+	kind := "document"
+	for _, attr := range doc.Attributes {
+		switch attr := attr.(type) {
+		case *tg.DocumentAttributeSticker:
+			return "sticker"
+		case *tg.DocumentAttributeAnimated:
+			kind = "animation"
+		case *tg.DocumentAttributeVideo:
+			if attr.RoundMessage {
+				return "video_note"
+			}
+			if kind != "animation" {
+				kind = "video"
+			}
+		case *tg.DocumentAttributeAudio:
+			if attr.Voice {
+				return "voice"
+			}
+			if kind == "document" {
+				kind = "audio"
+			}
+		}
+	}
+	return kind
+}
+
+// mediaSummary returns canonical representation of MTProto media.
+//
+// Empty string is returned for media which is not compared.
+func mediaSummary(media tg.MessageMediaClass) string {
+	switch media := media.(type) {
+	case *tg.MessageMediaPhoto:
+		p, ok := media.Photo.AsNotEmpty()
+		if !ok {
+			return ""
+		}
+		var maxW, maxH int
+		for _, size := range p.Sizes {
+			if _, ok := photoSizeType(size); !ok {
+				continue
+			}
+			if w, h := photoSizeDimensions(size); w*h > maxW*maxH {
+				maxW, maxH = w, h
+			}
+		}
+		return fmt.Sprintf("photo %dx%d", maxW, maxH)
+	case *tg.MessageMediaDocument:
+		doc, ok := media.Document.AsNotEmpty()
+		if !ok {
+			return ""
+		}
+		return fmt.Sprintf("%s %d bytes", documentKind(doc), doc.Size)
+	default:
+		return ""
+	}
+}
+
+// botAPIMediaSummary returns canonical representation of BotAPI media.
+func botAPIMediaSummary(msg botapi.Message) string {
+	switch {
+	case len(msg.Photo) > 0:
+		p := msg.Photo[len(msg.Photo)-1]
+		return fmt.Sprintf("photo %dx%d", p.Width, p.Height)
+	case msg.Sticker.FileID != "":
+		return fmt.Sprintf("sticker %d bytes", msg.Sticker.FileSize)
+	case msg.Animation.FileID != "":
+		return fmt.Sprintf("animation %d bytes", msg.Animation.FileSize)
+	case msg.VideoNote.FileID != "":
+		return fmt.Sprintf("video_note %d bytes", msg.VideoNote.FileSize)
+	case msg.Video.FileID != "":
+		return fmt.Sprintf("video %d bytes", msg.Video.FileSize)
+	case msg.Voice.FileID != "":
+		return fmt.Sprintf("voice %d bytes", msg.Voice.FileSize)
+	case msg.Audio.FileID != "":
+		return fmt.Sprintf("audio %d bytes", msg.Audio.FileSize)
+	case msg.Document.FileID != "":
+		return fmt.Sprintf("document %d bytes", msg.Document.FileSize)
+	default:
+		return ""
+	}
+}
+
+// Cross-check results.
+const (
+	diffMismatch = "mismatch"
+	diffSkipped  = "skipped"
+)
+
+// senderOrigin returns canonical representation of BotAPI forward origin of
+// message which is forwarded by bot, e.g. to mirror chat.
+func senderOrigin(msg *tg.Message) string {
+	if h, ok := msg.GetFwdFrom(); ok {
+		// Forward of forwarded message keeps original origin.
+		return forwardOrigin(h)
+	}
+	if channel, ok := msg.PeerID.(*tg.PeerChannel); ok && msg.Post {
+		return fmt.Sprintf("channel %d/%d at %d", channel.ChannelID, msg.ID, msg.Date)
+	}
+	from := msg.FromID
+	if from == nil {
+		// Private message or anonymous admin of group.
+		from = msg.PeerID
+	}
+	return forwardOrigin(tg.MessageFwdHeader{FromID: from, Date: msg.Date})
+}
+
+// diffMessage compares MTProto and BotAPI views of the same message and
+// returns discrepancies and fields which can't be compared.
+//
+// If mirrored is true, b is a copy of message forwarded by bot. Forwarded copy
+// has no reply, so reply_to is skipped, and forward origin of copy is compared
+// with original sender.
+func diffMessage(msg *tg.Message, b botapi.Message, mirrored bool) (r []messageDiff, skipped []string) {
+	text, entities := b.Text, b.Entities
+	if b.Caption != "" || len(b.CaptionEntities) > 0 {
+		text, entities = b.Caption, b.CaptionEntities
+	}
+	if msg.Message != text {
+		r = append(r, messageDiff{Field: "text", MTProto: msg.Message, BotAPI: text})
+	}
+	r = append(r, diffEntities(msg.Message, msg.Entities, entities)...)
+
+	if media := mediaSummary(msg.Media); media != "" {
+		if theirs := botAPIMediaSummary(b); media != theirs {
+			r = append(r, messageDiff{Field: "media", MTProto: media, BotAPI: theirs})
+		}
+	}
+
+	// Replies to other chats are exposed as external_reply and topic messages
+	// reply to topic root in BotAPI, skip them.
+	switch h, ok := msg.ReplyTo.(*tg.MessageReplyHeader); {
+	case mirrored:
+		if ok {
+			skipped = append(skipped, "reply_to")
+		}
+	case !b.IsTopicMessage && (!ok || h.ReplyToPeerID == nil):
+		var ours, theirs int
+		if ok {
+			ours = h.ReplyToMsgID
+		}
+		if b.ReplyToMessage != nil {
+			theirs = b.ReplyToMessage.MessageID
+		}
+		if ours != theirs {
+			r = append(r, messageDiff{
+				Field:   "reply_to",
+				MTProto: fmt.Sprint(ours),
+				BotAPI:  fmt.Sprint(theirs),
+			})
+		}
+	}
+
+	var ours, theirs string
+	h, forwarded := msg.GetFwdFrom()
+	switch {
+	case mirrored:
+		ours = senderOrigin(msg)
+	case forwarded:
+		ours = forwardOrigin(h)
+	}
+	if b.ForwardOrigin != nil {
+		theirs = botAPIForwardOrigin(*b.ForwardOrigin)
+	}
+	switch {
+	case mirrored && !forwarded && b.ForwardOrigin != nil && b.ForwardOrigin.Type == "hidden_user":
+		// Sender hides account in forwards.
+		skipped = append(skipped, "forward")
+	case ours != theirs:
+		r = append(r, messageDiff{Field: "forward", MTProto: ours, BotAPI: theirs})
+	}
+
+	return r, skipped
+}
+
+// crossCheck compares MTProto and BotAPI views of message and reports
+// discrepancies.
+func (m Middleware) crossCheck(ctx context.Context, e dispatch.MessageEvent, b botapi.Message) error {
+	diffs, skipped := diffMessage(e.Message, b, m.mirror != 0)
+	for _, field := range skipped {
+		m.metrics.MessageDiffs.Add(ctx, 1, metric.WithAttributes(
+			attribute.String("field", field),
+			attribute.String("result", diffSkipped),
+		))
+	}
+	if len(diffs) == 0 {
+		return nil
+	}
+
+	var w strings.Builder
+	w.WriteString("MTProto and BotAPI views differ:\n")
+	for _, d := range diffs {
+		m.metrics.MessageDiffs.Add(ctx, 1, metric.WithAttributes(
+			attribute.String("field", d.Field),
+			attribute.String("result", diffMismatch),
+		))
+		fmt.Fprintf(&w, "\n%s", d)
+	}
+	m.logger.Warn("Message views differ",
+		zap.Int("msg_id", e.Message.ID),
+		zap.Stringers("diffs", diffs),
+	)
+
+	if !m.replyDiffs {
+		return nil
+	}
+	if _, err := e.Reply().Text(ctx, w.String()); err != nil {
+		return err
+	}
+	return nil
+}
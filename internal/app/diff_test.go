@@ -0,0 +1,146 @@
+package app
+
+import (
+	"testing"
+
+	"github.com/gotd/td/tg"
+	"github.com/stretchr/testify/require"
+
+	"github.com/gotd/bot/internal/botapi"
+)
+
+func TestDiffMessage(t *testing.T) {
+	a := require.New(t)
+
+	// "👋 hello" is 8 UTF-16 code units, emoji is a surrogate pair.
+	msg := &tg.Message{
+		ID:      10,
+		Message: "👋 hello",
+		Entities: []tg.MessageEntityClass{
+			&tg.MessageEntityBold{Offset: 3, Length: 5},
+			&tg.MessageEntityBankCard{Offset: 0, Length: 2},
+		},
+		ReplyTo: &tg.MessageReplyHeader{ReplyToMsgID: 5},
+		FwdFrom: tg.MessageFwdHeader{
+			FromID: &tg.PeerChannel{ChannelID: 100},
+			Date:   42,
+		},
+		Media: &tg.MessageMediaDocument{Document: &tg.Document{
+			Size: 1024,
+			Attributes: []tg.DocumentAttributeClass{
+				&tg.DocumentAttributeAudio{Voice: true},
+			},
+		}},
+	}
+	msg.SetFlags()
+	b := botapi.Message{
+		MessageID: 10,
+		Caption:   "👋 hello",
+		CaptionEntities: []botapi.MessageEntity{
+			{Type: "bold", Offset: 3, Length: 5},
+		},
+		ReplyToMessage: &botapi.Message{MessageID: 5},
+		ForwardOrigin: &botapi.MessageOrigin{
+			Type:       "chat",
+			Date:       42,
+			SenderChat: &botapi.Chat{ID: -1000000000100},
+		},
+		Voice: botapi.Voice{FileID: "voice", FileSize: 1024},
+	}
+	diffs, skipped := diffMessage(msg, b, false)
+	a.Empty(diffs)
+	a.Empty(skipped)
+
+	b.CaptionEntities[0].Offset = 2
+	b.ReplyToMessage = nil
+	b.Voice.FileSize = 1000
+	diffs, skipped = diffMessage(msg, b, false)
+	a.Equal([]string{"entities", "media", "reply_to"}, diffFields(diffs))
+	a.Empty(skipped)
+}
+
+func diffFields(diffs []messageDiff) []string {
+	var fields []string
+	for _, d := range diffs {
+		fields = append(fields, d.Field)
+	}
+	return fields
+}
+
+func TestDiffMessage_Mirrored(t *testing.T) {
+	a := require.New(t)
+
+	msg := &tg.Message{
+		ID:      10,
+		Date:    42,
+		PeerID:  &tg.PeerChat{ChatID: 20},
+		FromID:  &tg.PeerUser{UserID: 1},
+		Message: "hello",
+		ReplyTo: &tg.MessageReplyHeader{ReplyToMsgID: 5},
+	}
+	msg.SetFlags()
+	// Forwarded copy has no reply and is attributed to original sender.
+	b := botapi.Message{
+		MessageID: 100,
+		Text:      "hello",
+		ForwardOrigin: &botapi.MessageOrigin{
+			Type:       "user",
+			Date:       42,
+			SenderUser: &botapi.User{ID: 1},
+		},
+	}
+	diffs, skipped := diffMessage(msg, b, true)
+	a.Empty(diffs)
+	a.Equal([]string{"reply_to"}, skipped)
+
+	b.ForwardOrigin.SenderUser.ID = 2
+	diffs, _ = diffMessage(msg, b, true)
+	a.Equal([]string{"forward"}, diffFields(diffs))
+
+	// Sender hides account in forwards.
+	b.ForwardOrigin = &botapi.MessageOrigin{Type: "hidden_user", Date: 42, SenderUserName: "Foo"}
+	diffs, skipped = diffMessage(msg, b, true)
+	a.Empty(diffs)
+	a.Equal([]string{"reply_to", "forward"}, skipped)
+
+	// Forwarded copy of forwarded message keeps original origin.
+	msg.FwdFrom = tg.MessageFwdHeader{FromID: &tg.PeerUser{UserID: 3}, Date: 10}
+	msg.SetFlags()
+	b.ForwardOrigin = &botapi.MessageOrigin{Type: "user", Date: 10, SenderUser: &botapi.User{ID: 3}}
+	diffs, _ = diffMessage(msg, b, true)
+	a.Empty(diffs)
+
+	// Channel post.
+	post := &tg.Message{
+		ID:      7,
+		Date:    42,
+		Post:    true,
+		PeerID:  &tg.PeerChannel{ChannelID: 100},
+		Message: "post",
+	}
+	b = botapi.Message{
+		Text: "post",
+		ForwardOrigin: &botapi.MessageOrigin{
+			Type:      "channel",
+			Date:      42,
+			Chat:      &botapi.Chat{ID: -1000000000100},
+			MessageID: 7,
+		},
+	}
+	diffs, skipped = diffMessage(post, b, true)
+	a.Empty(diffs)
+	a.Empty(skipped)
+}
+
+func TestDiffEntities_Bounds(t *testing.T) {
+	a := require.New(t)
+
+	diffs := diffEntities("👋", []tg.MessageEntityClass{
+		&tg.MessageEntityItalic{Offset: 0, Length: 3},
+	}, []botapi.MessageEntity{
+		{Type: "italic", Offset: 0, Length: 3},
+	})
+	a.Len(diffs, 1)
+	a.Equal("entity_bounds", diffs[0].Field)
+	a.Equal("text length 2", diffs[0].BotAPI)
+}
@@ -0,0 +1,301 @@
+package app
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/go-faster/errors"
+	"github.com/gotd/td/telegram/downloader"
+	"github.com/gotd/td/tg"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+	"go.uber.org/zap"
+)
+
+// Download check results.
+const (
+	downloadOK       = "ok"
+	downloadMismatch = "mismatch"
+	downloadError    = "error"
+	downloadDirect   = "direct"
+	downloadPartial  = "partial"
+)
+
+// errPartial is returned by limitWriter when limit is reached.
+var errPartial = errors.New("partial download")
+
+// limitWriter writes at most N bytes to W and then returns errPartial,
+// interrupting download.
+type limitWriter struct {
+	W io.Writer
+	N int64
+}
+
+func (l *limitWriter) Write(p []byte) (int, error) {
+	if l.N <= 0 {
+		return 0, errPartial
+	}
+	partial := int64(len(p)) > l.N
+	if partial {
+		p = p[:l.N]
+	}
+	n, err := l.W.Write(p)
+	l.N -= int64(n)
+	if err != nil {
+		return n, err
+	}
+	if partial {
+		return n, errPartial
+	}
+	return n, nil
+}
+
+// limitDownload wraps w to write at most limit bytes if limit is positive.
+func limitDownload(w io.Writer, limit int64) io.Writer {
+	if limit <= 0 {
+		return w
+	}
+	return &limitWriter{W: w, N: limit}
+}
+
+// preciseLimit is limit of precise ranged request.
+//
+// Offset is aligned to limit, so request never crosses 1MB boundary.
+const preciseLimit = 4096
+
+// rangeCheck is expected SHA-256 of file range, verified against
+// full download.
+type rangeCheck struct {
+	Name   string
+	Offset int64
+	Limit  int
+	Hash   []byte
+
+	data []byte
+}
+
+// verify reports whether recorded data matches expected hash.
+func (c *rangeCheck) verify() bool {
+	h := sha256.Sum256(c.data)
+	return bytes.Equal(h[:], c.Hash)
+}
+
+// rangeRecorder records ranges of written stream.
+type rangeRecorder struct {
+	offset int64
+	checks []*rangeCheck
+}
+
+func (r *rangeRecorder) Write(p []byte) (int, error) {
+	start, end := r.offset, r.offset+int64(len(p))
+	for _, c := range r.checks {
+		from := max(start, c.Offset)
+		to := min(end, c.Offset+int64(c.Limit))
+		if from < to {
+			c.data = append(c.data, p[from-start:to-start]...)
+		}
+	}
+	r.offset = end
+	return len(p), nil
+}
+
+// downloadTarget is a file to download.
+type downloadTarget struct {
+	Kind     string
+	DC       int
+	Size     int64
+	Location tg.InputFileLocationClass
+	// Verify enables verification using upload.getFileHashes.
+	Verify bool
+	// Limit is maximum count of bytes to download, zero means no limit.
+	Limit int64
+}
+
+// downloadSize returns count of bytes expected to be downloaded, zero if
+// unknown.
+func (t downloadTarget) downloadSize() int64 {
+	if t.Limit > 0 && t.Size > t.Limit {
+		return t.Limit
+	}
+	return t.Size
+}
+
+func (m Middleware) recordDownload(ctx context.Context, check, result string) {
+	m.metrics.DownloadChecks.Add(ctx, 1, metric.WithAttributes(
+		attribute.String("check", check),
+		attribute.String("result", result),
+	))
+}
+
+// preciseCheck requests small range of file using precise download.
+func (m Middleware) preciseCheck(ctx context.Context, rpc *tg.Client, t downloadTarget) (*rangeCheck, error) {
+	offset := (t.downloadSize() / 2) &^ (preciseLimit - 1)
+	req := &tg.UploadGetFileRequest{
+		Location: t.Location,
+		Offset:   offset,
+		Limit:    preciseLimit,
+	}
+	req.SetPrecise(true)
+
+	r, err := rpc.UploadGetFile(ctx, req)
+	if err != nil {
+		return nil, errors.Wrap(err, "get file")
+	}
+	f, ok := r.(*tg.UploadFile)
+	if !ok {
+		return nil, errors.Errorf("unexpected type %T", r)
+	}
+
+	h := sha256.Sum256(f.Bytes)
+	return &rangeCheck{
+		Name:   "precise",
+		Offset: offset,
+		Limit:  len(f.Bytes),
+		Hash:   h[:],
+	}, nil
+}
+
+// cdnHashesCheck requests file with CDN support and, if redirected, fetches
+// CDN file hashes from master DC. Hashes are verified against file downloaded
+// from master DC, so this checks that CDN hashes match original file.
+//
+// File is not downloaded through CDN: connecting to CDN DC is not supported
+// by gotd/td yet.
+func (m Middleware) cdnHashesCheck(ctx context.Context, rpc *tg.Client, t downloadTarget) ([]*rangeCheck, bool, error) {
+	req := &tg.UploadGetFileRequest{
+		Location: t.Location,
+		Limit:    preciseLimit,
+	}
+	req.SetCDNSupported(true)
+
+	r, err := rpc.UploadGetFile(ctx, req)
+	if err != nil {
+		return nil, false, errors.Wrap(err, "get file")
+	}
+	redirect, ok := r.(*tg.UploadFileCDNRedirect)
+	if !ok {
+		return nil, false, nil
+	}
+
+	hashes, err := rpc.UploadGetCDNFileHashes(ctx, &tg.UploadGetCDNFileHashesRequest{
+		FileToken: redirect.FileToken,
+	})
+	if err != nil {
+		return nil, true, errors.Wrap(err, "get CDN hashes")
+	}
+
+	checks := make([]*rangeCheck, 0, len(hashes))
+	for _, h := range hashes {
+		checks = append(checks, &rangeCheck{
+			Name:   "cdn_hashes",
+			Offset: h.Offset,
+			Limit:  h.Limit,
+			Hash:   h.Hash,
+		})
+	}
+	return checks, true, nil
+}
+
+// rangeChecks collects expected hashes of file ranges.
+func (m Middleware) rangeChecks(ctx context.Context, log *zap.Logger, rpc *tg.Client, t downloadTarget) []*rangeCheck {
+	var checks []*rangeCheck
+	if t.downloadSize() > 0 {
+		check, err := m.preciseCheck(ctx, rpc, t)
+		if err != nil {
+			m.recordDownload(ctx, "precise", downloadError)
+			log.Warn("Precise download", zap.Error(err))
+		} else {
+			checks = append(checks, check)
+		}
+	}
+
+	cdn, redirected, err := m.cdnHashesCheck(ctx, rpc, t)
+	switch {
+	case err != nil:
+		m.recordDownload(ctx, "cdn_hashes", downloadError)
+		log.Warn("CDN hashes", zap.Error(err))
+	case !redirected:
+		m.recordDownload(ctx, "cdn_hashes", downloadDirect)
+	default:
+		for _, c := range cdn {
+			// Skip ranges which are not downloaded.
+			if t.Limit > 0 && c.Offset+int64(c.Limit) > t.Limit {
+				continue
+			}
+			checks = append(checks, c)
+		}
+	}
+
+	return checks
+}
+
+func (m Middleware) downloadMedia(ctx context.Context, rpc *tg.Client, t downloadTarget) error {
+	log := m.logger.With(zap.String("kind", t.Kind), zap.Int("dc", t.DC))
+	checks := m.rangeChecks(ctx, log, rpc, t)
+
+	h := sha256.New()
+	w := &metricWriter{
+		Increase: func(n int64) int64 {
+			m.metrics.Bytes.Add(ctx, n)
+			return n
+		},
+	}
+
+	start := time.Now()
+	_, err := m.downloader.Download(rpc, t.Location).
+		WithVerify(t.Verify).
+		Stream(ctx, limitDownload(io.MultiWriter(h, w, &rangeRecorder{checks: checks}), t.Limit))
+	partial := errors.Is(err, errPartial)
+	if partial {
+		err = nil
+	}
+	if t.Verify {
+		switch {
+		case errors.Is(err, downloader.ErrHashMismatch):
+			m.recordDownload(ctx, "verify", downloadMismatch)
+		case err != nil:
+			m.recordDownload(ctx, "verify", downloadError)
+		default:
+			m.recordDownload(ctx, "verify", downloadOK)
+		}
+	}
+	if err != nil {
+		return errors.Wrap(err, "stream")
+	}
+
+	if partial {
+		m.recordDownload(ctx, "size", downloadPartial)
+	}
+	if seconds := time.Since(start).Seconds(); seconds > 0 {
+		m.metrics.DownloadThroughput.Record(ctx, float64(w.Bytes)/seconds, metric.WithAttributes(
+			attribute.Int("dc", t.DC),
+			attribute.String("kind", t.Kind),
+		))
+	}
+
+	for _, c := range checks {
+		if c.verify() {
+			m.recordDownload(ctx, c.Name, downloadOK)
+			continue
+		}
+		m.recordDownload(ctx, c.Name, downloadMismatch)
+		log.Warn("Downloaded range mismatch",
+			zap.String("check", c.Name),
+			zap.Int64("offset", c.Offset),
+			zap.Int("limit", c.Limit),
+			zap.Int("got", len(c.data)),
+		)
+	}
+
+	log.Info("Downloaded media",
+		zap.Int64("bytes", w.Bytes),
+		zap.Bool("partial", partial),
+		zap.String("sha256", fmt.Sprintf("%x", h.Sum(nil))),
+	)
+
+	return nil
+}
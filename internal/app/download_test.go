@@ -0,0 +1,98 @@
+package app
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"testing"
+
+	"github.com/gotd/td/bin"
+	"github.com/gotd/td/telegram/downloader"
+	"github.com/gotd/td/tg"
+	"github.com/gotd/td/tgmock"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"github.com/gotd/bot/internal/dispatch"
+)
+
+func TestRangeRecorder(t *testing.T) {
+	a := require.New(t)
+
+	data := []byte("0123456789abcdef")
+	expected := func(offset, limit int) *rangeCheck {
+		h := sha256.Sum256(data[offset : offset+limit])
+		return &rangeCheck{Offset: int64(offset), Limit: limit, Hash: h[:]}
+	}
+	checks := []*rangeCheck{
+		expected(0, 4),
+		expected(3, 8),
+		expected(12, 4),
+	}
+	wrong := &rangeCheck{Offset: 2, Limit: 2, Hash: checks[0].Hash}
+	checks = append(checks, wrong)
+
+	r := &rangeRecorder{checks: checks}
+	for _, chunk := range [][]byte{data[:5], data[5:6], data[6:]} {
+		n, err := r.Write(chunk)
+		a.NoError(err)
+		a.Equal(len(chunk), n)
+	}
+
+	for _, c := range checks[:3] {
+		a.True(c.verify(), "offset %d", c.Offset)
+	}
+	a.Equal([]byte("23"), wrong.data)
+	a.False(wrong.verify())
+}
+
+func TestLimitWriter(t *testing.T) {
+	a := require.New(t)
+
+	var buf bytes.Buffer
+	w := limitDownload(&buf, 5)
+
+	n, err := w.Write([]byte("012"))
+	a.NoError(err)
+	a.Equal(3, n)
+
+	n, err = w.Write([]byte("3456"))
+	a.ErrorIs(err, errPartial)
+	a.Equal(2, n)
+	a.Equal("01234", buf.String())
+
+	_, err = w.Write([]byte("7"))
+	a.ErrorIs(err, errPartial)
+
+	a.Equal(&buf, limitDownload(&buf, 0))
+}
+
+func TestMiddleware_rangeChecksCDN(t *testing.T) {
+	a := require.New(t)
+	ctx := context.Background()
+
+	token := []byte("token")
+	mock := tgmock.NewRequire(t)
+	mock.ExpectFunc(func(b bin.Encoder) {
+		req, ok := b.(*tg.UploadGetFileRequest)
+		a.True(ok)
+		a.True(req.CDNSupported)
+	}).ThenResult(&tg.UploadFileCDNRedirect{DCID: 203, FileToken: token}).
+		ExpectCall(&tg.UploadGetCDNFileHashesRequest{FileToken: token}).
+		ThenResult(&tg.FileHashVector{Elems: []tg.FileHash{
+			{Offset: 0, Limit: 10, Hash: []byte{1}},
+			{Offset: 10, Limit: 10, Hash: []byte{2}},
+		}})
+
+	m := NewMiddleware(dispatch.MessageHandlerFunc(func(ctx context.Context, e dispatch.MessageEvent) error {
+		return nil
+	}), downloader.NewDownloader(), testMetrics(t), MiddlewareOptions{})
+	checks := m.rangeChecks(ctx, zap.NewNop(), tg.NewClient(mock), downloadTarget{
+		Location: &tg.InputDocumentFileLocation{ID: 1},
+		// Second range is not downloaded.
+		Limit: 15,
+	})
+	a.Len(checks, 1)
+	a.Equal("cdn_hashes", checks[0].Name)
+	a.Equal([]byte{1}, checks[0].Hash)
+}
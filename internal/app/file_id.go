@@ -1,40 +1,390 @@
 package app
 
 import (
+	"bytes"
 	"context"
+	"strings"
+	"unicode/utf8"
 
 	"github.com/go-faster/errors"
+	"github.com/gotd/td/constant"
 	"github.com/gotd/td/fileid"
 	"github.com/gotd/td/tg"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+	"go.uber.org/zap"
 
 	"github.com/gotd/bot/internal/botapi"
+	"github.com/gotd/bot/internal/dispatch"
 )
 
-// checkOurFileID generates file_id and tries to use it in BotAPI.
-func (m Middleware) checkOurFileID(ctx context.Context, id fileid.FileID) error {
-	if m.client == nil {
+// File ID check results.
+const (
+	FileIDOK       = "ok"
+	FileIDEncode   = "encode_error"
+	FileIDRejected = "rejected"
+	FileIDMismatch = "mismatch"
+)
+
+// FileIDResult is a result of file_id check.
+type FileIDResult struct {
+	Type fileid.Type
+	DC   int
+	// FileID is file_id encoded by us.
+	FileID string
+	// BotAPI is file_id of the same file returned by BotAPI, if known.
+	BotAPI string
+	// Result is one of FileIDOK, FileIDEncode, FileIDRejected, FileIDMismatch.
+	Result string
+	Err    error
+}
+
+// FileIDReporter stores file_id check results.
+type FileIDReporter interface {
+	Report(ctx context.Context, r FileIDResult) error
+}
+
+// fileIDCheck is a file_id generated from MTProto object.
+type fileIDCheck struct {
+	ID fileid.FileID
+	// BotAPI is a file_id of the same file returned by BotAPI, if known.
+	BotAPI string
+}
+
+// photoSizeType returns thumbnail type of downloadable photo size.
+func photoSizeType(size tg.PhotoSizeClass) (rune, bool) {
+	switch size.(type) {
+	case *tg.PhotoSize, *tg.PhotoCachedSize, *tg.PhotoSizeProgressive:
+	default:
+		// Stripped and path sizes are embedded into object and have no file_id.
+		return 0, false
+	}
+
+	typ := size.GetType()
+	r, n := utf8.DecodeRuneInString(typ)
+	if r == utf8.RuneError || n != len(typ) {
+		return 0, false
+	}
+	return r, true
+}
+
+// photoSizeDimensions returns width and height of photo size.
+func photoSizeDimensions(size tg.PhotoSizeClass) (w, h int) {
+	if s, ok := size.(interface {
+		GetW() int
+		GetH() int
+	}); ok {
+		return s.GetW(), s.GetH()
+	}
+	return 0, 0
+}
+
+// findPhotoSize finds BotAPI photo size with given dimensions.
+func findPhotoSize(sizes []botapi.PhotoSize, w, h int) string {
+	for _, s := range sizes {
+		if s.Width == w && s.Height == h {
+			return s.FileID
+		}
+	}
+	return ""
+}
+
+// documentThumbnail creates FileID of document thumbnail.
+func documentThumbnail(doc *tg.Document, thumbType rune) fileid.FileID {
+	return fileid.FileID{
+		Type:          fileid.Thumbnail,
+		DC:            doc.DCID,
+		ID:            doc.ID,
+		AccessHash:    doc.AccessHash,
+		FileReference: doc.FileReference,
+		PhotoSizeSource: fileid.PhotoSizeSource{
+			Type:          fileid.PhotoSizeSourceThumbnail,
+			FileType:      fileid.Thumbnail,
+			ThumbnailType: thumbType,
+		},
+	}
+}
+
+// mediaFileIDs generates file_id for every file of message attachment.
+//
+// If msg is not nil, file_id returned by BotAPI for the same message is attached
+// to the check.
+func mediaFileIDs(media tg.MessageMediaClass, msg *botapi.Message) (r []fileIDCheck) {
+	switch media := media.(type) {
+	case *tg.MessageMediaDocument:
+		doc, ok := media.Document.AsNotEmpty()
+		if !ok {
+			return nil
+		}
+
+		check := fileIDCheck{ID: fileid.FromDocument(doc)}
+		var thumbs []botapi.PhotoSize
+		if msg != nil {
+			check.BotAPI, _ = botapi.GetFileIDFromMessage(*msg)
+			if thumb, ok := botapi.GetThumbnailFromMessage(*msg); ok {
+				thumbs = append(thumbs, thumb)
+			}
+		}
+		r = append(r, check)
+
+		for _, size := range doc.Thumbs {
+			thumbType, ok := photoSizeType(size)
+			if !ok {
+				continue
+			}
+			w, h := photoSizeDimensions(size)
+			r = append(r, fileIDCheck{
+				ID:     documentThumbnail(doc, thumbType),
+				BotAPI: findPhotoSize(thumbs, w, h),
+			})
+		}
+	case *tg.MessageMediaPhoto:
+		p, ok := media.Photo.AsNotEmpty()
+		if !ok {
+			return nil
+		}
+
+		var sizes []botapi.PhotoSize
+		if msg != nil {
+			sizes = msg.Photo
+		}
+		for _, size := range p.Sizes {
+			thumbType, ok := photoSizeType(size)
+			if !ok {
+				continue
+			}
+			w, h := photoSizeDimensions(size)
+			r = append(r, fileIDCheck{
+				ID:     fileid.FromPhoto(p, thumbType),
+				BotAPI: findPhotoSize(sizes, w, h),
+			})
+		}
+	}
+
+	return r
+}
+
+// peerPhoto returns TDLib peer ID, access hash and photo of event peer.
+func peerPhoto(e dispatch.MessageEvent) (id constant.TDLibPeerID, accessHash int64, _ fileid.ChatPhoto, _ bool) {
+	if user, ok := e.User(); ok {
+		photo, ok := user.Photo.(*tg.UserProfilePhoto)
+		if !ok {
+			return 0, 0, nil, false
+		}
+		id.User(user.ID)
+		return id, user.AccessHash, photo, true
+	}
+	if chat, ok := e.Chat(); ok {
+		photo, ok := chat.Photo.(*tg.ChatPhoto)
+		if !ok {
+			return 0, 0, nil, false
+		}
+		id.Chat(chat.ID)
+		return id, 0, photo, true
+	}
+	if channel, ok := e.Channel(); ok {
+		photo, ok := channel.Photo.(*tg.ChatPhoto)
+		if !ok {
+			return 0, 0, nil, false
+		}
+		id.Channel(channel.ID)
+		return id, channel.AccessHash, photo, true
+	}
+	return 0, 0, nil, false
+}
+
+// diffFileID returns names of fields which differ between a and b.
+func diffFileID(a, b fileid.FileID) (r []string) {
+	if a.Type != b.Type {
+		r = append(r, "type")
+	}
+	if a.DC != b.DC {
+		r = append(r, "dc")
+	}
+	if a.ID != b.ID {
+		r = append(r, "id")
+	}
+	if a.AccessHash != b.AccessHash {
+		r = append(r, "access_hash")
+	}
+	if !bytes.Equal(a.FileReference, b.FileReference) {
+		r = append(r, "file_reference")
+	}
+	if a.URL != b.URL {
+		r = append(r, "url")
+	}
+	if a.PhotoSizeSource != b.PhotoSizeSource {
+		r = append(r, "photo_size_source")
+	}
+	return r
+}
+
+// compareFileID compares our encoded file_id with file_id returned by BotAPI.
+func compareFileID(ours, theirs string) error {
+	if ours == theirs {
 		return nil
 	}
 
-	encoded, err := fileid.EncodeFileID(id)
+	their, err := fileid.DecodeFileID(theirs)
+	if err != nil {
+		return errors.Wrap(err, "decode BotAPI file_id")
+	}
+	our, err := fileid.DecodeFileID(ours)
+	if err != nil {
+		return errors.Wrap(err, "decode our file_id")
+	}
+
+	if diff := diffFileID(our, their); len(diff) > 0 {
+		return errors.Errorf("fields differ: %s", strings.Join(diff, ", "))
+	}
+	return errors.New("encoding differs")
+}
+
+// checkFileID generates file_id, tries to use it in BotAPI and compares it
+// with file_id from BotAPI, if any.
+func (m Middleware) checkFileID(ctx context.Context, check fileIDCheck) FileIDResult {
+	r := FileIDResult{
+		Type:   check.ID.Type,
+		DC:     check.ID.DC,
+		BotAPI: check.BotAPI,
+	}
+
+	encoded, err := fileid.EncodeFileID(check.ID)
 	if err != nil {
-		return errors.Wrap(err, "encode")
+		r.Result, r.Err = FileIDEncode, errors.Wrap(err, "encode")
+		return r
 	}
+	r.FileID = encoded
 
 	if err := m.client.GetFile(ctx, encoded); err != nil {
-		return errors.Wrap(err, "check file_id")
+		r.Result, r.Err = FileIDRejected, errors.Wrap(err, "check file_id")
+		return r
 	}
 
+	if check.BotAPI != "" {
+		if err := compareFileID(encoded, check.BotAPI); err != nil {
+			r.Result, r.Err = FileIDMismatch, errors.Wrap(err, "compare")
+			return r
+		}
+	}
+
+	r.Result = FileIDOK
+	return r
+}
+
+// checkFileIDs runs all given checks, records and reports results.
+func (m Middleware) checkFileIDs(ctx context.Context, log *zap.Logger, checks []fileIDCheck) {
+	for _, check := range checks {
+		r := m.checkFileID(ctx, check)
+		m.metrics.FileIDChecks.Add(ctx, 1, metric.WithAttributes(
+			attribute.String("type", r.Type.String()),
+			attribute.String("result", r.Result),
+		))
+		if r.Err != nil {
+			log.Warn("Test FileID",
+				zap.Stringer("type", r.Type),
+				zap.String("file_id", r.FileID),
+				zap.String("bot_api_file_id", r.BotAPI),
+				zap.Error(r.Err),
+			)
+		}
+		if m.reporter == nil {
+			continue
+		}
+		if err := m.reporter.Report(ctx, r); err != nil {
+			log.Warn("Report FileID", zap.Error(err))
+		}
+	}
+}
+
+// checkPeerPhoto checks file_id of event peer photo.
+//
+// Every photo is checked only once.
+func (m Middleware) checkPeerPhoto(ctx context.Context, e dispatch.MessageEvent) error {
+	id, accessHash, photo, ok := peerPhoto(e)
+	if !ok {
+		return nil
+	}
+	if _, loaded := m.photos.LoadOrStore(photo.GetPhotoID(), struct{}{}); loaded {
+		return nil
+	}
+
+	chat, err := m.client.GetChat(ctx, int64(id))
+	if err != nil {
+		// Retry on next message.
+		m.photos.Delete(photo.GetPhotoID())
+		return errors.Wrap(err, "get chat")
+	}
+	small := fileIDCheck{ID: fileid.FromChatPhoto(id, accessHash, photo, false)}
+	big := fileIDCheck{ID: fileid.FromChatPhoto(id, accessHash, photo, true)}
+	if chat.Photo != nil {
+		small.BotAPI = chat.Photo.SmallFileID
+		big.BotAPI = chat.Photo.BigFileID
+	}
+
+	m.checkFileIDs(ctx, m.logger.With(zap.Int64("peer_id", int64(id))), []fileIDCheck{small, big})
 	return nil
 }
 
-// tryGetFileID decodes file_id from BotAPI and tries to map into Telegram API file location.
-func (m Middleware) tryGetFileID(ctx context.Context, msgID int) (tg.InputFileLocationClass, string, error) {
-	botAPIMsg, err := m.client.GetBotAPIMessage(ctx, msgID)
+// botAPIChatID returns BotAPI chat ID of peer.
+func botAPIChatID(p tg.PeerClass) (int64, bool) {
+	var id constant.TDLibPeerID
+	switch p := p.(type) {
+	case *tg.PeerUser:
+		id.User(p.UserID)
+	case *tg.PeerChat:
+		id.Chat(p.ChatID)
+	case *tg.PeerChannel:
+		id.Channel(p.ChannelID)
+	default:
+		return 0, false
+	}
+	return int64(id), true
+}
+
+// hasFile reports whether media has file_id in BotAPI.
+func hasFile(media tg.MessageMediaClass) bool {
+	switch media := media.(type) {
+	case *tg.MessageMediaDocument:
+		if media.Document == nil {
+			return false
+		}
+		_, ok := media.Document.AsNotEmpty()
+		return ok
+	case *tg.MessageMediaPhoto:
+		if media.Photo == nil {
+			return false
+		}
+		_, ok := media.Photo.AsNotEmpty()
+		return ok
+	default:
+		return false
+	}
+}
+
+// botAPIMessage returns BotAPI representation of message.
+//
+// Message is forwarded to mirror chat, if set, so it should be called only
+// for messages with files (see hasFile) or text. Forward is used instead of
+// copyMessage, because copyMessage returns only message ID.
+func (m Middleware) botAPIMessage(ctx context.Context, msg *tg.Message) (botapi.Message, error) {
+	if m.mirror == 0 {
+		return m.client.GetBotAPIMessage(ctx, msg.ID)
+	}
+
+	from, ok := botAPIChatID(msg.PeerID)
+	if !ok {
+		return botapi.Message{}, errors.Errorf("unexpected peer %T", msg.PeerID)
+	}
+	fwd, err := m.client.ForwardMessage(ctx, m.mirror, from, msg.ID)
 	if err != nil {
-		return nil, "", errors.Wrap(err, "get message")
+		return botapi.Message{}, errors.Wrap(err, "forward")
 	}
+	return fwd, nil
+}
 
+// tryGetFileID decodes file_id from BotAPI and tries to map into Telegram API file location.
+func tryGetFileID(botAPIMsg botapi.Message) (tg.InputFileLocationClass, string, error) {
 	encoded, ok := botapi.GetFileIDFromMessage(botAPIMsg)
 	if !ok {
 		return nil, "", errors.New("no media in message")
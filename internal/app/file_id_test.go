@@ -0,0 +1,120 @@
+package app
+
+import (
+	"testing"
+
+	"github.com/gotd/td/fileid"
+	"github.com/gotd/td/tg"
+	"github.com/stretchr/testify/require"
+
+	"github.com/gotd/bot/internal/botapi"
+)
+
+func TestMaxSize(t *testing.T) {
+	a := require.New(t)
+
+	size, ok := maxSize([]tg.PhotoSizeClass{
+		&tg.PhotoStrippedSize{Type: "i"},
+		&tg.PhotoSize{Type: "m", W: 320, H: 180},
+		&tg.PhotoSizeProgressive{Type: "y", W: 1280, H: 720},
+		&tg.PhotoSize{Type: "x", W: 800, H: 450},
+		&tg.PhotoPathSize{Type: "j"},
+	})
+	a.True(ok)
+	a.Equal("y", size)
+
+	_, ok = maxSize([]tg.PhotoSizeClass{&tg.PhotoStrippedSize{Type: "i"}})
+	a.False(ok)
+}
+
+func TestPhotoSizeType(t *testing.T) {
+	a := require.New(t)
+
+	r, ok := photoSizeType(&tg.PhotoSize{Type: "w"})
+	a.True(ok)
+	a.Equal('w', r)
+
+	_, ok = photoSizeType(&tg.PhotoSize{Type: "xy"})
+	a.False(ok)
+	_, ok = photoSizeType(&tg.PhotoStrippedSize{Type: "i"})
+	a.False(ok)
+}
+
+func TestMediaFileIDs(t *testing.T) {
+	a := require.New(t)
+
+	doc := &tg.Document{
+		ID:         10,
+		AccessHash: 20,
+		DCID:       2,
+		Attributes: []tg.DocumentAttributeClass{
+			&tg.DocumentAttributeSticker{},
+		},
+		Thumbs: []tg.PhotoSizeClass{
+			&tg.PhotoStrippedSize{Type: "i"},
+			&tg.PhotoSize{Type: "m", W: 128, H: 128},
+		},
+	}
+	checks := mediaFileIDs(&tg.MessageMediaDocument{Document: doc}, &botapi.Message{
+		Sticker: botapi.Sticker{
+			FileID:    "sticker",
+			Thumbnail: botapi.PhotoSize{FileID: "thumb", Width: 128, Height: 128},
+		},
+	})
+	a.Len(checks, 2)
+	a.Equal(fileid.Sticker, checks[0].ID.Type)
+	a.Equal("sticker", checks[0].BotAPI)
+	a.Equal(fileid.Thumbnail, checks[1].ID.Type)
+	a.Equal('m', checks[1].ID.PhotoSizeSource.ThumbnailType)
+	a.Equal("thumb", checks[1].BotAPI)
+
+	photo := &tg.Photo{
+		ID: 10,
+		Sizes: []tg.PhotoSizeClass{
+			&tg.PhotoSize{Type: "s", W: 90, H: 90},
+			&tg.PhotoSizeProgressive{Type: "x", W: 800, H: 800},
+		},
+	}
+	checks = mediaFileIDs(&tg.MessageMediaPhoto{Photo: photo}, nil)
+	a.Len(checks, 2)
+	a.Equal('s', checks[0].ID.PhotoSizeSource.ThumbnailType)
+	a.Equal('x', checks[1].ID.PhotoSizeSource.ThumbnailType)
+	a.Empty(checks[1].BotAPI)
+}
+
+func TestCompareFileID(t *testing.T) {
+	a := require.New(t)
+
+	id := fileid.FileID{
+		Type:       fileid.Video,
+		DC:         2,
+		ID:         10,
+		AccessHash: 20,
+	}
+	encode := func(id fileid.FileID) string {
+		s, err := fileid.EncodeFileID(id)
+		a.NoError(err)
+		return s
+	}
+
+	a.NoError(compareFileID(encode(id), encode(id)))
+
+	other := id
+	other.Type = fileid.VideoNote
+	other.DC = 4
+	err := compareFileID(encode(id), encode(other))
+	a.ErrorContains(err, "type, dc")
+
+	a.Error(compareFileID(encode(id), "invalid"))
+}
+
+func TestHasFile(t *testing.T) {
+	a := require.New(t)
+
+	a.True(hasFile(&tg.MessageMediaDocument{Document: &tg.Document{ID: 1}}))
+	a.True(hasFile(&tg.MessageMediaPhoto{Photo: &tg.Photo{ID: 1}}))
+	a.False(hasFile(&tg.MessageMediaDocument{Document: &tg.DocumentEmpty{}}))
+	a.False(hasFile(&tg.MessageMediaPhoto{}))
+	a.False(hasFile(&tg.MessageMediaGeo{}))
+	a.False(hasFile(nil))
+}
@@ -1,5 +1,12 @@
 package app
 
+import (
+	"context"
+	"sync/atomic"
+
+	"go.opentelemetry.io/otel/metric"
+)
+
 type metricWriter struct {
 	Increase func(n int64) int64
 	Bytes    int64
@@ -13,3 +20,35 @@ func (m *metricWriter) Write(p []byte) (n int, err error) {
 
 	return len(p), nil
 }
+
+// Counter is metric.Int64Counter which also keeps its value locally, so
+// it can be reported by bot itself.
+type Counter struct {
+	metric.Int64Counter
+	value atomic.Int64
+}
+
+// NewCounter wraps given counter.
+func NewCounter(c metric.Int64Counter) *Counter {
+	return &Counter{Int64Counter: c}
+}
+
+// Add implements metric.Int64Counter.
+func (c *Counter) Add(ctx context.Context, incr int64, options ...metric.AddOption) {
+	c.value.Add(incr)
+	c.Int64Counter.Add(ctx, incr, options...)
+}
+
+// Value returns sum of all increments.
+func (c *Counter) Value() int64 {
+	return c.value.Load()
+}
+
+// counterValue returns local value of counter, if tracked.
+func counterValue(c metric.Int64Counter) (int64, bool) {
+	v, ok := c.(interface{ Value() int64 })
+	if !ok {
+		return 0, false
+	}
+	return v.Value(), true
+}
@@ -2,12 +2,11 @@ package app
 
 import (
 	"context"
-	"crypto/sha256"
-	"fmt"
 	"io"
+	"sync"
+	"time"
 
 	"github.com/go-faster/errors"
-	"github.com/gotd/td/fileid"
 	"github.com/gotd/td/telegram"
 	"go.opentelemetry.io/otel/metric"
 	"go.uber.org/zap"
@@ -20,17 +19,31 @@ import (
 )
 
 type Metrics struct {
-	Messages   metric.Int64Counter
-	Responses  metric.Int64Counter
-	Bytes      metric.Int64Counter
-	Middleware telegram.Middleware
+	Messages           metric.Int64Counter
+	Responses          metric.Int64Counter
+	Bytes              metric.Int64Counter
+	FileIDChecks       metric.Int64Counter
+	MessageDiffs       metric.Int64Counter
+	DownloadChecks     metric.Int64Counter
+	DownloadThroughput metric.Float64Histogram
+	DroppedJobs        metric.Int64Counter
+	UploadThroughput   metric.Float64Histogram
+	Middleware         telegram.Middleware
 }
 
 type Middleware struct {
 	next       dispatch.MessageHandler
 	downloader *downloader.Downloader
 	client     *botapi.Client
+	mirror     int64
+	replyDiffs bool
 	metrics    *Metrics
+	reporter   FileIDReporter
+	photos     *sync.Map // checked peer photo IDs
+	pool       *mediaPool
+	workers    int
+	maxSize    int64
+	timeout    time.Duration
 
 	logger *zap.Logger
 }
@@ -47,53 +60,43 @@ func NewMiddleware(
 		next:       next,
 		downloader: d,
 		client:     opts.BotAPI,
+		mirror:     opts.MirrorChat,
+		replyDiffs: opts.ReplyDiffs,
 		metrics:    metrics,
+		reporter:   opts.FileIDReporter,
+		photos:     &sync.Map{},
+		pool:       newMediaPool(opts.QueueSize, opts.ChatRate, opts.ChatBurst, opts.ChatIdle),
+		workers:    opts.Workers,
+		maxSize:    opts.MaxMediaSize,
+		timeout:    opts.JobTimeout,
 		logger:     opts.Logger,
 	}
 }
 
-func maxSize(sizes []tg.PhotoSizeClass) string {
+// maxSize returns the largest downloadable photo size.
+func maxSize(sizes []tg.PhotoSizeClass) (string, bool) {
 	var (
 		maxSize string
-		maxH    int
+		maxArea int
 	)
 
 	for _, size := range sizes {
-		if s, ok := size.(interface {
-			GetH() int
-			GetType() string
-		}); ok && s.GetH() > maxH {
-			maxH = s.GetH()
-			maxSize = s.GetType()
+		if _, ok := photoSizeType(size); !ok {
+			continue
+		}
+		if w, h := photoSizeDimensions(size); w*h > maxArea {
+			maxArea = w * h
+			maxSize = size.GetType()
 		}
 	}
 
-	return maxSize
-}
-
-func (m Middleware) downloadMedia(ctx context.Context, rpc *tg.Client, loc tg.InputFileLocationClass) error {
-	h := sha256.New()
-	w := &metricWriter{
-		Increase: func(n int64) int64 {
-			m.metrics.Bytes.Add(ctx, n)
-			return n
-		},
-	}
-
-	if _, err := m.downloader.Download(rpc, loc).
-		Stream(ctx, io.MultiWriter(h, w)); err != nil {
-		return errors.Wrap(err, "stream")
-	}
-
-	m.logger.Info("Downloaded media",
-		zap.Int64("bytes", w.Bytes),
-		zap.String("sha256", fmt.Sprintf("%x", h.Sum(nil))),
-	)
-
-	return nil
+	return maxSize, maxSize != ""
 }
 
-func (m Middleware) handleMedia(ctx context.Context, rpc *tg.Client, msg *tg.Message) error {
+// handleMedia downloads message media and checks its file_id.
+//
+// BotAPI representation of message is optional.
+func (m Middleware) handleMedia(ctx context.Context, rpc *tg.Client, msg *tg.Message, botAPIMsg *botapi.Message) error {
 	log := m.logger.With(zap.Int("msg_id", msg.ID), zap.Stringer("peer_id", msg.PeerID))
 
 	switch media := msg.Media.(type) {
@@ -102,54 +105,65 @@ func (m Middleware) handleMedia(ctx context.Context, rpc *tg.Client, msg *tg.Mes
 		if !ok {
 			return nil
 		}
-		if err := m.downloadMedia(ctx, rpc, &tg.InputDocumentFileLocation{
-			ID:            doc.ID,
-			AccessHash:    doc.AccessHash,
-			FileReference: doc.FileReference,
+		if err := m.downloadMedia(ctx, rpc, downloadTarget{
+			Kind: "document",
+			DC:   doc.DCID,
+			Size: doc.Size,
+			Location: &tg.InputDocumentFileLocation{
+				ID:            doc.ID,
+				AccessHash:    doc.AccessHash,
+				FileReference: doc.FileReference,
+			},
+			Verify: true,
+			Limit:  m.maxSize,
 		}); err != nil {
 			return errors.Wrap(err, "download")
 		}
-
-		if err := m.checkOurFileID(ctx, fileid.FromDocument(doc)); err != nil {
-			log.Warn("Test document FileID", zap.Error(err))
-		}
-
 	case *tg.MessageMediaPhoto:
 		p, ok := media.Photo.AsNotEmpty()
 		if !ok {
 			return nil
 		}
-		size := maxSize(p.Sizes)
-		if err := m.downloadMedia(ctx, rpc, &tg.InputPhotoFileLocation{
-			ID:            p.ID,
-			AccessHash:    p.AccessHash,
-			FileReference: p.FileReference,
-			ThumbSize:     size,
+		size, ok := maxSize(p.Sizes)
+		if !ok {
+			return nil
+		}
+		// File hashes are available only for documents.
+		if err := m.downloadMedia(ctx, rpc, downloadTarget{
+			Kind: "photo",
+			DC:   p.DCID,
+			Location: &tg.InputPhotoFileLocation{
+				ID:            p.ID,
+				AccessHash:    p.AccessHash,
+				FileReference: p.FileReference,
+				ThumbSize:     size,
+			},
+			Limit: m.maxSize,
 		}); err != nil {
 			return errors.Wrap(err, "download")
 		}
-
-		thumbType := 'x'
-		if len(size) >= 1 {
-			thumbType = rune(size[0])
-		}
-
-		if err := m.checkOurFileID(ctx, fileid.FromPhoto(p, thumbType)); err != nil {
-			log.Warn("Test photo FileID", zap.Error(err))
-		}
 	default:
 		// Do not try to get file_id from messages without attachments.
 		return nil
 	}
 
-	loc, fileID, err := m.tryGetFileID(ctx, msg.ID)
+	if m.client == nil {
+		return nil
+	}
+	m.checkFileIDs(ctx, log, mediaFileIDs(msg.Media, botAPIMsg))
+	if botAPIMsg == nil {
+		return nil
+	}
+
+	loc, fileID, err := tryGetFileID(*botAPIMsg)
 	if err != nil {
 		log.Warn("Parse file_id",
 			zap.String("file_id", fileID),
 			zap.Error(err),
 		)
 	} else {
-		if _, err := m.downloader.Download(rpc, loc).Stream(ctx, io.Discard); err != nil {
+		_, err := m.downloader.Download(rpc, loc).Stream(ctx, limitDownload(io.Discard, m.maxSize))
+		if err != nil && !errors.Is(err, errPartial) {
 			log.Warn("Download file_id",
 				zap.String("file_id", fileID),
 				zap.Error(err),
@@ -161,17 +175,53 @@ func (m Middleware) handleMedia(ctx context.Context, rpc *tg.Client, msg *tg.Mes
 	return nil
 }
 
+// process checks message media and compares MTProto and BotAPI views of
+// message.
+func (m Middleware) process(ctx context.Context, e dispatch.MessageEvent) {
+	ctx, cancel := context.WithTimeout(ctx, m.timeout)
+	defer cancel()
+
+	log := m.logger.With(zap.Int("msg_id", e.Message.ID))
+	var botAPIMsg *botapi.Message
+	// Messages with files are needed for file_id checks and text messages
+	// for cross-check, do not forward or look up other messages, e.g.
+	// polls or service ones.
+	if m.client != nil && (hasFile(e.Message.Media) || e.Message.Message != "") {
+		msg, err := m.botAPIMessage(ctx, e.Message)
+		if err != nil {
+			log.Warn("Get BotAPI message", zap.Error(err))
+		} else {
+			botAPIMsg = &msg
+		}
+	}
+
+	if err := m.handleMedia(ctx, e.RPC(), e.Message, botAPIMsg); err != nil {
+		log.Warn("Handle media", zap.Error(err))
+	}
+	if botAPIMsg != nil {
+		if err := m.crossCheck(ctx, e, *botAPIMsg); err != nil {
+			log.Warn("Cross-check", zap.Error(err))
+		}
+	}
+	if m.client != nil {
+		if err := m.checkPeerPhoto(ctx, e); err != nil {
+			log.Warn("Check peer photo", zap.Error(err))
+		}
+	}
+}
+
 // OnMessage implements dispatch.MessageHandler.
+//
+// Message is processed asynchronously by workers started by Run, message is
+// dropped if queue is full or chat is rate limited. Messages without files
+// are not rate limited.
 func (m Middleware) OnMessage(ctx context.Context, e dispatch.MessageEvent) error {
 	m.metrics.Messages.Add(ctx, 1)
 
 	if err := m.next.OnMessage(ctx, e); err != nil {
 		return err
 	}
-
-	if err := m.handleMedia(ctx, e.RPC(), e.Message); err != nil {
-		return errors.Wrap(err, "handle media")
-	}
+	m.enqueue(ctx, e)
 
 	m.metrics.Responses.Add(ctx, 1)
 	return nil
@@ -1,7 +1,10 @@
 package app
 
 import (
+	"time"
+
 	"go.uber.org/zap"
+	"golang.org/x/time/rate"
 
 	"github.com/gotd/bot/internal/botapi"
 )
@@ -9,10 +12,61 @@ import (
 // MiddlewareOptions is middleware options.
 type MiddlewareOptions struct {
 	BotAPI *botapi.Client
+	// MirrorChat is BotAPI chat ID where messages with files or text are
+	// forwarded to get their BotAPI representation.
+	//
+	// If zero, recent updates of BotAPI are used, which is racy.
+	MirrorChat int64
+	// ReplyDiffs enables replies with discrepancies between MTProto and
+	// BotAPI views of message.
+	ReplyDiffs bool
+	// FileIDReporter stores file_id check results, optional.
+	FileIDReporter FileIDReporter
+
+	// Workers is count of message processing workers, defaults to 4.
+	Workers int
+	// QueueSize is size of message processing queue, defaults to 64.
+	QueueSize int
+	// JobTimeout is timeout of single message processing, defaults to 5 minutes.
+	JobTimeout time.Duration
+	// MaxMediaSize is maximum size of media to download in full, only
+	// first MaxMediaSize bytes of bigger media are downloaded.
+	//
+	// Defaults to 20MB.
+	MaxMediaSize int64
+	// ChatRate is rate limit of processed messages per chat, defaults to
+	// one message per second with burst of 5.
+	ChatRate  rate.Limit
+	ChatBurst int
+	// ChatIdle is duration after which rate limiter of inactive chat is
+	// removed, defaults to 10 minutes.
+	ChatIdle time.Duration
+
 	Logger *zap.Logger
 }
 
 func (m *MiddlewareOptions) setDefaults() {
+	if m.Workers <= 0 {
+		m.Workers = 4
+	}
+	if m.QueueSize <= 0 {
+		m.QueueSize = 64
+	}
+	if m.JobTimeout <= 0 {
+		m.JobTimeout = 5 * time.Minute
+	}
+	if m.MaxMediaSize <= 0 {
+		m.MaxMediaSize = 20 * 1024 * 1024
+	}
+	if m.ChatRate == 0 {
+		m.ChatRate = rate.Limit(1)
+	}
+	if m.ChatBurst <= 0 {
+		m.ChatBurst = 5
+	}
+	if m.ChatIdle <= 0 {
+		m.ChatIdle = 10 * time.Minute
+	}
 	if m.Logger == nil {
 		m.Logger = zap.NewNop()
 	}
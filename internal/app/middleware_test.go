@@ -0,0 +1,356 @@
+package app
+
+import (
+	"context"
+	"crypto/sha256"
+	"testing"
+	"time"
+
+	"github.com/gotd/td/bin"
+	"github.com/gotd/td/fileid"
+	"github.com/gotd/td/telegram/downloader"
+	"github.com/gotd/td/tg"
+	"github.com/gotd/td/tgmock"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/metric/noop"
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+
+	"github.com/gotd/bot/internal/botapi"
+	"github.com/gotd/bot/internal/botapi/botapitest"
+	"github.com/gotd/bot/internal/dispatch"
+)
+
+type reporterFunc func(ctx context.Context, r FileIDResult) error
+
+func (f reporterFunc) Report(ctx context.Context, r FileIDResult) error {
+	return f(ctx, r)
+}
+
+func testMetrics(t *testing.T) *Metrics {
+	a := require.New(t)
+	meter := noop.NewMeterProvider().Meter("test")
+
+	var (
+		m   Metrics
+		err error
+	)
+	m.Messages, err = meter.Int64Counter("messages")
+	a.NoError(err)
+	m.Responses, err = meter.Int64Counter("responses")
+	a.NoError(err)
+	m.Bytes, err = meter.Int64Counter("bytes")
+	a.NoError(err)
+	m.FileIDChecks, err = meter.Int64Counter("file_id_checks")
+	a.NoError(err)
+	m.MessageDiffs, err = meter.Int64Counter("message_diffs")
+	a.NoError(err)
+	m.DownloadChecks, err = meter.Int64Counter("download_checks")
+	a.NoError(err)
+	m.DownloadThroughput, err = meter.Float64Histogram("download_throughput")
+	a.NoError(err)
+	m.DroppedJobs, err = meter.Int64Counter("dropped_jobs")
+	a.NoError(err)
+	return &m
+}
+
+func TestMiddleware_handleMedia(t *testing.T) {
+	a := require.New(t)
+	ctx := context.Background()
+
+	doc := &tg.Document{
+		ID:            10,
+		AccessHash:    20,
+		FileReference: []byte{1, 2, 3},
+		DCID:          2,
+		Attributes: []tg.DocumentAttributeClass{
+			&tg.DocumentAttributeSticker{},
+		},
+		Thumbs: []tg.PhotoSizeClass{
+			&tg.PhotoSize{Type: "m", W: 128, H: 128},
+		},
+	}
+	encode := func(id fileid.FileID) string {
+		s, err := fileid.EncodeFileID(id)
+		a.NoError(err)
+		return s
+	}
+	sticker := encode(fileid.FromDocument(doc))
+	thumb := encode(documentThumbnail(doc, 'm'))
+
+	wrongDC := fileid.FromDocument(doc)
+	wrongDC.DC = 4
+
+	srv := botapitest.NewServer(t)
+	srv.AddMessage(botapi.Message{
+		MessageID: 1,
+		Sticker: botapi.Sticker{
+			FileID:    sticker,
+			Thumbnail: botapi.PhotoSize{FileID: thumb, Width: 128, Height: 128},
+		},
+	})
+	srv.AddMessage(botapi.Message{
+		MessageID: 2,
+		Sticker: botapi.Sticker{
+			FileID: encode(wrongDC),
+		},
+	})
+
+	data := []byte("sticker")
+	file := &tg.UploadFile{
+		Type:  &tg.StorageFileWebp{},
+		Bytes: data,
+	}
+	hash := sha256.Sum256(data)
+
+	// For every message: CDN redirect probe, verified media download and
+	// download by BotAPI file_id.
+	mock := tgmock.NewRequire(t)
+	for range 2 {
+		mock.Expect().ThenResult(file).
+			Expect().ThenResult(&tg.FileHashVector{Elems: []tg.FileHash{
+			{Offset: 0, Limit: 128 * 1024, Hash: hash[:]},
+		}}).
+			Expect().ThenResult(file).
+			Expect().ThenResult(&tg.FileHashVector{}).
+			Expect().ThenResult(file)
+	}
+
+	var results []FileIDResult
+	m := NewMiddleware(dispatch.MessageHandlerFunc(func(ctx context.Context, e dispatch.MessageEvent) error {
+		return nil
+	}), downloader.NewDownloader(), testMetrics(t), MiddlewareOptions{
+		BotAPI: srv.Client(),
+		FileIDReporter: reporterFunc(func(ctx context.Context, r FileIDResult) error {
+			results = append(results, r)
+			return nil
+		}),
+	})
+
+	rpc := tg.NewClient(mock)
+	for _, id := range []int{1, 2} {
+		msg := &tg.Message{
+			ID:     id,
+			PeerID: &tg.PeerUser{UserID: 1},
+			Media:  &tg.MessageMediaDocument{Document: doc},
+		}
+		botAPIMsg, err := m.botAPIMessage(ctx, msg)
+		a.NoError(err)
+		a.NoError(m.handleMedia(ctx, rpc, msg, &botAPIMsg))
+	}
+
+	a.Equal([]string{sticker, thumb, sticker, thumb}, srv.Files())
+	a.Len(results, 4)
+	for i, expected := range []struct {
+		Type   fileid.Type
+		Result string
+	}{
+		{fileid.Sticker, FileIDOK},
+		{fileid.Thumbnail, FileIDOK},
+		{fileid.Sticker, FileIDMismatch},
+		{fileid.Thumbnail, FileIDOK},
+	} {
+		a.Equal(expected.Type, results[i].Type, i)
+		a.Equal(expected.Result, results[i].Result, i)
+	}
+	a.ErrorContains(results[2].Err, "dc")
+}
+
+type invokerFunc func(ctx context.Context, input bin.Encoder, output bin.Decoder) error
+
+func (f invokerFunc) Invoke(ctx context.Context, input bin.Encoder, output bin.Decoder) error {
+	return f(ctx, input, output)
+}
+
+func TestMiddleware_OnMessageMirror(t *testing.T) {
+	const mirror = -1001234567890
+	a := require.New(t)
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*10)
+	defer cancel()
+
+	doc := &tg.Document{
+		ID:         10,
+		AccessHash: 20,
+		DCID:       2,
+		Attributes: []tg.DocumentAttributeClass{
+			&tg.DocumentAttributeSticker{},
+		},
+	}
+	sticker, err := fileid.EncodeFileID(fileid.FromDocument(doc))
+	a.NoError(err)
+
+	srv := botapitest.NewServer(t)
+	srv.AddMessage(botapi.Message{
+		MessageID: 2,
+		Chat:      botapi.Chat{ID: 1},
+		Sticker:   botapi.Sticker{FileID: sticker},
+	})
+
+	// Only media message is downloaded: CDN redirect probe, verified
+	// download and download by BotAPI file_id.
+	data := []byte("sticker")
+	file := &tg.UploadFile{Type: &tg.StorageFileWebp{}, Bytes: data}
+	hash := sha256.Sum256(data)
+	mock := tgmock.NewRequire(t)
+	mock.Expect().ThenResult(file).
+		Expect().ThenResult(&tg.FileHashVector{Elems: []tg.FileHash{
+		{Offset: 0, Limit: 128 * 1024, Hash: hash[:]},
+	}}).
+		Expect().ThenResult(file).
+		Expect().ThenResult(&tg.FileHashVector{}).
+		Expect().ThenResult(file)
+
+	var handled []int
+	reported := make(chan FileIDResult, 1)
+	m := NewMiddleware(dispatch.MessageHandlerFunc(func(ctx context.Context, e dispatch.MessageEvent) error {
+		handled = append(handled, e.Message.ID)
+		return nil
+	}), downloader.NewDownloader(), testMetrics(t), MiddlewareOptions{
+		BotAPI:     srv.Client(),
+		MirrorChat: mirror,
+		Workers:    1,
+		FileIDReporter: reporterFunc(func(ctx context.Context, r FileIDResult) error {
+			reported <- r
+			return nil
+		}),
+	})
+	runCtx, stop := context.WithCancel(ctx)
+	defer stop()
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		_ = m.Run(runCtx)
+	}()
+
+	calls := make(chan struct{}, 5)
+	invoker := invokerFunc(func(ctx context.Context, input bin.Encoder, output bin.Decoder) error {
+		defer func() { calls <- struct{}{} }()
+		return mock.Invoke(ctx, input, output)
+	})
+	bot := dispatch.NewBot(tg.NewClient(invoker)).OnMessage(m)
+	entities := tg.Entities{Users: map[int64]*tg.User{1: {ID: 1}}}
+	for _, msg := range []*tg.Message{
+		{ID: 1, PeerID: &tg.PeerUser{UserID: 1}, Media: &tg.MessageMediaGeo{Geo: &tg.GeoPointEmpty{}}},
+		{ID: 2, PeerID: &tg.PeerUser{UserID: 1}, Media: &tg.MessageMediaDocument{Document: doc}},
+	} {
+		a.NoError(bot.OnNewMessage(ctx, entities, &tg.UpdateNewMessage{Message: msg}))
+	}
+
+	// Messages are processed in order by single worker, so geo message is
+	// already processed when media is reported.
+	select {
+	case r := <-reported:
+		a.Equal(FileIDOK, r.Result)
+		a.Equal(sticker, r.BotAPI)
+	case <-ctx.Done():
+		t.Fatal("media is not processed")
+	}
+	// Wait for download by BotAPI file_id.
+	for i := 0; i < cap(calls); i++ {
+		select {
+		case <-calls:
+		case <-ctx.Done():
+			t.Fatal("file is not downloaded")
+		}
+	}
+	stop()
+	<-done
+
+	a.Equal([]int{1, 2}, handled)
+	forwarded := srv.Forwarded()
+	a.Len(forwarded, 1, "only media with files should be mirrored")
+	a.Equal(mirror, forwarded[0].Chat.ID)
+	a.Equal(sticker, forwarded[0].Sticker.FileID)
+}
+
+func TestMiddleware_OnMessageCrossCheck(t *testing.T) {
+	const mirror = -1001234567890
+	a := require.New(t)
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*10)
+	defer cancel()
+
+	// "👋 hello" is 8 UTF-16 code units, emoji is a surrogate pair.
+	srv := botapitest.NewServer(t)
+	srv.AddMessage(botapi.Message{
+		MessageID: 1,
+		Date:      42,
+		From:      &botapi.User{ID: 1},
+		Chat:      botapi.Chat{ID: 1},
+		Text:      "👋 hello",
+		Entities: []botapi.MessageEntity{
+			// Offset in code points instead of UTF-16 code units.
+			{Type: "bold", Offset: 2, Length: 5},
+		},
+	})
+
+	reader := sdkmetric.NewManualReader()
+	metrics := testMetrics(t)
+	var err error
+	metrics.MessageDiffs, err = sdkmetric.NewMeterProvider(sdkmetric.WithReader(reader)).
+		Meter("test").Int64Counter("diffs")
+	a.NoError(err)
+
+	m := NewMiddleware(dispatch.MessageHandlerFunc(func(ctx context.Context, e dispatch.MessageEvent) error {
+		return nil
+	}), downloader.NewDownloader(), metrics, MiddlewareOptions{
+		BotAPI:     srv.Client(),
+		MirrorChat: mirror,
+		Workers:    1,
+	})
+	runCtx, stop := context.WithCancel(ctx)
+	defer stop()
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		_ = m.Run(runCtx)
+	}()
+
+	// Text message needs no RPC calls.
+	bot := dispatch.NewBot(tg.NewClient(tgmock.NewRequire(t))).OnMessage(m)
+	entities := tg.Entities{Users: map[int64]*tg.User{1: {ID: 1}}}
+	msg := &tg.Message{
+		ID:      1,
+		Date:    42,
+		PeerID:  &tg.PeerUser{UserID: 1},
+		Message: "👋 hello",
+		Entities: []tg.MessageEntityClass{
+			&tg.MessageEntityBold{Offset: 3, Length: 5},
+		},
+		ReplyTo: &tg.MessageReplyHeader{ReplyToMsgID: 5},
+	}
+	msg.SetFlags()
+	a.NoError(bot.OnNewMessage(ctx, entities, &tg.UpdateNewMessage{Message: msg}))
+
+	// Collect recorded diffs by field and result.
+	diffs := func() map[string]int64 {
+		var rm metricdata.ResourceMetrics
+		a.NoError(reader.Collect(ctx, &rm))
+		r := map[string]int64{}
+		for _, sm := range rm.ScopeMetrics {
+			for _, mt := range sm.Metrics {
+				sum, ok := mt.Data.(metricdata.Sum[int64])
+				if !ok {
+					continue
+				}
+				for _, dp := range sum.DataPoints {
+					field, _ := dp.Attributes.Value("field")
+					result, _ := dp.Attributes.Value("result")
+					r[field.AsString()+"/"+result.AsString()] += dp.Value
+				}
+			}
+		}
+		return r
+	}
+	// Mismatches are recorded after skipped fields.
+	a.Eventually(func() bool {
+		return diffs()["entities/mismatch"] > 0
+	}, time.Second*5, time.Millisecond*10)
+	stop()
+	<-done
+
+	a.Equal(map[string]int64{
+		"entities/mismatch": 1,
+		"reply_to/skipped":  1,
+	}, diffs())
+	a.Len(srv.Forwarded(), 1, "text should be mirrored")
+}
@@ -0,0 +1,190 @@
+package app
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+	"go.uber.org/zap"
+	"golang.org/x/sync/errgroup"
+	"golang.org/x/time/rate"
+
+	"github.com/gotd/bot/internal/dispatch"
+)
+
+// Dropped job reasons.
+const (
+	dropQueueFull   = "queue_full"
+	dropRateLimited = "rate_limited"
+)
+
+// chatLimiter is rate limiter of single chat.
+type chatLimiter struct {
+	limiter  *rate.Limiter
+	lastUsed time.Time
+}
+
+// mediaPool is bounded queue of message processing jobs with per-chat
+// rate limits.
+type mediaPool struct {
+	jobs chan dispatch.MessageEvent
+
+	limit rate.Limit
+	burst int
+	idle  time.Duration
+	now   func() time.Time
+
+	mux      sync.Mutex
+	limiters map[int64]*chatLimiter
+}
+
+func newMediaPool(size int, limit rate.Limit, burst int, idle time.Duration) *mediaPool {
+	return &mediaPool{
+		jobs:     make(chan dispatch.MessageEvent, size),
+		limit:    limit,
+		burst:    burst,
+		idle:     idle,
+		now:      time.Now,
+		limiters: map[int64]*chatLimiter{},
+	}
+}
+
+// allow reports whether job from given chat is allowed by rate limit.
+//
+// Must be called with p.mux held.
+func (p *mediaPool) allow(chatID int64) bool {
+	now := p.now()
+	l, ok := p.limiters[chatID]
+	if !ok {
+		l = &chatLimiter{limiter: rate.NewLimiter(p.limit, p.burst)}
+		p.limiters[chatID] = l
+	}
+	l.lastUsed = now
+	return l.limiter.AllowN(now, 1)
+}
+
+// evict removes limiters of chats which were idle for longer than idle
+// timeout and returns count of removed limiters.
+//
+// Idle timeout is expected to be bigger than time needed to refill burst,
+// so evicted limiter is equal to new one.
+func (p *mediaPool) evict() int {
+	p.mux.Lock()
+	defer p.mux.Unlock()
+
+	deadline := p.now().Add(-p.idle)
+	var removed int
+	for chatID, l := range p.limiters {
+		if l.lastUsed.Before(deadline) {
+			delete(p.limiters, chatID)
+			removed++
+		}
+	}
+	return removed
+}
+
+// sweep periodically evicts idle limiters until context is done.
+func (p *mediaPool) sweep(ctx context.Context) error {
+	ticker := time.NewTicker(p.idle)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+			p.evict()
+		}
+	}
+}
+
+// push enqueues job and returns reason if job is dropped.
+//
+// Only jobs with files are rate limited. Rate limit is charged only if job
+// fits into queue.
+func (p *mediaPool) push(e dispatch.MessageEvent, limited bool) (string, bool) {
+	p.mux.Lock()
+	defer p.mux.Unlock()
+
+	// Jobs are pushed only with p.mux held, so queue can't be filled
+	// after this check.
+	if len(p.jobs) == cap(p.jobs) {
+		return dropQueueFull, false
+	}
+	if limited {
+		chatID, _ := botAPIChatID(e.Message.PeerID)
+		if !p.allow(chatID) {
+			return dropRateLimited, false
+		}
+	}
+
+	select {
+	case p.jobs <- e:
+		return "", true
+	default:
+		return dropQueueFull, false
+	}
+}
+
+// needsProcessing reports whether message has work for workers: file to
+// download or BotAPI checks.
+func (m Middleware) needsProcessing(e dispatch.MessageEvent) bool {
+	if hasFile(e.Message.Media) {
+		return true
+	}
+	if m.client == nil {
+		return false
+	}
+	if e.Message.Message != "" {
+		// Cross-check of text.
+		return true
+	}
+	_, _, photo, ok := peerPhoto(e)
+	if !ok {
+		return false
+	}
+	_, checked := m.photos.Load(photo.GetPhotoID())
+	return !checked
+}
+
+// enqueue schedules processing of message and records dropped jobs.
+func (m Middleware) enqueue(ctx context.Context, e dispatch.MessageEvent) {
+	if !m.needsProcessing(e) {
+		return
+	}
+	reason, ok := m.pool.push(e, hasFile(e.Message.Media))
+	if ok {
+		return
+	}
+
+	m.metrics.DroppedJobs.Add(ctx, 1, metric.WithAttributes(
+		attribute.String("reason", reason),
+	))
+	m.logger.Debug("Dropped message processing",
+		zap.Int("msg_id", e.Message.ID),
+		zap.String("reason", reason),
+	)
+}
+
+// Run starts workers which process queued messages until context is done.
+func (m Middleware) Run(ctx context.Context) error {
+	g, ctx := errgroup.WithContext(ctx)
+	g.Go(func() error {
+		return m.pool.sweep(ctx)
+	})
+	for i := 0; i < m.workers; i++ {
+		g.Go(func() error {
+			for {
+				select {
+				case <-ctx.Done():
+					return ctx.Err()
+				case e := <-m.pool.jobs:
+					m.process(ctx, e)
+				}
+			}
+		})
+	}
+	return g.Wait()
+}
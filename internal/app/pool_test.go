@@ -0,0 +1,119 @@
+package app
+
+import (
+	"testing"
+	"time"
+
+	"github.com/gotd/td/tg"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/time/rate"
+
+	"github.com/gotd/bot/internal/botapi/botapitest"
+	"github.com/gotd/bot/internal/dispatch"
+)
+
+func TestMediaPool(t *testing.T) {
+	a := require.New(t)
+
+	event := func(userID int64) dispatch.MessageEvent {
+		return dispatch.MessageEvent{
+			Message: &tg.Message{PeerID: &tg.PeerUser{UserID: userID}},
+		}
+	}
+
+	p := newMediaPool(2, rate.Limit(0), 1, time.Minute)
+	_, ok := p.push(event(1), true)
+	a.True(ok)
+
+	// Burst of chat 1 is exhausted.
+	reason, ok := p.push(event(1), true)
+	a.False(ok)
+	a.Equal(dropRateLimited, reason)
+
+	// Other chats are limited separately.
+	_, ok = p.push(event(2), true)
+	a.True(ok)
+	reason, ok = p.push(event(3), true)
+	a.False(ok)
+	a.Equal(dropQueueFull, reason)
+
+	a.Len(p.jobs, 2)
+
+	// Job dropped because of full queue does not exhaust burst.
+	<-p.jobs
+	_, ok = p.push(event(3), true)
+	a.True(ok)
+}
+
+func TestMediaPool_TextThenMedia(t *testing.T) {
+	a := require.New(t)
+
+	event := func(media tg.MessageMediaClass) dispatch.MessageEvent {
+		return dispatch.MessageEvent{
+			Message: &tg.Message{PeerID: &tg.PeerUser{UserID: 1}, Media: media},
+		}
+	}
+
+	p := newMediaPool(10, rate.Limit(0), 1, time.Minute)
+	// Text messages are not rate limited and do not exhaust burst.
+	for i := 0; i < 5; i++ {
+		_, ok := p.push(event(nil), false)
+		a.True(ok)
+	}
+	_, ok := p.push(event(&tg.MessageMediaPhoto{Photo: &tg.Photo{}}), true)
+	a.True(ok)
+	reason, ok := p.push(event(&tg.MessageMediaPhoto{Photo: &tg.Photo{}}), true)
+	a.False(ok)
+	a.Equal(dropRateLimited, reason)
+	a.Len(p.jobs, 6)
+}
+
+func TestMiddleware_needsProcessing(t *testing.T) {
+	a := require.New(t)
+
+	event := func(msg *tg.Message) dispatch.MessageEvent {
+		return dispatch.MessageEvent{Message: msg}
+	}
+	text := event(&tg.Message{Message: "text"})
+	photo := event(&tg.Message{Media: &tg.MessageMediaPhoto{Photo: &tg.Photo{ID: 1}}})
+	geo := event(&tg.Message{Media: &tg.MessageMediaGeo{Geo: &tg.GeoPointEmpty{}}})
+
+	// Without BotAPI only files are processed.
+	m := NewMiddleware(nil, nil, testMetrics(t), MiddlewareOptions{})
+	a.True(m.needsProcessing(photo))
+	a.False(m.needsProcessing(text))
+	a.False(m.needsProcessing(geo))
+
+	m = NewMiddleware(nil, nil, testMetrics(t), MiddlewareOptions{
+		BotAPI: botapitest.NewServer(t).Client(),
+	})
+	a.True(m.needsProcessing(photo))
+	a.True(m.needsProcessing(text))
+	a.False(m.needsProcessing(geo))
+}
+
+func TestMediaPool_Evict(t *testing.T) {
+	a := require.New(t)
+
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	p := newMediaPool(2, rate.Limit(0), 1, time.Minute)
+	p.now = func() time.Time { return now }
+
+	a.True(p.allow(1))
+	now = now.Add(time.Second * 30)
+	a.True(p.allow(2))
+	a.Zero(p.evict())
+	a.Len(p.limiters, 2)
+
+	// Chat 1 is idle for more than minute.
+	now = now.Add(time.Second * 31)
+	a.Equal(1, p.evict())
+	a.Len(p.limiters, 1)
+	a.Contains(p.limiters, int64(2))
+
+	// Chat 2 is still limited until eviction.
+	a.False(p.allow(2))
+	now = now.Add(time.Minute * 2)
+	a.Equal(1, p.evict())
+	a.Empty(p.limiters)
+}
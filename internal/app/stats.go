@@ -3,7 +3,10 @@ package app
 import (
 	"context"
 	"fmt"
+	"io"
+	"runtime"
 	"strings"
+	"time"
 
 	"github.com/gotd/td/tg"
 
@@ -12,26 +15,135 @@ import (
 
 // Handler implements stats request handler.
 type Handler struct {
+	start       time.Time
+	build       BuildInfo
+	metrics     *Metrics
+	commands    *CommandStats
+	accounts    AccountStats
+	client      Client
+	pingTimeout time.Duration
 }
 
 // NewHandler creates new Handler.
-func NewHandler() Handler {
-	return Handler{}
+func NewHandler(opts HandlerOptions) Handler {
+	opts.setDefaults()
+	return Handler{
+		start:       opts.Start,
+		build:       opts.Build,
+		metrics:     opts.Metrics,
+		commands:    opts.Commands,
+		accounts:    opts.Accounts,
+		client:      opts.Client,
+		pingTimeout: opts.PingTimeout,
+	}
+}
+
+// formatBytes formats byte count using binary units.
+func formatBytes(n uint64) string {
+	const unit = 1024
+	if n < unit {
+		return fmt.Sprintf("%d B", n)
+	}
+	div, exp := uint64(unit), 0
+	for v := n / unit; v >= unit; v /= unit {
+		div *= unit
+		exp++
+	}
+	return fmt.Sprintf("%.1f %ciB", float64(n)/float64(div), "KMGTPE"[exp])
+}
+
+func (h Handler) writeRuntime(w io.Writer) {
+	var mem runtime.MemStats
+	runtime.ReadMemStats(&mem)
+
+	fmt.Fprintln(w, "Uptime:", time.Since(h.start).Truncate(time.Second))
+	fmt.Fprintln(w, "Goroutines:", runtime.NumGoroutine())
+	fmt.Fprintf(w, "Memory: %s heap, %s sys, %d GC\n",
+		formatBytes(mem.HeapAlloc), formatBytes(mem.Sys), mem.NumGC,
+	)
+}
+
+func (h Handler) writeMetrics(w io.Writer) {
+	if h.metrics == nil {
+		return
+	}
+	if v, ok := counterValue(h.metrics.Messages); ok {
+		fmt.Fprintln(w, "Messages:", v)
+	}
+	if v, ok := counterValue(h.metrics.Responses); ok {
+		fmt.Fprintln(w, "Responses:", v)
+	}
+	if v, ok := counterValue(h.metrics.Bytes); ok {
+		fmt.Fprintln(w, "Downloaded:", formatBytes(uint64(max(v, 0))))
+	}
 }
 
-func (h Handler) stats() string {
+func (h Handler) writeCommands(w io.Writer) {
+	if h.commands == nil {
+		return
+	}
+	stats := h.commands.Stats()
+	if len(stats) == 0 {
+		return
+	}
+	fmt.Fprintln(w, "\nCommands:")
+	for _, s := range stats {
+		fmt.Fprintf(w, "%s: %d calls, %d errors, p50 %s, p90 %s, p99 %s\n",
+			s.Command, s.Count, s.Errors,
+			s.P50.Round(time.Millisecond),
+			s.P90.Round(time.Millisecond),
+			s.P99.Round(time.Millisecond),
+		)
+	}
+}
+
+func (h Handler) writeConnection(ctx context.Context, w io.Writer) {
+	if h.accounts != nil {
+		s := h.accounts.Stats()
+		fmt.Fprintf(w, "Accounts: %d running, %d leased, %d free, %d unhealthy\n",
+			s.Running, s.Leased, s.Free, s.Unhealthy,
+		)
+	}
+	if h.client == nil {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, h.pingTimeout)
+	defer cancel()
+
+	dc := h.client.Config().ThisDC
+	start := time.Now()
+	if err := h.client.Ping(ctx); err != nil {
+		fmt.Fprintf(w, "DC %d: %s\n", dc, err)
+		return
+	}
+	fmt.Fprintf(w, "DC %d: connected, ping %s\n", dc, time.Since(start).Round(time.Millisecond))
+}
+
+func (h Handler) stats(ctx context.Context) string {
 	var w strings.Builder
 	fmt.Fprintf(&w, "Statistics:\n\n")
 	fmt.Fprintln(&w, "TL Layer version:", tg.Layer)
-	if v := GetVersion(); v != "" {
+	if v := h.build.Version; v != "" {
 		fmt.Fprintln(&w, "Version:", v)
 	}
+	if v := h.build.GoVersion; v != "" {
+		fmt.Fprintln(&w, "Go:", v)
+	}
+	if v := h.build.Commit; v != "" {
+		fmt.Fprintln(&w, "Commit:", v)
+	}
+	h.writeRuntime(&w)
+	h.writeMetrics(&w)
+	h.writeCommands(&w)
+	fmt.Fprintln(&w)
+	h.writeConnection(ctx, &w)
 
 	return w.String()
 }
 
 // OnMessage implements dispatch.MessageHandler.
 func (h Handler) OnMessage(ctx context.Context, e dispatch.MessageEvent) error {
-	_, err := e.Reply().Text(ctx, h.stats())
+	_, err := e.Reply().Text(ctx, h.stats(ctx))
 	return err
 }
@@ -0,0 +1,53 @@
+package app
+
+import (
+	"context"
+	"time"
+
+	"github.com/gotd/td/tg"
+
+	"github.com/gotd/bot/internal/tgmanager"
+)
+
+// AccountStats reports state of test accounts.
+type AccountStats interface {
+	Stats() tgmanager.AccountStats
+}
+
+// Client is Telegram client to report connection status of.
+type Client interface {
+	Ping(ctx context.Context) error
+	Config() tg.Config
+}
+
+// HandlerOptions is options of stats handler.
+type HandlerOptions struct {
+	// Start is time of bot start, defaults to time.Now().
+	Start time.Time
+	// Build is info of current build, defaults to GetBuildInfo().
+	Build BuildInfo
+	// Metrics to report, optional.
+	//
+	// Only counters created by NewCounter are reported.
+	Metrics *Metrics
+	// Commands is per-command statistics, optional.
+	Commands *CommandStats
+	// Accounts reports test accounts state, optional.
+	Accounts AccountStats
+	// Client is Telegram client to report connection status, optional.
+	Client Client
+	// PingTimeout is timeout of ping request, defaults to 5 seconds.
+	PingTimeout time.Duration
+}
+
+func (h *HandlerOptions) setDefaults() {
+	if h.Start.IsZero() {
+		h.Start = time.Now()
+	}
+	if h.Build == (BuildInfo{}) {
+		h.Build = GetBuildInfo()
+	}
+	if h.PingTimeout <= 0 {
+		h.PingTimeout = 5 * time.Second
+	}
+}
@@ -0,0 +1,78 @@
+package app
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/gotd/td/tg"
+	"github.com/stretchr/testify/require"
+
+	"github.com/gotd/bot/internal/tgmanager"
+)
+
+type accountStatsFunc func() tgmanager.AccountStats
+
+func (f accountStatsFunc) Stats() tgmanager.AccountStats { return f() }
+
+type testClient struct {
+	err error
+}
+
+func (c testClient) Ping(ctx context.Context) error { return c.err }
+
+func (c testClient) Config() tg.Config { return tg.Config{ThisDC: 2} }
+
+func TestFormatBytes(t *testing.T) {
+	a := require.New(t)
+
+	a.Equal("512 B", formatBytes(512))
+	a.Equal("1.5 KiB", formatBytes(1536))
+	a.Equal("20.0 MiB", formatBytes(20*1024*1024))
+}
+
+func TestHandler_stats(t *testing.T) {
+	a := require.New(t)
+	ctx := context.Background()
+
+	m := testMetrics(t)
+	messages := NewCounter(m.Messages)
+	m.Messages = messages
+	messages.Add(ctx, 10)
+	a.Equal(int64(10), messages.Value())
+
+	commands := NewCommandStats()
+	commands.ObserveCommand(ctx, "/stat", time.Millisecond, nil)
+
+	h := NewHandler(HandlerOptions{
+		Start: time.Now().Add(-time.Hour),
+		Build: BuildInfo{
+			GoVersion: "go1.24.0",
+			Version:   "v0.120.0",
+			Commit:    "abcdef0",
+		},
+		Metrics:  m,
+		Commands: commands,
+		Accounts: accountStatsFunc(func() tgmanager.AccountStats {
+			return tgmanager.AccountStats{Running: 3, Leased: 1, Free: 2}
+		}),
+		Client: testClient{},
+	})
+
+	s := h.stats(ctx)
+	for _, expected := range []string{
+		"Version: v0.120.0",
+		"Go: go1.24.0",
+		"Commit: abcdef0",
+		"Uptime: 1h0m0s",
+		"Goroutines:",
+		"Messages: 10",
+		"/stat: 1 calls, 0 errors, p50 1ms",
+		"Accounts: 3 running, 1 leased, 2 free, 0 unhealthy",
+		"DC 2: connected",
+	} {
+		a.Contains(s, expected)
+	}
+	// Counters which are not wrapped are not reported.
+	a.NotContains(s, "Responses:")
+}
@@ -3,6 +3,7 @@ package app
 import (
 	"runtime/debug"
 	"strings"
+	"time"
 )
 
 // GetVersion optimistically gets current client version.
@@ -21,3 +22,39 @@ func GetVersion() string {
 	}
 	return ""
 }
+
+// BuildInfo describes current build.
+type BuildInfo struct {
+	// GoVersion is version of Go toolchain.
+	GoVersion string
+	// Version is version of gotd/td.
+	Version string
+	// Commit is short VCS revision.
+	Commit string
+	// Date is VCS commit time.
+	Date time.Time
+}
+
+// GetBuildInfo returns info of current build.
+func GetBuildInfo() BuildInfo {
+	info, ok := debug.ReadBuildInfo()
+	if !ok {
+		return BuildInfo{}
+	}
+	r := BuildInfo{
+		GoVersion: info.GoVersion,
+		Version:   GetVersion(),
+	}
+	for _, c := range info.Settings {
+		switch c.Key {
+		case "vcs.revision":
+			r.Commit = c.Value
+			if len(r.Commit) > 7 {
+				r.Commit = r.Commit[:7]
+			}
+		case "vcs.time":
+			r.Date, _ = time.Parse(time.RFC3339, c.Value)
+		}
+	}
+	return r
+}
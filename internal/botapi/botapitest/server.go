@@ -0,0 +1,187 @@
+// Package botapitest provides fake BotAPI server for tests.
+package botapitest
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/gotd/td/fileid"
+
+	"github.com/gotd/bot/internal/botapi"
+)
+
+// Token is bot token accepted by Server.
+const Token = "123456:test"
+
+// Server is fake BotAPI server.
+//
+// Supported methods are getFile, getUpdates, sendMessage and forwardMessage.
+type Server struct {
+	srv *httptest.Server
+
+	mux       sync.Mutex
+	lastID    int
+	updates   []botapi.Update
+	files     []string
+	sent      []botapi.Message
+	forwarded []botapi.Message
+}
+
+// NewServer creates and starts new Server.
+//
+// Server is closed on test cleanup.
+func NewServer(t testing.TB) *Server {
+	s := &Server{}
+	s.srv = httptest.NewServer(s)
+	t.Cleanup(s.srv.Close)
+	return s
+}
+
+// URL returns base URL of server.
+func (s *Server) URL() string {
+	return s.srv.URL
+}
+
+// Client returns BotAPI client connected to server.
+func (s *Server) Client() *botapi.Client {
+	return botapi.NewClient(Token, botapi.Options{
+		HTTPClient: s.srv.Client(),
+		BaseURL:    s.srv.URL,
+	})
+}
+
+// AddMessage adds message to updates returned by getUpdates.
+//
+// Added messages can be forwarded by forwardMessage.
+func (s *Server) AddMessage(msg botapi.Message) {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+	s.updates = append(s.updates, botapi.Update{
+		UpdateID: len(s.updates) + 1,
+		Message:  msg,
+	})
+}
+
+// Files returns file_id requested by getFile.
+func (s *Server) Files() []string {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+	return append([]string(nil), s.files...)
+}
+
+// Sent returns messages sent by sendMessage.
+func (s *Server) Sent() []botapi.Message {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+	return append([]botapi.Message(nil), s.sent...)
+}
+
+// Forwarded returns messages sent by forwardMessage.
+func (s *Server) Forwarded() []botapi.Message {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+	return append([]botapi.Message(nil), s.forwarded...)
+}
+
+// findMessage finds added message.
+func (s *Server) findMessage(chatID, msgID int) (botapi.Message, bool) {
+	for _, u := range s.updates {
+		if u.Message.Chat.ID == chatID && u.Message.MessageID == msgID {
+			return u.Message, true
+		}
+	}
+	return botapi.Message{}, false
+}
+
+func writeResult(w http.ResponseWriter, result interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(map[string]interface{}{
+		"ok":     true,
+		"result": result,
+	})
+}
+
+func writeError(w http.ResponseWriter, code int, description string) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(code)
+	_ = json.NewEncoder(w).Encode(map[string]interface{}{
+		"ok":          false,
+		"error_code":  code,
+		"description": description,
+	})
+}
+
+// ServeHTTP implements http.Handler.
+func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	method, ok := strings.CutPrefix(r.URL.Path, "/bot"+Token+"/")
+	if !ok {
+		writeError(w, http.StatusUnauthorized, "Unauthorized")
+		return
+	}
+	if r.Method != http.MethodPost {
+		writeError(w, http.StatusBadRequest, "Bad Request: expected POST")
+		return
+	}
+
+	var params struct {
+		FileID     string `json:"file_id"`
+		ChatID     int    `json:"chat_id"`
+		FromChatID int    `json:"from_chat_id"`
+		MessageID  int    `json:"message_id"`
+		Text       string `json:"text"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&params); err != nil {
+		writeError(w, http.StatusBadRequest, "Bad Request: "+err.Error())
+		return
+	}
+
+	s.mux.Lock()
+	defer s.mux.Unlock()
+
+	switch method {
+	case "getFile":
+		s.files = append(s.files, params.FileID)
+		if _, err := fileid.DecodeFileID(params.FileID); err != nil {
+			writeError(w, http.StatusBadRequest, "Bad Request: wrong file_id")
+			return
+		}
+		writeResult(w, botapi.File{FileID: params.FileID})
+	case "getUpdates":
+		writeResult(w, s.updates)
+	case "sendMessage":
+		s.lastID++
+		msg := botapi.Message{
+			MessageID: s.lastID,
+			Chat:      botapi.Chat{ID: params.ChatID},
+			Text:      params.Text,
+		}
+		s.sent = append(s.sent, msg)
+		writeResult(w, msg)
+	case "forwardMessage":
+		msg, ok := s.findMessage(params.FromChatID, params.MessageID)
+		if !ok {
+			writeError(w, http.StatusBadRequest, "Bad Request: message to forward not found")
+			return
+		}
+		if msg.ForwardOrigin == nil {
+			origin := botapi.MessageOrigin{Type: "user", Date: msg.Date, SenderUser: msg.From}
+			if msg.From == nil {
+				chat := msg.Chat
+				origin = botapi.MessageOrigin{Type: "chat", Date: msg.Date, SenderChat: &chat}
+			}
+			msg.ForwardOrigin = &origin
+		}
+		s.lastID++
+		msg.MessageID = s.lastID
+		msg.Chat = botapi.Chat{ID: params.ChatID}
+		msg.ReplyToMessage = nil
+		s.forwarded = append(s.forwarded, msg)
+		writeResult(w, msg)
+	default:
+		writeError(w, http.StatusNotFound, "Not Found: method not found")
+	}
+}
@@ -1,11 +1,11 @@
 package botapi
 
 import (
+	"bytes"
 	"context"
 	"encoding/json"
 	"fmt"
 	"net/http"
-	"net/url"
 
 	"github.com/go-faster/errors"
 	"go.uber.org/multierr"
@@ -14,6 +14,7 @@ import (
 // Client is simplified Telegram BotAPI client.
 type Client struct {
 	httpClient *http.Client
+	baseURL    string
 	token      string
 }
 
@@ -22,15 +23,45 @@ func NewClient(token string, opts Options) *Client {
 	opts.setDefaults()
 	return &Client{
 		httpClient: opts.HTTPClient,
+		baseURL:    opts.BaseURL,
 		token:      token,
 	}
 }
 
-func (m *Client) sendBotAPI(ctx context.Context, u string, result interface{}) (rErr error) {
-	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u, http.NoBody)
+// method returns URL of given BotAPI method.
+func (m *Client) method(name string) string {
+	return fmt.Sprintf("%s/bot%s/%s", m.baseURL, m.token, name)
+}
+
+// Error is BotAPI error response.
+type Error struct {
+	Code        int
+	Description string
+}
+
+func (e *Error) Error() string {
+	return fmt.Sprintf("API error %d: %s", e.Code, e.Description)
+}
+
+type response struct {
+	OK          bool            `json:"ok"`
+	ErrorCode   int             `json:"error_code"`
+	Description string          `json:"description"`
+	Result      json.RawMessage `json:"result"`
+}
+
+// sendBotAPI calls BotAPI method with JSON-encoded params and decodes result.
+func (m *Client) sendBotAPI(ctx context.Context, method string, params, result interface{}) (rErr error) {
+	body, err := json.Marshal(params)
+	if err != nil {
+		return errors.Wrap(err, "encode params")
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, m.method(method), bytes.NewReader(body))
 	if err != nil {
 		return errors.Wrap(err, "create request")
 	}
+	req.Header.Set("Content-Type", "application/json")
 
 	resp, err := m.httpClient.Do(req)
 	if err != nil {
@@ -38,31 +69,78 @@ func (m *Client) sendBotAPI(ctx context.Context, u string, result interface{}) (
 	}
 	defer multierr.AppendInvoke(&rErr, multierr.Close(resp.Body))
 
-	if err := json.NewDecoder(resp.Body).Decode(result); err != nil {
+	var r response
+	if err := json.NewDecoder(resp.Body).Decode(&r); err != nil {
 		return errors.Wrap(err, "decode json")
 	}
+	if !r.OK {
+		return &Error{Code: r.ErrorCode, Description: r.Description}
+	}
+	if result == nil {
+		return nil
+	}
+	if err := json.Unmarshal(r.Result, result); err != nil {
+		return errors.Wrap(err, "decode result")
+	}
 
 	return nil
 }
 
 // GetFile sends getFile request to BotAPI.
-func (m *Client) GetFile(ctx context.Context, id string) (rErr error) {
-	u := fmt.Sprintf("https://api.telegram.org/bot%s/getFile?file_id=%s", m.token, url.QueryEscape(id))
-	var result struct {
-		OK          bool   `json:"ok"`
-		ErrorCode   int    `json:"error_code"`
-		Description string `json:"description"`
-	}
-	if err := m.sendBotAPI(ctx, u, &result); err != nil {
+func (m *Client) GetFile(ctx context.Context, id string) error {
+	var result File
+	if err := m.sendBotAPI(ctx, "getFile", map[string]interface{}{
+		"file_id": id,
+	}, &result); err != nil {
 		return errors.Wrap(err, "send")
 	}
-	if !result.OK {
-		return errors.Errorf("API error %d: %s", result.ErrorCode, result.Description)
-	}
 
 	return nil
 }
 
+// GetChat sends getChat request to BotAPI.
+func (m *Client) GetChat(ctx context.Context, chatID int64) (Chat, error) {
+	var result Chat
+	if err := m.sendBotAPI(ctx, "getChat", map[string]interface{}{
+		"chat_id": chatID,
+	}, &result); err != nil {
+		return Chat{}, errors.Wrap(err, "send")
+	}
+
+	return result, nil
+}
+
+// SendMessage sends sendMessage request to BotAPI.
+func (m *Client) SendMessage(ctx context.Context, chatID int64, text string) (Message, error) {
+	var result Message
+	if err := m.sendBotAPI(ctx, "sendMessage", map[string]interface{}{
+		"chat_id": chatID,
+		"text":    text,
+	}, &result); err != nil {
+		return Message{}, errors.Wrap(err, "send")
+	}
+
+	return result, nil
+}
+
+// ForwardMessage sends forwardMessage request to BotAPI and returns forwarded message.
+//
+// Forwarded message has the same attachments as original one, so it can be used
+// to get BotAPI representation of message received via MTProto.
+func (m *Client) ForwardMessage(ctx context.Context, chatID, fromChatID int64, msgID int) (Message, error) {
+	var result Message
+	if err := m.sendBotAPI(ctx, "forwardMessage", map[string]interface{}{
+		"chat_id":              chatID,
+		"from_chat_id":         fromChatID,
+		"message_id":           msgID,
+		"disable_notification": true,
+	}, &result); err != nil {
+		return Message{}, errors.Wrap(err, "send")
+	}
+
+	return result, nil
+}
+
 type Update struct {
 	UpdateID int     `json:"update_id"`
 	Message  Message `json:"message"`
@@ -70,23 +148,17 @@ type Update struct {
 
 // GetBotAPIMessage sends getUpdates request to BotAPI and finds message by msg_id.
 //
-// NB: it can find only recently received messages.
+// NB: it can find only recently received messages and races with MTProto
+// update stream of the same bot, prefer ForwardMessage.
 func (m *Client) GetBotAPIMessage(ctx context.Context, msgID int) (Message, error) {
-	u := fmt.Sprintf(`https://api.telegram.org/bot%s/getUpdates?allowed_updates="message"`, m.token)
-	var resp struct {
-		OK          bool     `json:"ok"`
-		ErrorCode   int      `json:"error_code"`
-		Description string   `json:"description"`
-		Result      []Update `json:"result"`
-	}
-	if err := m.sendBotAPI(ctx, u, &resp); err != nil {
+	var result []Update
+	if err := m.sendBotAPI(ctx, "getUpdates", map[string]interface{}{
+		"allowed_updates": []string{"message"},
+	}, &result); err != nil {
 		return Message{}, errors.Wrap(err, "send")
 	}
-	if !resp.OK {
-		return Message{}, errors.Errorf("API error %d: %s", resp.ErrorCode, resp.Description)
-	}
 
-	for _, update := range resp.Result {
+	for _, update := range result {
 		if update.Message.MessageID == msgID {
 			return update.Message, nil
 		}
@@ -96,9 +168,11 @@ func (m *Client) GetBotAPIMessage(ctx context.Context, msgID int) (Message, erro
 }
 
 // GetFileIDFromMessage finds file_id in message attachments.
+//
+// For photos, file_id of the largest size is returned.
 func GetFileIDFromMessage(msg Message) (string, bool) {
 	if len(msg.Photo) > 0 {
-		return msg.Photo[0].FileID, true
+		return msg.Photo[len(msg.Photo)-1].FileID, true
 	}
 	switch {
 	case msg.Animation.FileID != "":
@@ -119,3 +193,21 @@ func GetFileIDFromMessage(msg Message) (string, bool) {
 
 	return "", false
 }
+
+// GetThumbnailFromMessage finds thumbnail of message attachment.
+func GetThumbnailFromMessage(msg Message) (PhotoSize, bool) {
+	for _, thumb := range []PhotoSize{
+		msg.Animation.Thumbnail,
+		msg.Audio.Thumbnail,
+		msg.Document.Thumbnail,
+		msg.Sticker.Thumbnail,
+		msg.Video.Thumbnail,
+		msg.VideoNote.Thumbnail,
+	} {
+		if thumb.FileID != "" {
+			return thumb, true
+		}
+	}
+
+	return PhotoSize{}, false
+}
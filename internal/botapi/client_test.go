@@ -0,0 +1,59 @@
+package botapi_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/go-faster/errors"
+	"github.com/gotd/td/fileid"
+	"github.com/stretchr/testify/require"
+
+	"github.com/gotd/bot/internal/botapi"
+	"github.com/gotd/bot/internal/botapi/botapitest"
+)
+
+func TestClient(t *testing.T) {
+	a := require.New(t)
+	ctx := context.Background()
+	srv := botapitest.NewServer(t)
+	client := srv.Client()
+
+	id, err := fileid.EncodeFileID(fileid.FileID{Type: fileid.Sticker, DC: 2, ID: 10})
+	a.NoError(err)
+	a.NoError(client.GetFile(ctx, id))
+
+	var apiErr *botapi.Error
+	a.True(errors.As(client.GetFile(ctx, "invalid"), &apiErr))
+	a.Equal(400, apiErr.Code)
+	a.Equal([]string{id, "invalid"}, srv.Files())
+
+	sent, err := client.SendMessage(ctx, 10, "hello")
+	a.NoError(err)
+	a.Equal(10, sent.Chat.ID)
+	a.Equal("hello", sent.Text)
+	a.Equal([]botapi.Message{sent}, srv.Sent())
+
+	srv.AddMessage(botapi.Message{MessageID: 5, Sticker: botapi.Sticker{FileID: id}})
+	msg, err := client.GetBotAPIMessage(ctx, 5)
+	a.NoError(err)
+	a.Equal(id, msg.Sticker.FileID)
+	_, err = client.GetBotAPIMessage(ctx, 6)
+	a.Error(err)
+
+	srv.AddMessage(botapi.Message{MessageID: 7, Chat: botapi.Chat{ID: 20}, Sticker: botapi.Sticker{FileID: id}})
+	fwd, err := client.ForwardMessage(ctx, 30, 20, 7)
+	a.NoError(err)
+	a.Equal(30, fwd.Chat.ID)
+	a.Equal(id, fwd.Sticker.FileID)
+	a.NotEqual(7, fwd.MessageID)
+	a.Equal([]botapi.Message{fwd}, srv.Forwarded())
+	_, err = client.ForwardMessage(ctx, 30, 10, 7)
+	a.True(errors.As(err, &apiErr))
+	a.Equal(400, apiErr.Code)
+
+	a.True(errors.As(
+		botapi.NewClient("invalid", botapi.Options{BaseURL: srv.URL()}).GetFile(ctx, id),
+		&apiErr,
+	))
+	a.Equal(401, apiErr.Code)
+}
@@ -6,7 +6,7 @@ type Animation struct {
 	Width        int       `json:"width"`
 	Height       int       `json:"height"`
 	Duration     int       `json:"duration"`
-	Thumb        PhotoSize `json:"thumb"`
+	Thumbnail    PhotoSize `json:"thumbnail"`
 	FileName     string    `json:"file_name"`
 	MimeType     string    `json:"mime_type"`
 	FileSize     int       `json:"file_size"`
@@ -18,7 +18,7 @@ type Audio struct {
 	Duration     int       `json:"duration"`
 	Performer    string    `json:"performer"`
 	Title        string    `json:"title"`
-	Thumb        PhotoSize `json:"thumb"`
+	Thumbnail    PhotoSize `json:"thumbnail"`
 	FileName     string    `json:"file_name"`
 	MimeType     string    `json:"mime_type"`
 	FileSize     int       `json:"file_size"`
@@ -27,7 +27,7 @@ type Audio struct {
 type Document struct {
 	FileID       string    `json:"file_id"`
 	FileUniqueID string    `json:"file_unique_id"`
-	Thumb        PhotoSize `json:"thumb"`
+	Thumbnail    PhotoSize `json:"thumbnail"`
 	FileName     string    `json:"file_name"`
 	MimeType     string    `json:"mime_type"`
 	FileSize     int       `json:"file_size"`
@@ -39,7 +39,7 @@ type Sticker struct {
 	Width        int          `json:"width"`
 	Height       int          `json:"height"`
 	IsAnimated   bool         `json:"is_animated"`
-	Thumb        PhotoSize    `json:"thumb"`
+	Thumbnail    PhotoSize    `json:"thumbnail"`
 	Emoji        string       `json:"emoji"`
 	SetName      string       `json:"set_name"`
 	MaskPosition MaskPosition `json:"mask_position"`
@@ -52,7 +52,7 @@ type Video struct {
 	Width        int       `json:"width"`
 	Height       int       `json:"height"`
 	Duration     int       `json:"duration"`
-	Thumb        PhotoSize `json:"thumb"`
+	Thumbnail    PhotoSize `json:"thumbnail"`
 	FileName     string    `json:"file_name"`
 	MimeType     string    `json:"mime_type"`
 	FileSize     int       `json:"file_size"`
@@ -63,7 +63,7 @@ type VideoNote struct {
 	FileUniqueID string    `json:"file_unique_id"`
 	Length       int       `json:"length"`
 	Duration     int       `json:"duration"`
-	Thumb        PhotoSize `json:"thumb"`
+	Thumbnail    PhotoSize `json:"thumbnail"`
 	FileSize     int       `json:"file_size"`
 }
 
@@ -76,29 +76,46 @@ type Voice struct {
 }
 
 type Chat struct {
-	ID            int      `json:"id"`
-	Type          string   `json:"type"`
-	Title         string   `json:"title"`
-	Username      string   `json:"username"`
-	FirstName     string   `json:"first_name"`
-	LastName      string   `json:"last_name"`
-	Bio           string   `json:"bio"`
-	Description   string   `json:"description"`
-	InviteLink    string   `json:"invite_link"`
-	PinnedMessage *Message `json:"pinned_message"`
+	ID            int        `json:"id"`
+	Type          string     `json:"type"`
+	Title         string     `json:"title"`
+	Username      string     `json:"username"`
+	FirstName     string     `json:"first_name"`
+	LastName      string     `json:"last_name"`
+	Bio           string     `json:"bio"`
+	Description   string     `json:"description"`
+	InviteLink    string     `json:"invite_link"`
+	Photo         *ChatPhoto `json:"photo"`
+	PinnedMessage *Message   `json:"pinned_message"`
+}
+
+type ChatPhoto struct {
+	SmallFileID       string `json:"small_file_id"`
+	SmallFileUniqueID string `json:"small_file_unique_id"`
+	BigFileID         string `json:"big_file_id"`
+	BigFileUniqueID   string `json:"big_file_unique_id"`
 }
 
 type Message struct {
-	MessageID int         `json:"message_id"`
-	Chat      Chat        `json:"chat"`
-	Animation Animation   `json:"animation"`
-	Audio     Audio       `json:"audio"`
-	Document  Document    `json:"document"`
-	Photo     []PhotoSize `json:"photo"`
-	Sticker   Sticker     `json:"sticker"`
-	Video     Video       `json:"video"`
-	VideoNote VideoNote   `json:"video_note"`
-	Voice     Voice       `json:"voice"`
+	MessageID       int             `json:"message_id"`
+	Date            int             `json:"date"`
+	From            *User           `json:"from"`
+	Chat            Chat            `json:"chat"`
+	Text            string          `json:"text"`
+	Entities        []MessageEntity `json:"entities"`
+	Caption         string          `json:"caption"`
+	CaptionEntities []MessageEntity `json:"caption_entities"`
+	ReplyToMessage  *Message        `json:"reply_to_message"`
+	ForwardOrigin   *MessageOrigin  `json:"forward_origin"`
+	IsTopicMessage  bool            `json:"is_topic_message"`
+	Animation       Animation       `json:"animation"`
+	Audio           Audio           `json:"audio"`
+	Document        Document        `json:"document"`
+	Photo           []PhotoSize     `json:"photo"`
+	Sticker         Sticker         `json:"sticker"`
+	Video           Video           `json:"video"`
+	VideoNote       VideoNote       `json:"video_note"`
+	Voice           Voice           `json:"voice"`
 }
 
 type PhotoSize struct {
@@ -115,3 +132,39 @@ type MaskPosition struct {
 	YShift float64 `json:"y_shift"`
 	Scale  float64 `json:"scale"`
 }
+
+type File struct {
+	FileID       string `json:"file_id"`
+	FileUniqueID string `json:"file_unique_id"`
+	FileSize     int    `json:"file_size"`
+	FilePath     string `json:"file_path"`
+}
+
+type User struct {
+	ID        int64  `json:"id"`
+	IsBot     bool   `json:"is_bot"`
+	FirstName string `json:"first_name"`
+	LastName  string `json:"last_name"`
+	Username  string `json:"username"`
+}
+
+type MessageEntity struct {
+	Type          string `json:"type"`
+	Offset        int    `json:"offset"`
+	Length        int    `json:"length"`
+	URL           string `json:"url"`
+	User          *User  `json:"user"`
+	Language      string `json:"language"`
+	CustomEmojiID string `json:"custom_emoji_id"`
+}
+
+type MessageOrigin struct {
+	Type            string `json:"type"`
+	Date            int    `json:"date"`
+	SenderUser      *User  `json:"sender_user"`
+	SenderUserName  string `json:"sender_user_name"`
+	SenderChat      *Chat  `json:"sender_chat"`
+	Chat            *Chat  `json:"chat"`
+	MessageID       int    `json:"message_id"`
+	AuthorSignature string `json:"author_signature"`
+}
@@ -2,15 +2,27 @@ package botapi
 
 import (
 	"net/http"
+	"strings"
 )
 
+// DefaultBaseURL is base URL of public Telegram BotAPI server.
+const DefaultBaseURL = "https://api.telegram.org"
+
 // Options is Client options.
 type Options struct {
 	HTTPClient *http.Client
+	// BaseURL is BotAPI server URL, e.g. of self-hosted telegram-bot-api.
+	//
+	// Defaults to DefaultBaseURL.
+	BaseURL string
 }
 
 func (m *Options) setDefaults() {
 	if m.HTTPClient == nil {
 		m.HTTPClient = http.DefaultClient
 	}
+	if m.BaseURL == "" {
+		m.BaseURL = DefaultBaseURL
+	}
+	m.BaseURL = strings.TrimSuffix(m.BaseURL, "/")
 }
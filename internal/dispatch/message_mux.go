@@ -3,8 +3,10 @@ package dispatch
 import (
 	"context"
 	"strings"
+	"time"
 
 	"github.com/go-faster/errors"
+	"go.opentelemetry.io/otel/trace"
 
 	"github.com/gotd/td/tg"
 )
@@ -14,9 +16,16 @@ type handle struct {
 	description string
 }
 
+// CommandObserver observes handled commands.
+type CommandObserver interface {
+	ObserveCommand(ctx context.Context, command string, duration time.Duration, err error)
+}
+
 // MessageMux is message event router.
 type MessageMux struct {
-	prefixes map[string]handle
+	prefixes  map[string]handle
+	observer  CommandObserver
+	telemetry *muxTelemetry
 }
 
 // NewMessageMux creates new MessageMux.
@@ -24,6 +33,12 @@ func NewMessageMux() MessageMux {
 	return MessageMux{prefixes: map[string]handle{}}
 }
 
+// WithObserver sets command observer.
+func (m MessageMux) WithObserver(observer CommandObserver) MessageMux {
+	m.observer = observer
+	return m
+}
+
 // Handle adds given prefix and handler to the mux.
 func (m MessageMux) Handle(prefix, description string, handler MessageHandler) {
 	m.prefixes[prefix] = handle{
@@ -37,11 +52,32 @@ func (m MessageMux) HandleFunc(prefix, description string, handler func(ctx cont
 	m.Handle(prefix, description, MessageHandlerFunc(handler))
 }
 
+// handle calls command handler and records its result.
+func (m MessageMux) handle(ctx context.Context, prefix string, handler MessageHandler, e MessageEvent) error {
+	span := trace.SpanFromContext(ctx)
+	if m.telemetry != nil {
+		ctx, span = m.telemetry.start(ctx, prefix, e)
+		defer span.End()
+	}
+
+	start := time.Now()
+	err := handler.OnMessage(ctx, e)
+	duration := time.Since(start)
+
+	if m.telemetry != nil {
+		m.telemetry.record(ctx, span, prefix, duration, err)
+	}
+	if m.observer != nil {
+		m.observer.ObserveCommand(ctx, prefix, duration, err)
+	}
+	return err
+}
+
 // OnMessage implements MessageHandler.
 func (m MessageMux) OnMessage(ctx context.Context, e MessageEvent) error {
 	for prefix, handler := range m.prefixes {
 		if strings.HasPrefix(e.Message.Message, prefix) {
-			if err := handler.OnMessage(ctx, e); err != nil {
+			if err := m.handle(ctx, prefix, handler, e); err != nil {
 				return errors.Wrapf(err, "handle %q", prefix)
 			}
 			return nil
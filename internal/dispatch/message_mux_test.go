@@ -2,7 +2,10 @@ package dispatch
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"testing"
+	"time"
 
 	"github.com/gotd/td/tg"
 	"github.com/stretchr/testify/require"
@@ -33,3 +36,28 @@ func TestMessageMux_OnMessage(t *testing.T) {
 	send("/github")
 	a.Equal(1, calls)
 }
+
+type observerFunc func(ctx context.Context, command string, duration time.Duration, err error)
+
+func (f observerFunc) ObserveCommand(ctx context.Context, command string, duration time.Duration, err error) {
+	f(ctx, command, duration, err)
+}
+
+func TestMessageMux_WithObserver(t *testing.T) {
+	a := require.New(t)
+	ctx := context.Background()
+
+	var observed []string
+	mux := NewMessageMux().WithObserver(observerFunc(
+		func(ctx context.Context, command string, duration time.Duration, err error) {
+			observed = append(observed, fmt.Sprintf("%s %v", command, err))
+		},
+	))
+	mux.HandleFunc("/fail", "test", func(ctx context.Context, e MessageEvent) error {
+		return errors.New("failed")
+	})
+
+	a.Error(mux.OnMessage(ctx, MessageEvent{Message: &tg.Message{Message: "/fail"}}))
+	a.NoError(mux.OnMessage(ctx, MessageEvent{Message: &tg.Message{Message: "text"}}))
+	a.Equal([]string{"/fail failed"}, observed)
+}
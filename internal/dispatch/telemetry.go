@@ -0,0 +1,121 @@
+package dispatch
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"time"
+
+	"github.com/go-faster/errors"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/gotd/td/tg"
+)
+
+// muxTelemetry traces and measures commands handled by MessageMux.
+type muxTelemetry struct {
+	// peerKey is per-process random key of peer hash.
+	peerKey  []byte
+	tracer   trace.Tracer
+	duration metric.Float64Histogram
+	errors   metric.Int64Counter
+}
+
+// WithTelemetry enables per-command spans, latency histogram and error counter.
+//
+// Span context is passed to command handler, so RPC calls made by handler are
+// children of command span.
+func (m MessageMux) WithTelemetry(meterProvider metric.MeterProvider, tracerProvider trace.TracerProvider) (MessageMux, error) {
+	meter := meterProvider.Meter("bot.gotd.dev/dispatch")
+	t := &muxTelemetry{
+		peerKey: make([]byte, sha256.Size),
+		tracer:  tracerProvider.Tracer("bot.gotd.dev/dispatch"),
+	}
+	if _, err := rand.Read(t.peerKey); err != nil {
+		return m, errors.Wrap(err, "peer hash key")
+	}
+
+	var err error
+	if t.duration, err = meter.Float64Histogram("gotd.bot.command.duration",
+		metric.WithDescription("Command handling duration"),
+		metric.WithUnit("s"),
+	); err != nil {
+		return m, errors.Wrap(err, "command duration")
+	}
+	if t.errors, err = meter.Int64Counter("gotd.bot.command.errors",
+		metric.WithDescription("Count of failed commands"),
+	); err != nil {
+		return m, errors.Wrap(err, "command errors")
+	}
+
+	m.telemetry = t
+	return m, nil
+}
+
+// chatType returns BotAPI-like type of event chat.
+func chatType(e MessageEvent) string {
+	if _, ok := e.User(); ok {
+		return "private"
+	}
+	if _, ok := e.Chat(); ok {
+		return "group"
+	}
+	if channel, ok := e.Channel(); ok {
+		if channel.Broadcast {
+			return "channel"
+		}
+		return "supergroup"
+	}
+	return "unknown"
+}
+
+// peerHash returns keyed hash of message peer, so traces can be correlated
+// without exposing peer IDs.
+//
+// Key is random and never leaves the process, so hash can't be reversed by
+// enumerating IDs, but is stable only until restart.
+func peerHash(key []byte, p tg.PeerClass) string {
+	var s string
+	switch p := p.(type) {
+	case *tg.PeerUser:
+		s = fmt.Sprintf("user:%d", p.UserID)
+	case *tg.PeerChat:
+		s = fmt.Sprintf("chat:%d", p.ChatID)
+	case *tg.PeerChannel:
+		s = fmt.Sprintf("channel:%d", p.ChannelID)
+	default:
+		return ""
+	}
+	h := hmac.New(sha256.New, key)
+	_, _ = h.Write([]byte(s))
+	return hex.EncodeToString(h.Sum(nil)[:8])
+}
+
+// start starts command span.
+func (t *muxTelemetry) start(ctx context.Context, command string, e MessageEvent) (context.Context, trace.Span) {
+	return t.tracer.Start(ctx, command,
+		trace.WithSpanKind(trace.SpanKindServer),
+		trace.WithAttributes(
+			attribute.String("bot.command", command),
+			attribute.String("bot.chat.type", chatType(e)),
+			attribute.String("bot.peer.hash", peerHash(t.peerKey, e.Message.PeerID)),
+		),
+	)
+}
+
+// record records command result.
+func (t *muxTelemetry) record(ctx context.Context, span trace.Span, command string, duration time.Duration, err error) {
+	attrs := metric.WithAttributes(attribute.String("command", command))
+	t.duration.Record(ctx, duration.Seconds(), attrs)
+	if err != nil {
+		t.errors.Add(ctx, 1, attrs)
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+}
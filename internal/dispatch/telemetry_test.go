@@ -0,0 +1,75 @@
+package dispatch
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/gotd/td/tg"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+)
+
+func TestPeerHash(t *testing.T) {
+	a := require.New(t)
+
+	key := []byte("key")
+	user := peerHash(key, &tg.PeerUser{UserID: 10})
+	a.Len(user, 16)
+	a.Equal(user, peerHash(key, &tg.PeerUser{UserID: 10}))
+	a.NotEqual(user, peerHash(key, &tg.PeerChannel{ChannelID: 10}))
+	a.NotEqual(user, peerHash([]byte("other"), &tg.PeerUser{UserID: 10}))
+	a.Empty(peerHash(key, nil))
+}
+
+func TestMessageMux_WithTelemetry(t *testing.T) {
+	a := require.New(t)
+	ctx := context.Background()
+
+	spans := tracetest.NewSpanRecorder()
+	reader := sdkmetric.NewManualReader()
+	mux, err := NewMessageMux().WithTelemetry(
+		sdkmetric.NewMeterProvider(sdkmetric.WithReader(reader)),
+		sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(spans)),
+	)
+	a.NoError(err)
+
+	var handlerSpan trace.SpanContext
+	mux.HandleFunc("/fail", "test", func(ctx context.Context, e MessageEvent) error {
+		handlerSpan = trace.SpanContextFromContext(ctx)
+		return errors.New("failed")
+	})
+
+	a.Error(mux.OnMessage(ctx, MessageEvent{
+		Message: &tg.Message{
+			Message: "/fail",
+			PeerID:  &tg.PeerUser{UserID: 10},
+		},
+		user: &tg.User{ID: 10},
+	}))
+
+	ended := spans.Ended()
+	a.Len(ended, 1)
+	span := ended[0]
+	a.Equal("/fail", span.Name())
+	a.Equal(handlerSpan, span.SpanContext(), "span should be passed to handler")
+	a.Equal(codes.Error, span.Status().Code)
+	a.Contains(span.Attributes(), attribute.String("bot.chat.type", "private"))
+	a.Contains(span.Attributes(), attribute.String("bot.peer.hash", peerHash(mux.telemetry.peerKey, &tg.PeerUser{UserID: 10})))
+
+	var rm metricdata.ResourceMetrics
+	a.NoError(reader.Collect(ctx, &rm))
+	a.Len(rm.ScopeMetrics, 1)
+	recorded := map[string]bool{}
+	for _, m := range rm.ScopeMetrics[0].Metrics {
+		recorded[m.Name] = true
+	}
+	a.True(recorded["gotd.bot.command.duration"])
+	a.True(recorded["gotd.bot.command.errors"])
+}
@@ -0,0 +1,3 @@
+// Package echo contains bot command handler which downloads replied media,
+// uploads it back and compares hashes.
+package echo
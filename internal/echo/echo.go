@@ -0,0 +1,252 @@
+package echo
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/go-faster/errors"
+	"github.com/gotd/td/constant"
+	"github.com/gotd/td/telegram/downloader"
+	"github.com/gotd/td/telegram/message"
+	"github.com/gotd/td/telegram/message/unpack"
+	"github.com/gotd/td/telegram/uploader"
+	"github.com/gotd/td/tg"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+	"go.uber.org/zap"
+
+	"github.com/gotd/bot/internal/dispatch"
+)
+
+// Upload paths.
+const (
+	pathSmall = "small"
+	pathBig   = "big"
+)
+
+// Handler implements echo request handler.
+type Handler struct {
+	downloader *downloader.Downloader
+	opts       Options
+}
+
+// New creates new Handler.
+//
+// Invalid upload options are reported here, not on first upload.
+func New(d *downloader.Downloader, opts Options) (Handler, error) {
+	opts.setDefaults()
+	if err := opts.validate(); err != nil {
+		return Handler{}, errors.Wrap(err, "validate options")
+	}
+	return Handler{
+		downloader: d,
+		opts:       opts,
+	}, nil
+}
+
+// media is downloadable message attachment.
+type media struct {
+	Name     string
+	Size     int64
+	Location tg.InputFileLocationClass
+}
+
+func documentName(doc *tg.Document) string {
+	for _, attr := range doc.Attributes {
+		if a, ok := attr.(*tg.DocumentAttributeFilename); ok && a.FileName != "" {
+			return a.FileName
+		}
+	}
+	return fmt.Sprintf("%d", doc.ID)
+}
+
+// findMedia returns downloadable attachment of message.
+func findMedia(msg *tg.Message) (media, bool) {
+	switch m := msg.Media.(type) {
+	case *tg.MessageMediaDocument:
+		doc, ok := m.Document.AsNotEmpty()
+		if !ok {
+			return media{}, false
+		}
+		return media{
+			Name:     documentName(doc),
+			Size:     doc.Size,
+			Location: doc.AsInputDocumentFileLocation(),
+		}, true
+	case *tg.MessageMediaPhoto:
+		p, ok := m.Photo.AsNotEmpty()
+		if !ok {
+			return media{}, false
+		}
+		var (
+			typ  string
+			size int
+		)
+		for _, s := range p.Sizes {
+			switch s := s.(type) {
+			case *tg.PhotoSize:
+				if s.Size > size {
+					typ, size = s.Type, s.Size
+				}
+			case *tg.PhotoSizeProgressive:
+				if n := len(s.Sizes); n > 0 && s.Sizes[n-1] > size {
+					typ, size = s.Type, s.Sizes[n-1]
+				}
+			}
+		}
+		if typ == "" {
+			return media{}, false
+		}
+		return media{
+			Name: fmt.Sprintf("%d.jpg", p.ID),
+			Size: int64(size),
+			Location: &tg.InputPhotoFileLocation{
+				ID:            p.ID,
+				AccessHash:    p.AccessHash,
+				FileReference: p.FileReference,
+				ThumbSize:     typ,
+			},
+		}, true
+	default:
+		return media{}, false
+	}
+}
+
+// sentDocument returns document of sent message.
+func sentDocument(msg *tg.Message) (*tg.Document, bool) {
+	m, ok := msg.Media.(*tg.MessageMediaDocument)
+	if !ok {
+		return nil, false
+	}
+	return m.Document.AsNotEmpty()
+}
+
+// result is upload round-trip result.
+type result struct {
+	Path       string
+	Duration   time.Duration
+	Throughput float64
+	Match      bool
+	Err        error
+}
+
+func (r result) String() string {
+	if r.Err != nil {
+		return fmt.Sprintf("%s: %s", r.Path, r.Err)
+	}
+	status := "hash match"
+	if !r.Match {
+		status = "HASH MISMATCH"
+	}
+	return fmt.Sprintf("%s: %s, %.0f KB/s, %s",
+		r.Path, r.Duration.Round(time.Millisecond), r.Throughput/1024, status,
+	)
+}
+
+func (h Handler) download(ctx context.Context, rpc *tg.Client, loc tg.InputFileLocationClass) ([]byte, error) {
+	var buf bytes.Buffer
+	if _, err := h.downloader.Download(rpc, loc).Stream(ctx, &buf); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// roundTrip uploads data using given path, sends it back and compares hash of
+// sent document with original one.
+func (h Handler) roundTrip(ctx context.Context, e dispatch.MessageEvent, m media, data []byte, path string) result {
+	r := result{Path: path}
+
+	total := int64(len(data))
+	if path == pathBig {
+		// Unknown size forces upload.saveBigFilePart.
+		total = -1
+	}
+
+	start := time.Now()
+	f, err := uploader.NewUploader(e.RPC()).
+		WithPartSize(h.opts.PartSize).
+		WithThreads(h.opts.Threads).
+		Upload(ctx, uploader.NewUpload(m.Name, bytes.NewReader(data), total))
+	if err != nil {
+		r.Err = errors.Wrap(err, "upload")
+		return r
+	}
+	r.Duration = time.Since(start)
+	if seconds := r.Duration.Seconds(); seconds > 0 {
+		r.Throughput = float64(len(data)) / seconds
+		h.opts.Throughput.Record(ctx, r.Throughput, metric.WithAttributes(
+			attribute.String("path", path),
+		))
+	}
+
+	sent, err := unpack.Message(e.Reply().Media(ctx,
+		message.UploadedDocument(f).Filename(m.Name).ForceFile(true),
+	))
+	if err != nil {
+		r.Err = errors.Wrap(err, "send")
+		return r
+	}
+	doc, ok := sentDocument(sent)
+	if !ok {
+		r.Err = errors.Errorf("unexpected media %T", sent.Media)
+		return r
+	}
+
+	echoed, err := h.download(ctx, e.RPC(), doc.AsInputDocumentFileLocation())
+	if err != nil {
+		r.Err = errors.Wrap(err, "download sent")
+		return r
+	}
+	r.Match = sha256.Sum256(echoed) == sha256.Sum256(data)
+	return r
+}
+
+// OnMessage implements dispatch.MessageHandler.
+func (h Handler) OnMessage(ctx context.Context, e dispatch.MessageEvent) error {
+	return e.WithReply(ctx, func(reply *tg.Message) error {
+		m, ok := findMedia(reply)
+		if !ok {
+			_, err := e.Reply().Text(ctx, "Replied message has no media")
+			return err
+		}
+		if m.Size > h.opts.MaxSize {
+			_, err := e.Reply().Textf(ctx, "Media is too big: %d > %d bytes", m.Size, h.opts.MaxSize)
+			return err
+		}
+
+		data, err := h.download(ctx, e.RPC(), m.Location)
+		if err != nil {
+			return errors.Wrap(err, "download")
+		}
+
+		paths := []string{pathBig}
+		if int64(len(data)) <= constant.UploadMaxSmallSize {
+			paths = append([]string{pathSmall}, paths...)
+		}
+
+		var w strings.Builder
+		fmt.Fprintf(&w, "Upload round-trip of %d bytes (part %d, threads %d):\n",
+			len(data), h.opts.PartSize, h.opts.Threads,
+		)
+		for _, path := range paths {
+			r := h.roundTrip(ctx, e, m, data, path)
+			if r.Err != nil || !r.Match {
+				h.opts.Logger.Warn("Upload round-trip failed",
+					zap.String("path", path),
+					zap.Bool("match", r.Match),
+					zap.Error(r.Err),
+				)
+			}
+			fmt.Fprintf(&w, "\n%s", r)
+		}
+
+		if _, err := e.Reply().Text(ctx, w.String()); err != nil {
+			return errors.Wrap(err, "send")
+		}
+		return nil
+	})
+}
@@ -0,0 +1,269 @@
+package echo
+
+import (
+	"bytes"
+	"context"
+	"sort"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/go-faster/errors"
+	"github.com/gotd/td/bin"
+	"github.com/gotd/td/telegram/downloader"
+	"github.com/gotd/td/tg"
+	"github.com/stretchr/testify/require"
+
+	"github.com/gotd/bot/internal/dispatch"
+)
+
+func TestFindMedia(t *testing.T) {
+	a := require.New(t)
+
+	m, ok := findMedia(&tg.Message{Media: &tg.MessageMediaDocument{Document: &tg.Document{
+		ID:   10,
+		Size: 1024,
+		Attributes: []tg.DocumentAttributeClass{
+			&tg.DocumentAttributeFilename{FileName: "file.bin"},
+		},
+	}}})
+	a.True(ok)
+	a.Equal("file.bin", m.Name)
+	a.Equal(int64(1024), m.Size)
+
+	m, ok = findMedia(&tg.Message{Media: &tg.MessageMediaPhoto{Photo: &tg.Photo{
+		ID: 10,
+		Sizes: []tg.PhotoSizeClass{
+			&tg.PhotoStrippedSize{Type: "i"},
+			&tg.PhotoSize{Type: "m", Size: 100},
+			&tg.PhotoSizeProgressive{Type: "y", Sizes: []int{100, 500, 1000}},
+		},
+	}}})
+	a.True(ok)
+	a.Equal("10.jpg", m.Name)
+	a.Equal(int64(1000), m.Size)
+	a.Equal("y", m.Location.(*tg.InputPhotoFileLocation).ThumbSize)
+
+	_, ok = findMedia(&tg.Message{Media: &tg.MessageMediaDice{}})
+	a.False(ok)
+}
+
+func TestResult_String(t *testing.T) {
+	a := require.New(t)
+
+	a.Equal("small: 500ms, 2 KB/s, hash match", result{
+		Path:       pathSmall,
+		Duration:   500 * time.Millisecond,
+		Throughput: 2048,
+		Match:      true,
+	}.String())
+	a.Equal("big: 0s, 0 KB/s, HASH MISMATCH", result{Path: pathBig}.String())
+	a.Equal("big: upload: fail", result{Path: pathBig, Err: errors.New("upload: fail")}.String())
+}
+
+func TestNew(t *testing.T) {
+	for _, tt := range []struct {
+		Name string
+		Opts Options
+		Err  bool
+	}{
+		{Name: "Default", Opts: Options{}},
+		{Name: "Max", Opts: Options{PartSize: 512 * 1024, Threads: 4}},
+		{Name: "NotPadded", Opts: Options{PartSize: 1000}, Err: true},
+		{Name: "NotDivisor", Opts: Options{PartSize: 3 * 1024}, Err: true},
+		{Name: "TooBig", Opts: Options{PartSize: 1024 * 1024}, Err: true},
+		{Name: "Negative", Opts: Options{PartSize: -1024}, Err: true},
+		{Name: "Threads", Opts: Options{Threads: -1}, Err: true},
+	} {
+		t.Run(tt.Name, func(t *testing.T) {
+			_, err := New(downloader.NewDownloader(), tt.Opts)
+			if tt.Err {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+// fakeTelegram is fake Telegram server which stores uploaded files and
+// serves them as documents.
+type fakeTelegram struct {
+	mux   sync.Mutex
+	files map[int64][]byte         // by document ID
+	parts map[int64]map[int][]byte // by uploaded file ID
+	calls map[string]int           // by request type
+	texts []string
+	// corrupt flips first byte of echoed files.
+	corrupt bool
+}
+
+func newFakeTelegram(data []byte) *fakeTelegram {
+	return &fakeTelegram{
+		files: map[int64][]byte{1: data},
+		parts: map[int64]map[int][]byte{},
+		calls: map[string]int{},
+	}
+}
+
+func (f *fakeTelegram) savePart(fileID int64, part int, data []byte) tg.BoolClass {
+	if f.parts[fileID] == nil {
+		f.parts[fileID] = map[int][]byte{}
+	}
+	f.parts[fileID][part] = append([]byte(nil), data...)
+	return &tg.BoolTrue{}
+}
+
+func (f *fakeTelegram) sendMedia(req *tg.MessagesSendMediaRequest) (tg.UpdatesClass, error) {
+	media, ok := req.Media.(*tg.InputMediaUploadedDocument)
+	if !ok {
+		return nil, errors.Errorf("unexpected media %T", req.Media)
+	}
+	var fileID int64
+	switch file := media.File.(type) {
+	case *tg.InputFile:
+		fileID = file.ID
+	case *tg.InputFileBig:
+		fileID = file.ID
+	}
+
+	parts := f.parts[fileID]
+	ids := make([]int, 0, len(parts))
+	for id := range parts {
+		ids = append(ids, id)
+	}
+	sort.Ints(ids)
+	var data []byte
+	for _, id := range ids {
+		data = append(data, parts[id]...)
+	}
+	if f.corrupt && len(data) > 0 {
+		data[0] ^= 0xff
+	}
+	f.files[fileID] = data
+
+	return &tg.Updates{Updates: []tg.UpdateClass{&tg.UpdateNewMessage{Message: &tg.Message{
+		ID:     100 + len(f.files),
+		PeerID: &tg.PeerUser{UserID: 1},
+		Media: &tg.MessageMediaDocument{Document: &tg.Document{
+			ID:   fileID,
+			Size: int64(len(data)),
+		}},
+	}}}}, nil
+}
+
+func (f *fakeTelegram) handle(input bin.Encoder) (bin.Encoder, error) {
+	f.mux.Lock()
+	defer f.mux.Unlock()
+
+	switch req := input.(type) {
+	case *tg.MessagesGetMessagesRequest:
+		f.calls["getMessages"]++
+		return &tg.MessagesMessages{Messages: []tg.MessageClass{&tg.Message{
+			ID:     1,
+			PeerID: &tg.PeerUser{UserID: 1},
+			Media: &tg.MessageMediaDocument{Document: &tg.Document{
+				ID:   1,
+				Size: int64(len(f.files[1])),
+			}},
+		}}}, nil
+	case *tg.UploadGetFileRequest:
+		f.calls["getFile"]++
+		loc, ok := req.Location.(*tg.InputDocumentFileLocation)
+		if !ok {
+			return nil, errors.Errorf("unexpected location %T", req.Location)
+		}
+		data := f.files[loc.ID]
+		end := min(req.Offset+int64(req.Limit), int64(len(data)))
+		var chunk []byte
+		if req.Offset < end {
+			chunk = data[req.Offset:end]
+		}
+		return &tg.UploadFile{Type: &tg.StorageFileUnknown{}, Bytes: chunk}, nil
+	case *tg.UploadSaveFilePartRequest:
+		f.calls["saveFilePart"]++
+		return f.savePart(req.FileID, req.FilePart, req.Bytes), nil
+	case *tg.UploadSaveBigFilePartRequest:
+		f.calls["saveBigFilePart"]++
+		return f.savePart(req.FileID, req.FilePart, req.Bytes), nil
+	case *tg.MessagesSendMediaRequest:
+		f.calls["sendMedia"]++
+		return f.sendMedia(req)
+	case *tg.MessagesSendMessageRequest:
+		f.texts = append(f.texts, req.Message)
+		return &tg.Updates{}, nil
+	default:
+		return nil, errors.Errorf("unexpected request %T", input)
+	}
+}
+
+// Invoke implements tg.Invoker.
+func (f *fakeTelegram) Invoke(ctx context.Context, input bin.Encoder, output bin.Decoder) error {
+	r, err := f.handle(input)
+	if err != nil {
+		return err
+	}
+	var b bin.Buffer
+	if err := r.Encode(&b); err != nil {
+		return err
+	}
+	return output.Decode(&b)
+}
+
+func runEcho(t *testing.T, f *fakeTelegram) string {
+	t.Helper()
+	a := require.New(t)
+	ctx := context.Background()
+
+	h, err := New(downloader.NewDownloader(), Options{PartSize: 1024})
+	a.NoError(err)
+
+	bot := dispatch.NewBot(tg.NewClient(f)).OnMessage(h)
+	msg := &tg.Message{
+		ID:      2,
+		PeerID:  &tg.PeerUser{UserID: 1},
+		Message: "/echo",
+		ReplyTo: &tg.MessageReplyHeader{ReplyToMsgID: 1},
+	}
+	msg.SetFlags()
+	a.NoError(bot.OnNewMessage(ctx, tg.Entities{
+		Users: map[int64]*tg.User{1: {ID: 1}},
+	}, &tg.UpdateNewMessage{Message: msg}))
+
+	a.Len(f.texts, 1)
+	return f.texts[0]
+}
+
+func TestHandler_OnMessage(t *testing.T) {
+	a := require.New(t)
+
+	// Three parts of 1024 bytes. Uploader writes total parts count of upload
+	// with unknown size while parts are sent if last part is not full, which
+	// is reported by race detector, so last part is full.
+	data := bytes.Repeat([]byte("echo"), 768)
+	f := newFakeTelegram(data)
+	text := runEcho(t, f)
+
+	a.Contains(text, "Upload round-trip of 3072 bytes (part 1024, threads 1)")
+	a.Contains(text, "small: ")
+	a.Contains(text, "big: ")
+	a.NotContains(text, "MISMATCH")
+	a.Equal(3, f.calls["saveFilePart"])
+	a.Equal(3, f.calls["saveBigFilePart"])
+	a.Equal(2, f.calls["sendMedia"])
+	for id, file := range f.files {
+		a.Equal(data, file, "file %d", id)
+	}
+}
+
+func TestHandler_OnMessageMismatch(t *testing.T) {
+	a := require.New(t)
+
+	f := newFakeTelegram(bytes.Repeat([]byte("echo"), 768))
+	f.corrupt = true
+	text := runEcho(t, f)
+
+	a.Contains(text, "small: ")
+	a.Contains(text, "big: ")
+	a.Equal(2, bytes.Count([]byte(text), []byte("HASH MISMATCH")))
+}
@@ -0,0 +1,58 @@
+package echo
+
+import (
+	"github.com/go-faster/errors"
+	"github.com/gotd/td/constant"
+	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/metric/noop"
+	"go.uber.org/zap"
+)
+
+// Options is Handler options.
+type Options struct {
+	// PartSize is upload part size, must be divisible by 1024 and
+	// 524288 must be divisible by it.
+	//
+	// Defaults to 128KB.
+	PartSize int
+	// Threads is count of upload goroutines per file.
+	//
+	// Defaults to 1.
+	Threads int
+	// MaxSize is maximum size of media, defaults to 20MB.
+	MaxSize int64
+	// Throughput records upload throughput in bytes per second.
+	Throughput metric.Float64Histogram
+	Logger     *zap.Logger
+}
+
+func (o *Options) setDefaults() {
+	if o.PartSize == 0 {
+		o.PartSize = 128 * 1024
+	}
+	if o.Threads == 0 {
+		o.Threads = 1
+	}
+	if o.MaxSize == 0 {
+		o.MaxSize = 20 * 1024 * 1024
+	}
+	if o.Throughput == nil {
+		o.Throughput, _ = noop.NewMeterProvider().Meter("").Float64Histogram("")
+	}
+	if o.Logger == nil {
+		o.Logger = zap.NewNop()
+	}
+}
+
+// validate checks options with defaults set.
+func (o Options) validate() error {
+	switch {
+	case o.PartSize <= 0 || o.PartSize%constant.UploadPadding != 0:
+		return errors.Errorf("part size %d is not divisible by %d", o.PartSize, constant.UploadPadding)
+	case constant.UploadMaxPartSize%o.PartSize != 0:
+		return errors.Errorf("%d is not divisible by part size %d", constant.UploadMaxPartSize, o.PartSize)
+	case o.Threads <= 0:
+		return errors.Errorf("invalid threads count %d", o.Threads)
+	}
+	return nil
+}
@@ -16,10 +16,12 @@ import (
 	"entgo.io/ent/dialect"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/gotd/bot/internal/ent/fileidcheck"
 	"github.com/gotd/bot/internal/ent/lastchannelmessage"
 	"github.com/gotd/bot/internal/ent/prnotification"
 	"github.com/gotd/bot/internal/ent/telegramaccount"
 	"github.com/gotd/bot/internal/ent/telegramchannelstate"
+	"github.com/gotd/bot/internal/ent/telegramservicemessage"
 	"github.com/gotd/bot/internal/ent/telegramsession"
 	"github.com/gotd/bot/internal/ent/telegramuserstate"
 )
@@ -29,6 +31,8 @@ type Client struct {
 	config
 	// Schema is the client for creating, migrating and dropping schema.
 	Schema *migrate.Schema
+	// FileIDCheck is the client for interacting with the FileIDCheck builders.
+	FileIDCheck *FileIDCheckClient
 	// LastChannelMessage is the client for interacting with the LastChannelMessage builders.
 	LastChannelMessage *LastChannelMessageClient
 	// PRNotification is the client for interacting with the PRNotification builders.
@@ -37,6 +41,8 @@ type Client struct {
 	TelegramAccount *TelegramAccountClient
 	// TelegramChannelState is the client for interacting with the TelegramChannelState builders.
 	TelegramChannelState *TelegramChannelStateClient
+	// TelegramServiceMessage is the client for interacting with the TelegramServiceMessage builders.
+	TelegramServiceMessage *TelegramServiceMessageClient
 	// TelegramSession is the client for interacting with the TelegramSession builders.
 	TelegramSession *TelegramSessionClient
 	// TelegramUserState is the client for interacting with the TelegramUserState builders.
@@ -52,10 +58,12 @@ func NewClient(opts ...Option) *Client {
 
 func (c *Client) init() {
 	c.Schema = migrate.NewSchema(c.driver)
+	c.FileIDCheck = NewFileIDCheckClient(c.config)
 	c.LastChannelMessage = NewLastChannelMessageClient(c.config)
 	c.PRNotification = NewPRNotificationClient(c.config)
 	c.TelegramAccount = NewTelegramAccountClient(c.config)
 	c.TelegramChannelState = NewTelegramChannelStateClient(c.config)
+	c.TelegramServiceMessage = NewTelegramServiceMessageClient(c.config)
 	c.TelegramSession = NewTelegramSessionClient(c.config)
 	c.TelegramUserState = NewTelegramUserStateClient(c.config)
 }
@@ -148,14 +156,16 @@ func (c *Client) Tx(ctx context.Context) (*Tx, error) {
 	cfg := c.config
 	cfg.driver = tx
 	return &Tx{
-		ctx:                  ctx,
-		config:               cfg,
-		LastChannelMessage:   NewLastChannelMessageClient(cfg),
-		PRNotification:       NewPRNotificationClient(cfg),
-		TelegramAccount:      NewTelegramAccountClient(cfg),
-		TelegramChannelState: NewTelegramChannelStateClient(cfg),
-		TelegramSession:      NewTelegramSessionClient(cfg),
-		TelegramUserState:    NewTelegramUserStateClient(cfg),
+		ctx:                    ctx,
+		config:                 cfg,
+		FileIDCheck:            NewFileIDCheckClient(cfg),
+		LastChannelMessage:     NewLastChannelMessageClient(cfg),
+		PRNotification:         NewPRNotificationClient(cfg),
+		TelegramAccount:        NewTelegramAccountClient(cfg),
+		TelegramChannelState:   NewTelegramChannelStateClient(cfg),
+		TelegramServiceMessage: NewTelegramServiceMessageClient(cfg),
+		TelegramSession:        NewTelegramSessionClient(cfg),
+		TelegramUserState:      NewTelegramUserStateClient(cfg),
 	}, nil
 }
 
@@ -173,21 +183,23 @@ func (c *Client) BeginTx(ctx context.Context, opts *sql.TxOptions) (*Tx, error)
 	cfg := c.config
 	cfg.driver = &txDriver{tx: tx, drv: c.driver}
 	return &Tx{
-		ctx:                  ctx,
-		config:               cfg,
-		LastChannelMessage:   NewLastChannelMessageClient(cfg),
-		PRNotification:       NewPRNotificationClient(cfg),
-		TelegramAccount:      NewTelegramAccountClient(cfg),
-		TelegramChannelState: NewTelegramChannelStateClient(cfg),
-		TelegramSession:      NewTelegramSessionClient(cfg),
-		TelegramUserState:    NewTelegramUserStateClient(cfg),
+		ctx:                    ctx,
+		config:                 cfg,
+		FileIDCheck:            NewFileIDCheckClient(cfg),
+		LastChannelMessage:     NewLastChannelMessageClient(cfg),
+		PRNotification:         NewPRNotificationClient(cfg),
+		TelegramAccount:        NewTelegramAccountClient(cfg),
+		TelegramChannelState:   NewTelegramChannelStateClient(cfg),
+		TelegramServiceMessage: NewTelegramServiceMessageClient(cfg),
+		TelegramSession:        NewTelegramSessionClient(cfg),
+		TelegramUserState:      NewTelegramUserStateClient(cfg),
 	}, nil
 }
 
 // Debug returns a new debug-client. It's used to get verbose logging on specific operations.
 //
 //	client.Debug().
-//		LastChannelMessage.
+//		FileIDCheck.
 //		Query().
 //		Count(ctx)
 func (c *Client) Debug() *Client {
@@ -210,8 +222,9 @@ func (c *Client) Close() error {
 // In order to add hooks to a specific client, call: `client.Node.Use(...)`.
 func (c *Client) Use(hooks ...Hook) {
 	for _, n := range []interface{ Use(...Hook) }{
-		c.LastChannelMessage, c.PRNotification, c.TelegramAccount,
-		c.TelegramChannelState, c.TelegramSession, c.TelegramUserState,
+		c.FileIDCheck, c.LastChannelMessage, c.PRNotification, c.TelegramAccount,
+		c.TelegramChannelState, c.TelegramServiceMessage, c.TelegramSession,
+		c.TelegramUserState,
 	} {
 		n.Use(hooks...)
 	}
@@ -221,8 +234,9 @@ func (c *Client) Use(hooks ...Hook) {
 // In order to add interceptors to a specific client, call: `client.Node.Intercept(...)`.
 func (c *Client) Intercept(interceptors ...Interceptor) {
 	for _, n := range []interface{ Intercept(...Interceptor) }{
-		c.LastChannelMessage, c.PRNotification, c.TelegramAccount,
-		c.TelegramChannelState, c.TelegramSession, c.TelegramUserState,
+		c.FileIDCheck, c.LastChannelMessage, c.PRNotification, c.TelegramAccount,
+		c.TelegramChannelState, c.TelegramServiceMessage, c.TelegramSession,
+		c.TelegramUserState,
 	} {
 		n.Intercept(interceptors...)
 	}
@@ -231,6 +245,8 @@ func (c *Client) Intercept(interceptors ...Interceptor) {
 // Mutate implements the ent.Mutator interface.
 func (c *Client) Mutate(ctx context.Context, m Mutation) (Value, error) {
 	switch m := m.(type) {
+	case *FileIDCheckMutation:
+		return c.FileIDCheck.mutate(ctx, m)
 	case *LastChannelMessageMutation:
 		return c.LastChannelMessage.mutate(ctx, m)
 	case *PRNotificationMutation:
@@ -239,6 +255,8 @@ func (c *Client) Mutate(ctx context.Context, m Mutation) (Value, error) {
 		return c.TelegramAccount.mutate(ctx, m)
 	case *TelegramChannelStateMutation:
 		return c.TelegramChannelState.mutate(ctx, m)
+	case *TelegramServiceMessageMutation:
+		return c.TelegramServiceMessage.mutate(ctx, m)
 	case *TelegramSessionMutation:
 		return c.TelegramSession.mutate(ctx, m)
 	case *TelegramUserStateMutation:
@@ -248,6 +266,139 @@ func (c *Client) Mutate(ctx context.Context, m Mutation) (Value, error) {
 	}
 }
 
+// FileIDCheckClient is a client for the FileIDCheck schema.
+type FileIDCheckClient struct {
+	config
+}
+
+// NewFileIDCheckClient returns a client for the FileIDCheck from the given config.
+func NewFileIDCheckClient(c config) *FileIDCheckClient {
+	return &FileIDCheckClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `fileidcheck.Hooks(f(g(h())))`.
+func (c *FileIDCheckClient) Use(hooks ...Hook) {
+	c.hooks.FileIDCheck = append(c.hooks.FileIDCheck, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `fileidcheck.Intercept(f(g(h())))`.
+func (c *FileIDCheckClient) Intercept(interceptors ...Interceptor) {
+	c.inters.FileIDCheck = append(c.inters.FileIDCheck, interceptors...)
+}
+
+// Create returns a builder for creating a FileIDCheck entity.
+func (c *FileIDCheckClient) Create() *FileIDCheckCreate {
+	mutation := newFileIDCheckMutation(c.config, OpCreate)
+	return &FileIDCheckCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of FileIDCheck entities.
+func (c *FileIDCheckClient) CreateBulk(builders ...*FileIDCheckCreate) *FileIDCheckCreateBulk {
+	return &FileIDCheckCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *FileIDCheckClient) MapCreateBulk(slice any, setFunc func(*FileIDCheckCreate, int)) *FileIDCheckCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &FileIDCheckCreateBulk{err: fmt.Errorf("calling to FileIDCheckClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*FileIDCheckCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &FileIDCheckCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for FileIDCheck.
+func (c *FileIDCheckClient) Update() *FileIDCheckUpdate {
+	mutation := newFileIDCheckMutation(c.config, OpUpdate)
+	return &FileIDCheckUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *FileIDCheckClient) UpdateOne(fic *FileIDCheck) *FileIDCheckUpdateOne {
+	mutation := newFileIDCheckMutation(c.config, OpUpdateOne, withFileIDCheck(fic))
+	return &FileIDCheckUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *FileIDCheckClient) UpdateOneID(id int) *FileIDCheckUpdateOne {
+	mutation := newFileIDCheckMutation(c.config, OpUpdateOne, withFileIDCheckID(id))
+	return &FileIDCheckUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for FileIDCheck.
+func (c *FileIDCheckClient) Delete() *FileIDCheckDelete {
+	mutation := newFileIDCheckMutation(c.config, OpDelete)
+	return &FileIDCheckDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *FileIDCheckClient) DeleteOne(fic *FileIDCheck) *FileIDCheckDeleteOne {
+	return c.DeleteOneID(fic.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *FileIDCheckClient) DeleteOneID(id int) *FileIDCheckDeleteOne {
+	builder := c.Delete().Where(fileidcheck.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &FileIDCheckDeleteOne{builder}
+}
+
+// Query returns a query builder for FileIDCheck.
+func (c *FileIDCheckClient) Query() *FileIDCheckQuery {
+	return &FileIDCheckQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeFileIDCheck},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a FileIDCheck entity by its id.
+func (c *FileIDCheckClient) Get(ctx context.Context, id int) (*FileIDCheck, error) {
+	return c.Query().Where(fileidcheck.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *FileIDCheckClient) GetX(ctx context.Context, id int) *FileIDCheck {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *FileIDCheckClient) Hooks() []Hook {
+	return c.hooks.FileIDCheck
+}
+
+// Interceptors returns the client interceptors.
+func (c *FileIDCheckClient) Interceptors() []Interceptor {
+	return c.inters.FileIDCheck
+}
+
+func (c *FileIDCheckClient) mutate(ctx context.Context, m *FileIDCheckMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&FileIDCheckCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&FileIDCheckUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&FileIDCheckUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&FileIDCheckDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown FileIDCheck mutation op: %q", m.Op())
+	}
+}
+
 // LastChannelMessageClient is a client for the LastChannelMessage schema.
 type LastChannelMessageClient struct {
 	config
@@ -622,6 +773,22 @@ func (c *TelegramAccountClient) GetX(ctx context.Context, id string) *TelegramAc
 	return obj
 }
 
+// QueryServiceMessages queries the service_messages edge of a TelegramAccount.
+func (c *TelegramAccountClient) QueryServiceMessages(ta *TelegramAccount) *TelegramServiceMessageQuery {
+	query := (&TelegramServiceMessageClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := ta.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(telegramaccount.Table, telegramaccount.FieldID, id),
+			sqlgraph.To(telegramservicemessage.Table, telegramservicemessage.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, telegramaccount.ServiceMessagesTable, telegramaccount.ServiceMessagesColumn),
+		)
+		fromV = sqlgraph.Neighbors(ta.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
 // Hooks returns the client hooks.
 func (c *TelegramAccountClient) Hooks() []Hook {
 	return c.hooks.TelegramAccount
@@ -796,6 +963,155 @@ func (c *TelegramChannelStateClient) mutate(ctx context.Context, m *TelegramChan
 	}
 }
 
+// TelegramServiceMessageClient is a client for the TelegramServiceMessage schema.
+type TelegramServiceMessageClient struct {
+	config
+}
+
+// NewTelegramServiceMessageClient returns a client for the TelegramServiceMessage from the given config.
+func NewTelegramServiceMessageClient(c config) *TelegramServiceMessageClient {
+	return &TelegramServiceMessageClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `telegramservicemessage.Hooks(f(g(h())))`.
+func (c *TelegramServiceMessageClient) Use(hooks ...Hook) {
+	c.hooks.TelegramServiceMessage = append(c.hooks.TelegramServiceMessage, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `telegramservicemessage.Intercept(f(g(h())))`.
+func (c *TelegramServiceMessageClient) Intercept(interceptors ...Interceptor) {
+	c.inters.TelegramServiceMessage = append(c.inters.TelegramServiceMessage, interceptors...)
+}
+
+// Create returns a builder for creating a TelegramServiceMessage entity.
+func (c *TelegramServiceMessageClient) Create() *TelegramServiceMessageCreate {
+	mutation := newTelegramServiceMessageMutation(c.config, OpCreate)
+	return &TelegramServiceMessageCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of TelegramServiceMessage entities.
+func (c *TelegramServiceMessageClient) CreateBulk(builders ...*TelegramServiceMessageCreate) *TelegramServiceMessageCreateBulk {
+	return &TelegramServiceMessageCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *TelegramServiceMessageClient) MapCreateBulk(slice any, setFunc func(*TelegramServiceMessageCreate, int)) *TelegramServiceMessageCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &TelegramServiceMessageCreateBulk{err: fmt.Errorf("calling to TelegramServiceMessageClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*TelegramServiceMessageCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &TelegramServiceMessageCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for TelegramServiceMessage.
+func (c *TelegramServiceMessageClient) Update() *TelegramServiceMessageUpdate {
+	mutation := newTelegramServiceMessageMutation(c.config, OpUpdate)
+	return &TelegramServiceMessageUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *TelegramServiceMessageClient) UpdateOne(tsm *TelegramServiceMessage) *TelegramServiceMessageUpdateOne {
+	mutation := newTelegramServiceMessageMutation(c.config, OpUpdateOne, withTelegramServiceMessage(tsm))
+	return &TelegramServiceMessageUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *TelegramServiceMessageClient) UpdateOneID(id int) *TelegramServiceMessageUpdateOne {
+	mutation := newTelegramServiceMessageMutation(c.config, OpUpdateOne, withTelegramServiceMessageID(id))
+	return &TelegramServiceMessageUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for TelegramServiceMessage.
+func (c *TelegramServiceMessageClient) Delete() *TelegramServiceMessageDelete {
+	mutation := newTelegramServiceMessageMutation(c.config, OpDelete)
+	return &TelegramServiceMessageDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *TelegramServiceMessageClient) DeleteOne(tsm *TelegramServiceMessage) *TelegramServiceMessageDeleteOne {
+	return c.DeleteOneID(tsm.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *TelegramServiceMessageClient) DeleteOneID(id int) *TelegramServiceMessageDeleteOne {
+	builder := c.Delete().Where(telegramservicemessage.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &TelegramServiceMessageDeleteOne{builder}
+}
+
+// Query returns a query builder for TelegramServiceMessage.
+func (c *TelegramServiceMessageClient) Query() *TelegramServiceMessageQuery {
+	return &TelegramServiceMessageQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeTelegramServiceMessage},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a TelegramServiceMessage entity by its id.
+func (c *TelegramServiceMessageClient) Get(ctx context.Context, id int) (*TelegramServiceMessage, error) {
+	return c.Query().Where(telegramservicemessage.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *TelegramServiceMessageClient) GetX(ctx context.Context, id int) *TelegramServiceMessage {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryAccount queries the account edge of a TelegramServiceMessage.
+func (c *TelegramServiceMessageClient) QueryAccount(tsm *TelegramServiceMessage) *TelegramAccountQuery {
+	query := (&TelegramAccountClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := tsm.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(telegramservicemessage.Table, telegramservicemessage.FieldID, id),
+			sqlgraph.To(telegramaccount.Table, telegramaccount.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, telegramservicemessage.AccountTable, telegramservicemessage.AccountColumn),
+		)
+		fromV = sqlgraph.Neighbors(tsm.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *TelegramServiceMessageClient) Hooks() []Hook {
+	return c.hooks.TelegramServiceMessage
+}
+
+// Interceptors returns the client interceptors.
+func (c *TelegramServiceMessageClient) Interceptors() []Interceptor {
+	return c.inters.TelegramServiceMessage
+}
+
+func (c *TelegramServiceMessageClient) mutate(ctx context.Context, m *TelegramServiceMessageMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&TelegramServiceMessageCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&TelegramServiceMessageUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&TelegramServiceMessageUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&TelegramServiceMessageDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown TelegramServiceMessage mutation op: %q", m.Op())
+	}
+}
+
 // TelegramSessionClient is a client for the TelegramSession schema.
 type TelegramSessionClient struct {
 	config
@@ -1081,11 +1397,13 @@ func (c *TelegramUserStateClient) mutate(ctx context.Context, m *TelegramUserSta
 // hooks and interceptors per client, for fast access.
 type (
 	hooks struct {
-		LastChannelMessage, PRNotification, TelegramAccount, TelegramChannelState,
-		TelegramSession, TelegramUserState []ent.Hook
+		FileIDCheck, LastChannelMessage, PRNotification, TelegramAccount,
+		TelegramChannelState, TelegramServiceMessage, TelegramSession,
+		TelegramUserState []ent.Hook
 	}
 	inters struct {
-		LastChannelMessage, PRNotification, TelegramAccount, TelegramChannelState,
-		TelegramSession, TelegramUserState []ent.Interceptor
+		FileIDCheck, LastChannelMessage, PRNotification, TelegramAccount,
+		TelegramChannelState, TelegramServiceMessage, TelegramSession,
+		TelegramUserState []ent.Interceptor
 	}
 )
@@ -12,10 +12,12 @@ import (
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/gotd/bot/internal/ent/fileidcheck"
 	"github.com/gotd/bot/internal/ent/lastchannelmessage"
 	"github.com/gotd/bot/internal/ent/prnotification"
 	"github.com/gotd/bot/internal/ent/telegramaccount"
 	"github.com/gotd/bot/internal/ent/telegramchannelstate"
+	"github.com/gotd/bot/internal/ent/telegramservicemessage"
 	"github.com/gotd/bot/internal/ent/telegramsession"
 	"github.com/gotd/bot/internal/ent/telegramuserstate"
 )
@@ -78,12 +80,14 @@ var (
 func checkColumn(table, column string) error {
 	initCheck.Do(func() {
 		columnCheck = sql.NewColumnCheck(map[string]func(string) bool{
-			lastchannelmessage.Table:   lastchannelmessage.ValidColumn,
-			prnotification.Table:       prnotification.ValidColumn,
-			telegramaccount.Table:      telegramaccount.ValidColumn,
-			telegramchannelstate.Table: telegramchannelstate.ValidColumn,
-			telegramsession.Table:      telegramsession.ValidColumn,
-			telegramuserstate.Table:    telegramuserstate.ValidColumn,
+			fileidcheck.Table:            fileidcheck.ValidColumn,
+			lastchannelmessage.Table:     lastchannelmessage.ValidColumn,
+			prnotification.Table:         prnotification.ValidColumn,
+			telegramaccount.Table:        telegramaccount.ValidColumn,
+			telegramchannelstate.Table:   telegramchannelstate.ValidColumn,
+			telegramservicemessage.Table: telegramservicemessage.ValidColumn,
+			telegramsession.Table:        telegramsession.ValidColumn,
+			telegramuserstate.Table:      telegramuserstate.ValidColumn,
 		})
 	})
 	return columnCheck(table, column)
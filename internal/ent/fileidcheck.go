@@ -0,0 +1,183 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/gotd/bot/internal/ent/fileidcheck"
+)
+
+// FileIDCheck is the model entity for the FileIDCheck schema.
+type FileIDCheck struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// File type, e.g. Sticker
+	Type string `json:"type,omitempty"`
+	// DC of file
+	Dc int `json:"dc,omitempty"`
+	// file_id encoded by gotd/td
+	FileID string `json:"file_id,omitempty"`
+	// file_id of the same file returned by BotAPI
+	BotAPIFileID string `json:"bot_api_file_id,omitempty"`
+	// Result holds the value of the "result" field.
+	Result fileidcheck.Result `json:"result,omitempty"`
+	// Error or mismatch details
+	Error string `json:"error,omitempty"`
+	// Version of gotd/td
+	TdVersion string `json:"td_version,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt    time.Time `json:"created_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*FileIDCheck) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case fileidcheck.FieldID, fileidcheck.FieldDc:
+			values[i] = new(sql.NullInt64)
+		case fileidcheck.FieldType, fileidcheck.FieldFileID, fileidcheck.FieldBotAPIFileID, fileidcheck.FieldResult, fileidcheck.FieldError, fileidcheck.FieldTdVersion:
+			values[i] = new(sql.NullString)
+		case fileidcheck.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the FileIDCheck fields.
+func (fic *FileIDCheck) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case fileidcheck.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			fic.ID = int(value.Int64)
+		case fileidcheck.FieldType:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field type", values[i])
+			} else if value.Valid {
+				fic.Type = value.String
+			}
+		case fileidcheck.FieldDc:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field dc", values[i])
+			} else if value.Valid {
+				fic.Dc = int(value.Int64)
+			}
+		case fileidcheck.FieldFileID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field file_id", values[i])
+			} else if value.Valid {
+				fic.FileID = value.String
+			}
+		case fileidcheck.FieldBotAPIFileID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field bot_api_file_id", values[i])
+			} else if value.Valid {
+				fic.BotAPIFileID = value.String
+			}
+		case fileidcheck.FieldResult:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field result", values[i])
+			} else if value.Valid {
+				fic.Result = fileidcheck.Result(value.String)
+			}
+		case fileidcheck.FieldError:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field error", values[i])
+			} else if value.Valid {
+				fic.Error = value.String
+			}
+		case fileidcheck.FieldTdVersion:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field td_version", values[i])
+			} else if value.Valid {
+				fic.TdVersion = value.String
+			}
+		case fileidcheck.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				fic.CreatedAt = value.Time
+			}
+		default:
+			fic.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the FileIDCheck.
+// This includes values selected through modifiers, order, etc.
+func (fic *FileIDCheck) Value(name string) (ent.Value, error) {
+	return fic.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this FileIDCheck.
+// Note that you need to call FileIDCheck.Unwrap() before calling this method if this FileIDCheck
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (fic *FileIDCheck) Update() *FileIDCheckUpdateOne {
+	return NewFileIDCheckClient(fic.config).UpdateOne(fic)
+}
+
+// Unwrap unwraps the FileIDCheck entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (fic *FileIDCheck) Unwrap() *FileIDCheck {
+	_tx, ok := fic.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: FileIDCheck is not a transactional entity")
+	}
+	fic.config.driver = _tx.drv
+	return fic
+}
+
+// String implements the fmt.Stringer.
+func (fic *FileIDCheck) String() string {
+	var builder strings.Builder
+	builder.WriteString("FileIDCheck(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", fic.ID))
+	builder.WriteString("type=")
+	builder.WriteString(fic.Type)
+	builder.WriteString(", ")
+	builder.WriteString("dc=")
+	builder.WriteString(fmt.Sprintf("%v", fic.Dc))
+	builder.WriteString(", ")
+	builder.WriteString("file_id=")
+	builder.WriteString(fic.FileID)
+	builder.WriteString(", ")
+	builder.WriteString("bot_api_file_id=")
+	builder.WriteString(fic.BotAPIFileID)
+	builder.WriteString(", ")
+	builder.WriteString("result=")
+	builder.WriteString(fmt.Sprintf("%v", fic.Result))
+	builder.WriteString(", ")
+	builder.WriteString("error=")
+	builder.WriteString(fic.Error)
+	builder.WriteString(", ")
+	builder.WriteString("td_version=")
+	builder.WriteString(fic.TdVersion)
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(fic.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// FileIDChecks is a parsable slice of FileIDCheck.
+type FileIDChecks []*FileIDCheck
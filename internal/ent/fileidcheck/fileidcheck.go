@@ -0,0 +1,136 @@
+// Code generated by ent, DO NOT EDIT.
+
+package fileidcheck
+
+import (
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+const (
+	// Label holds the string label denoting the fileidcheck type in the database.
+	Label = "file_id_check"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldType holds the string denoting the type field in the database.
+	FieldType = "type"
+	// FieldDc holds the string denoting the dc field in the database.
+	FieldDc = "dc"
+	// FieldFileID holds the string denoting the file_id field in the database.
+	FieldFileID = "file_id"
+	// FieldBotAPIFileID holds the string denoting the bot_api_file_id field in the database.
+	FieldBotAPIFileID = "bot_api_file_id"
+	// FieldResult holds the string denoting the result field in the database.
+	FieldResult = "result"
+	// FieldError holds the string denoting the error field in the database.
+	FieldError = "error"
+	// FieldTdVersion holds the string denoting the td_version field in the database.
+	FieldTdVersion = "td_version"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// Table holds the table name of the fileidcheck in the database.
+	Table = "file_id_checks"
+)
+
+// Columns holds all SQL columns for fileidcheck fields.
+var Columns = []string{
+	FieldID,
+	FieldType,
+	FieldDc,
+	FieldFileID,
+	FieldBotAPIFileID,
+	FieldResult,
+	FieldError,
+	FieldTdVersion,
+	FieldCreatedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+)
+
+// Result defines the type for the "result" enum field.
+type Result string
+
+// Result values.
+const (
+	ResultOK          Result = "OK"
+	ResultEncodeError Result = "EncodeError"
+	ResultRejected    Result = "Rejected"
+	ResultMismatch    Result = "Mismatch"
+)
+
+func (r Result) String() string {
+	return string(r)
+}
+
+// ResultValidator is a validator for the "result" field enum values. It is called by the builders before save.
+func ResultValidator(r Result) error {
+	switch r {
+	case ResultOK, ResultEncodeError, ResultRejected, ResultMismatch:
+		return nil
+	default:
+		return fmt.Errorf("fileidcheck: invalid enum value for result field: %q", r)
+	}
+}
+
+// OrderOption defines the ordering options for the FileIDCheck queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByType orders the results by the type field.
+func ByType(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldType, opts...).ToFunc()
+}
+
+// ByDc orders the results by the dc field.
+func ByDc(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDc, opts...).ToFunc()
+}
+
+// ByFileID orders the results by the file_id field.
+func ByFileID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldFileID, opts...).ToFunc()
+}
+
+// ByBotAPIFileID orders the results by the bot_api_file_id field.
+func ByBotAPIFileID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldBotAPIFileID, opts...).ToFunc()
+}
+
+// ByResult orders the results by the result field.
+func ByResult(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldResult, opts...).ToFunc()
+}
+
+// ByError orders the results by the error field.
+func ByError(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldError, opts...).ToFunc()
+}
+
+// ByTdVersion orders the results by the td_version field.
+func ByTdVersion(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTdVersion, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
@@ -0,0 +1,560 @@
+// Code generated by ent, DO NOT EDIT.
+
+package fileidcheck
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/gotd/bot/internal/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.FileIDCheck {
+	return predicate.FileIDCheck(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.FileIDCheck {
+	return predicate.FileIDCheck(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.FileIDCheck {
+	return predicate.FileIDCheck(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.FileIDCheck {
+	return predicate.FileIDCheck(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.FileIDCheck {
+	return predicate.FileIDCheck(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.FileIDCheck {
+	return predicate.FileIDCheck(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.FileIDCheck {
+	return predicate.FileIDCheck(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.FileIDCheck {
+	return predicate.FileIDCheck(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.FileIDCheck {
+	return predicate.FileIDCheck(sql.FieldLTE(FieldID, id))
+}
+
+// Type applies equality check predicate on the "type" field. It's identical to TypeEQ.
+func Type(v string) predicate.FileIDCheck {
+	return predicate.FileIDCheck(sql.FieldEQ(FieldType, v))
+}
+
+// Dc applies equality check predicate on the "dc" field. It's identical to DcEQ.
+func Dc(v int) predicate.FileIDCheck {
+	return predicate.FileIDCheck(sql.FieldEQ(FieldDc, v))
+}
+
+// FileID applies equality check predicate on the "file_id" field. It's identical to FileIDEQ.
+func FileID(v string) predicate.FileIDCheck {
+	return predicate.FileIDCheck(sql.FieldEQ(FieldFileID, v))
+}
+
+// BotAPIFileID applies equality check predicate on the "bot_api_file_id" field. It's identical to BotAPIFileIDEQ.
+func BotAPIFileID(v string) predicate.FileIDCheck {
+	return predicate.FileIDCheck(sql.FieldEQ(FieldBotAPIFileID, v))
+}
+
+// Error applies equality check predicate on the "error" field. It's identical to ErrorEQ.
+func Error(v string) predicate.FileIDCheck {
+	return predicate.FileIDCheck(sql.FieldEQ(FieldError, v))
+}
+
+// TdVersion applies equality check predicate on the "td_version" field. It's identical to TdVersionEQ.
+func TdVersion(v string) predicate.FileIDCheck {
+	return predicate.FileIDCheck(sql.FieldEQ(FieldTdVersion, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.FileIDCheck {
+	return predicate.FileIDCheck(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// TypeEQ applies the EQ predicate on the "type" field.
+func TypeEQ(v string) predicate.FileIDCheck {
+	return predicate.FileIDCheck(sql.FieldEQ(FieldType, v))
+}
+
+// TypeNEQ applies the NEQ predicate on the "type" field.
+func TypeNEQ(v string) predicate.FileIDCheck {
+	return predicate.FileIDCheck(sql.FieldNEQ(FieldType, v))
+}
+
+// TypeIn applies the In predicate on the "type" field.
+func TypeIn(vs ...string) predicate.FileIDCheck {
+	return predicate.FileIDCheck(sql.FieldIn(FieldType, vs...))
+}
+
+// TypeNotIn applies the NotIn predicate on the "type" field.
+func TypeNotIn(vs ...string) predicate.FileIDCheck {
+	return predicate.FileIDCheck(sql.FieldNotIn(FieldType, vs...))
+}
+
+// TypeGT applies the GT predicate on the "type" field.
+func TypeGT(v string) predicate.FileIDCheck {
+	return predicate.FileIDCheck(sql.FieldGT(FieldType, v))
+}
+
+// TypeGTE applies the GTE predicate on the "type" field.
+func TypeGTE(v string) predicate.FileIDCheck {
+	return predicate.FileIDCheck(sql.FieldGTE(FieldType, v))
+}
+
+// TypeLT applies the LT predicate on the "type" field.
+func TypeLT(v string) predicate.FileIDCheck {
+	return predicate.FileIDCheck(sql.FieldLT(FieldType, v))
+}
+
+// TypeLTE applies the LTE predicate on the "type" field.
+func TypeLTE(v string) predicate.FileIDCheck {
+	return predicate.FileIDCheck(sql.FieldLTE(FieldType, v))
+}
+
+// TypeContains applies the Contains predicate on the "type" field.
+func TypeContains(v string) predicate.FileIDCheck {
+	return predicate.FileIDCheck(sql.FieldContains(FieldType, v))
+}
+
+// TypeHasPrefix applies the HasPrefix predicate on the "type" field.
+func TypeHasPrefix(v string) predicate.FileIDCheck {
+	return predicate.FileIDCheck(sql.FieldHasPrefix(FieldType, v))
+}
+
+// TypeHasSuffix applies the HasSuffix predicate on the "type" field.
+func TypeHasSuffix(v string) predicate.FileIDCheck {
+	return predicate.FileIDCheck(sql.FieldHasSuffix(FieldType, v))
+}
+
+// TypeEqualFold applies the EqualFold predicate on the "type" field.
+func TypeEqualFold(v string) predicate.FileIDCheck {
+	return predicate.FileIDCheck(sql.FieldEqualFold(FieldType, v))
+}
+
+// TypeContainsFold applies the ContainsFold predicate on the "type" field.
+func TypeContainsFold(v string) predicate.FileIDCheck {
+	return predicate.FileIDCheck(sql.FieldContainsFold(FieldType, v))
+}
+
+// DcEQ applies the EQ predicate on the "dc" field.
+func DcEQ(v int) predicate.FileIDCheck {
+	return predicate.FileIDCheck(sql.FieldEQ(FieldDc, v))
+}
+
+// DcNEQ applies the NEQ predicate on the "dc" field.
+func DcNEQ(v int) predicate.FileIDCheck {
+	return predicate.FileIDCheck(sql.FieldNEQ(FieldDc, v))
+}
+
+// DcIn applies the In predicate on the "dc" field.
+func DcIn(vs ...int) predicate.FileIDCheck {
+	return predicate.FileIDCheck(sql.FieldIn(FieldDc, vs...))
+}
+
+// DcNotIn applies the NotIn predicate on the "dc" field.
+func DcNotIn(vs ...int) predicate.FileIDCheck {
+	return predicate.FileIDCheck(sql.FieldNotIn(FieldDc, vs...))
+}
+
+// DcGT applies the GT predicate on the "dc" field.
+func DcGT(v int) predicate.FileIDCheck {
+	return predicate.FileIDCheck(sql.FieldGT(FieldDc, v))
+}
+
+// DcGTE applies the GTE predicate on the "dc" field.
+func DcGTE(v int) predicate.FileIDCheck {
+	return predicate.FileIDCheck(sql.FieldGTE(FieldDc, v))
+}
+
+// DcLT applies the LT predicate on the "dc" field.
+func DcLT(v int) predicate.FileIDCheck {
+	return predicate.FileIDCheck(sql.FieldLT(FieldDc, v))
+}
+
+// DcLTE applies the LTE predicate on the "dc" field.
+func DcLTE(v int) predicate.FileIDCheck {
+	return predicate.FileIDCheck(sql.FieldLTE(FieldDc, v))
+}
+
+// FileIDEQ applies the EQ predicate on the "file_id" field.
+func FileIDEQ(v string) predicate.FileIDCheck {
+	return predicate.FileIDCheck(sql.FieldEQ(FieldFileID, v))
+}
+
+// FileIDNEQ applies the NEQ predicate on the "file_id" field.
+func FileIDNEQ(v string) predicate.FileIDCheck {
+	return predicate.FileIDCheck(sql.FieldNEQ(FieldFileID, v))
+}
+
+// FileIDIn applies the In predicate on the "file_id" field.
+func FileIDIn(vs ...string) predicate.FileIDCheck {
+	return predicate.FileIDCheck(sql.FieldIn(FieldFileID, vs...))
+}
+
+// FileIDNotIn applies the NotIn predicate on the "file_id" field.
+func FileIDNotIn(vs ...string) predicate.FileIDCheck {
+	return predicate.FileIDCheck(sql.FieldNotIn(FieldFileID, vs...))
+}
+
+// FileIDGT applies the GT predicate on the "file_id" field.
+func FileIDGT(v string) predicate.FileIDCheck {
+	return predicate.FileIDCheck(sql.FieldGT(FieldFileID, v))
+}
+
+// FileIDGTE applies the GTE predicate on the "file_id" field.
+func FileIDGTE(v string) predicate.FileIDCheck {
+	return predicate.FileIDCheck(sql.FieldGTE(FieldFileID, v))
+}
+
+// FileIDLT applies the LT predicate on the "file_id" field.
+func FileIDLT(v string) predicate.FileIDCheck {
+	return predicate.FileIDCheck(sql.FieldLT(FieldFileID, v))
+}
+
+// FileIDLTE applies the LTE predicate on the "file_id" field.
+func FileIDLTE(v string) predicate.FileIDCheck {
+	return predicate.FileIDCheck(sql.FieldLTE(FieldFileID, v))
+}
+
+// FileIDContains applies the Contains predicate on the "file_id" field.
+func FileIDContains(v string) predicate.FileIDCheck {
+	return predicate.FileIDCheck(sql.FieldContains(FieldFileID, v))
+}
+
+// FileIDHasPrefix applies the HasPrefix predicate on the "file_id" field.
+func FileIDHasPrefix(v string) predicate.FileIDCheck {
+	return predicate.FileIDCheck(sql.FieldHasPrefix(FieldFileID, v))
+}
+
+// FileIDHasSuffix applies the HasSuffix predicate on the "file_id" field.
+func FileIDHasSuffix(v string) predicate.FileIDCheck {
+	return predicate.FileIDCheck(sql.FieldHasSuffix(FieldFileID, v))
+}
+
+// FileIDIsNil applies the IsNil predicate on the "file_id" field.
+func FileIDIsNil() predicate.FileIDCheck {
+	return predicate.FileIDCheck(sql.FieldIsNull(FieldFileID))
+}
+
+// FileIDNotNil applies the NotNil predicate on the "file_id" field.
+func FileIDNotNil() predicate.FileIDCheck {
+	return predicate.FileIDCheck(sql.FieldNotNull(FieldFileID))
+}
+
+// FileIDEqualFold applies the EqualFold predicate on the "file_id" field.
+func FileIDEqualFold(v string) predicate.FileIDCheck {
+	return predicate.FileIDCheck(sql.FieldEqualFold(FieldFileID, v))
+}
+
+// FileIDContainsFold applies the ContainsFold predicate on the "file_id" field.
+func FileIDContainsFold(v string) predicate.FileIDCheck {
+	return predicate.FileIDCheck(sql.FieldContainsFold(FieldFileID, v))
+}
+
+// BotAPIFileIDEQ applies the EQ predicate on the "bot_api_file_id" field.
+func BotAPIFileIDEQ(v string) predicate.FileIDCheck {
+	return predicate.FileIDCheck(sql.FieldEQ(FieldBotAPIFileID, v))
+}
+
+// BotAPIFileIDNEQ applies the NEQ predicate on the "bot_api_file_id" field.
+func BotAPIFileIDNEQ(v string) predicate.FileIDCheck {
+	return predicate.FileIDCheck(sql.FieldNEQ(FieldBotAPIFileID, v))
+}
+
+// BotAPIFileIDIn applies the In predicate on the "bot_api_file_id" field.
+func BotAPIFileIDIn(vs ...string) predicate.FileIDCheck {
+	return predicate.FileIDCheck(sql.FieldIn(FieldBotAPIFileID, vs...))
+}
+
+// BotAPIFileIDNotIn applies the NotIn predicate on the "bot_api_file_id" field.
+func BotAPIFileIDNotIn(vs ...string) predicate.FileIDCheck {
+	return predicate.FileIDCheck(sql.FieldNotIn(FieldBotAPIFileID, vs...))
+}
+
+// BotAPIFileIDGT applies the GT predicate on the "bot_api_file_id" field.
+func BotAPIFileIDGT(v string) predicate.FileIDCheck {
+	return predicate.FileIDCheck(sql.FieldGT(FieldBotAPIFileID, v))
+}
+
+// BotAPIFileIDGTE applies the GTE predicate on the "bot_api_file_id" field.
+func BotAPIFileIDGTE(v string) predicate.FileIDCheck {
+	return predicate.FileIDCheck(sql.FieldGTE(FieldBotAPIFileID, v))
+}
+
+// BotAPIFileIDLT applies the LT predicate on the "bot_api_file_id" field.
+func BotAPIFileIDLT(v string) predicate.FileIDCheck {
+	return predicate.FileIDCheck(sql.FieldLT(FieldBotAPIFileID, v))
+}
+
+// BotAPIFileIDLTE applies the LTE predicate on the "bot_api_file_id" field.
+func BotAPIFileIDLTE(v string) predicate.FileIDCheck {
+	return predicate.FileIDCheck(sql.FieldLTE(FieldBotAPIFileID, v))
+}
+
+// BotAPIFileIDContains applies the Contains predicate on the "bot_api_file_id" field.
+func BotAPIFileIDContains(v string) predicate.FileIDCheck {
+	return predicate.FileIDCheck(sql.FieldContains(FieldBotAPIFileID, v))
+}
+
+// BotAPIFileIDHasPrefix applies the HasPrefix predicate on the "bot_api_file_id" field.
+func BotAPIFileIDHasPrefix(v string) predicate.FileIDCheck {
+	return predicate.FileIDCheck(sql.FieldHasPrefix(FieldBotAPIFileID, v))
+}
+
+// BotAPIFileIDHasSuffix applies the HasSuffix predicate on the "bot_api_file_id" field.
+func BotAPIFileIDHasSuffix(v string) predicate.FileIDCheck {
+	return predicate.FileIDCheck(sql.FieldHasSuffix(FieldBotAPIFileID, v))
+}
+
+// BotAPIFileIDIsNil applies the IsNil predicate on the "bot_api_file_id" field.
+func BotAPIFileIDIsNil() predicate.FileIDCheck {
+	return predicate.FileIDCheck(sql.FieldIsNull(FieldBotAPIFileID))
+}
+
+// BotAPIFileIDNotNil applies the NotNil predicate on the "bot_api_file_id" field.
+func BotAPIFileIDNotNil() predicate.FileIDCheck {
+	return predicate.FileIDCheck(sql.FieldNotNull(FieldBotAPIFileID))
+}
+
+// BotAPIFileIDEqualFold applies the EqualFold predicate on the "bot_api_file_id" field.
+func BotAPIFileIDEqualFold(v string) predicate.FileIDCheck {
+	return predicate.FileIDCheck(sql.FieldEqualFold(FieldBotAPIFileID, v))
+}
+
+// BotAPIFileIDContainsFold applies the ContainsFold predicate on the "bot_api_file_id" field.
+func BotAPIFileIDContainsFold(v string) predicate.FileIDCheck {
+	return predicate.FileIDCheck(sql.FieldContainsFold(FieldBotAPIFileID, v))
+}
+
+// ResultEQ applies the EQ predicate on the "result" field.
+func ResultEQ(v Result) predicate.FileIDCheck {
+	return predicate.FileIDCheck(sql.FieldEQ(FieldResult, v))
+}
+
+// ResultNEQ applies the NEQ predicate on the "result" field.
+func ResultNEQ(v Result) predicate.FileIDCheck {
+	return predicate.FileIDCheck(sql.FieldNEQ(FieldResult, v))
+}
+
+// ResultIn applies the In predicate on the "result" field.
+func ResultIn(vs ...Result) predicate.FileIDCheck {
+	return predicate.FileIDCheck(sql.FieldIn(FieldResult, vs...))
+}
+
+// ResultNotIn applies the NotIn predicate on the "result" field.
+func ResultNotIn(vs ...Result) predicate.FileIDCheck {
+	return predicate.FileIDCheck(sql.FieldNotIn(FieldResult, vs...))
+}
+
+// ErrorEQ applies the EQ predicate on the "error" field.
+func ErrorEQ(v string) predicate.FileIDCheck {
+	return predicate.FileIDCheck(sql.FieldEQ(FieldError, v))
+}
+
+// ErrorNEQ applies the NEQ predicate on the "error" field.
+func ErrorNEQ(v string) predicate.FileIDCheck {
+	return predicate.FileIDCheck(sql.FieldNEQ(FieldError, v))
+}
+
+// ErrorIn applies the In predicate on the "error" field.
+func ErrorIn(vs ...string) predicate.FileIDCheck {
+	return predicate.FileIDCheck(sql.FieldIn(FieldError, vs...))
+}
+
+// ErrorNotIn applies the NotIn predicate on the "error" field.
+func ErrorNotIn(vs ...string) predicate.FileIDCheck {
+	return predicate.FileIDCheck(sql.FieldNotIn(FieldError, vs...))
+}
+
+// ErrorGT applies the GT predicate on the "error" field.
+func ErrorGT(v string) predicate.FileIDCheck {
+	return predicate.FileIDCheck(sql.FieldGT(FieldError, v))
+}
+
+// ErrorGTE applies the GTE predicate on the "error" field.
+func ErrorGTE(v string) predicate.FileIDCheck {
+	return predicate.FileIDCheck(sql.FieldGTE(FieldError, v))
+}
+
+// ErrorLT applies the LT predicate on the "error" field.
+func ErrorLT(v string) predicate.FileIDCheck {
+	return predicate.FileIDCheck(sql.FieldLT(FieldError, v))
+}
+
+// ErrorLTE applies the LTE predicate on the "error" field.
+func ErrorLTE(v string) predicate.FileIDCheck {
+	return predicate.FileIDCheck(sql.FieldLTE(FieldError, v))
+}
+
+// ErrorContains applies the Contains predicate on the "error" field.
+func ErrorContains(v string) predicate.FileIDCheck {
+	return predicate.FileIDCheck(sql.FieldContains(FieldError, v))
+}
+
+// ErrorHasPrefix applies the HasPrefix predicate on the "error" field.
+func ErrorHasPrefix(v string) predicate.FileIDCheck {
+	return predicate.FileIDCheck(sql.FieldHasPrefix(FieldError, v))
+}
+
+// ErrorHasSuffix applies the HasSuffix predicate on the "error" field.
+func ErrorHasSuffix(v string) predicate.FileIDCheck {
+	return predicate.FileIDCheck(sql.FieldHasSuffix(FieldError, v))
+}
+
+// ErrorIsNil applies the IsNil predicate on the "error" field.
+func ErrorIsNil() predicate.FileIDCheck {
+	return predicate.FileIDCheck(sql.FieldIsNull(FieldError))
+}
+
+// ErrorNotNil applies the NotNil predicate on the "error" field.
+func ErrorNotNil() predicate.FileIDCheck {
+	return predicate.FileIDCheck(sql.FieldNotNull(FieldError))
+}
+
+// ErrorEqualFold applies the EqualFold predicate on the "error" field.
+func ErrorEqualFold(v string) predicate.FileIDCheck {
+	return predicate.FileIDCheck(sql.FieldEqualFold(FieldError, v))
+}
+
+// ErrorContainsFold applies the ContainsFold predicate on the "error" field.
+func ErrorContainsFold(v string) predicate.FileIDCheck {
+	return predicate.FileIDCheck(sql.FieldContainsFold(FieldError, v))
+}
+
+// TdVersionEQ applies the EQ predicate on the "td_version" field.
+func TdVersionEQ(v string) predicate.FileIDCheck {
+	return predicate.FileIDCheck(sql.FieldEQ(FieldTdVersion, v))
+}
+
+// TdVersionNEQ applies the NEQ predicate on the "td_version" field.
+func TdVersionNEQ(v string) predicate.FileIDCheck {
+	return predicate.FileIDCheck(sql.FieldNEQ(FieldTdVersion, v))
+}
+
+// TdVersionIn applies the In predicate on the "td_version" field.
+func TdVersionIn(vs ...string) predicate.FileIDCheck {
+	return predicate.FileIDCheck(sql.FieldIn(FieldTdVersion, vs...))
+}
+
+// TdVersionNotIn applies the NotIn predicate on the "td_version" field.
+func TdVersionNotIn(vs ...string) predicate.FileIDCheck {
+	return predicate.FileIDCheck(sql.FieldNotIn(FieldTdVersion, vs...))
+}
+
+// TdVersionGT applies the GT predicate on the "td_version" field.
+func TdVersionGT(v string) predicate.FileIDCheck {
+	return predicate.FileIDCheck(sql.FieldGT(FieldTdVersion, v))
+}
+
+// TdVersionGTE applies the GTE predicate on the "td_version" field.
+func TdVersionGTE(v string) predicate.FileIDCheck {
+	return predicate.FileIDCheck(sql.FieldGTE(FieldTdVersion, v))
+}
+
+// TdVersionLT applies the LT predicate on the "td_version" field.
+func TdVersionLT(v string) predicate.FileIDCheck {
+	return predicate.FileIDCheck(sql.FieldLT(FieldTdVersion, v))
+}
+
+// TdVersionLTE applies the LTE predicate on the "td_version" field.
+func TdVersionLTE(v string) predicate.FileIDCheck {
+	return predicate.FileIDCheck(sql.FieldLTE(FieldTdVersion, v))
+}
+
+// TdVersionContains applies the Contains predicate on the "td_version" field.
+func TdVersionContains(v string) predicate.FileIDCheck {
+	return predicate.FileIDCheck(sql.FieldContains(FieldTdVersion, v))
+}
+
+// TdVersionHasPrefix applies the HasPrefix predicate on the "td_version" field.
+func TdVersionHasPrefix(v string) predicate.FileIDCheck {
+	return predicate.FileIDCheck(sql.FieldHasPrefix(FieldTdVersion, v))
+}
+
+// TdVersionHasSuffix applies the HasSuffix predicate on the "td_version" field.
+func TdVersionHasSuffix(v string) predicate.FileIDCheck {
+	return predicate.FileIDCheck(sql.FieldHasSuffix(FieldTdVersion, v))
+}
+
+// TdVersionEqualFold applies the EqualFold predicate on the "td_version" field.
+func TdVersionEqualFold(v string) predicate.FileIDCheck {
+	return predicate.FileIDCheck(sql.FieldEqualFold(FieldTdVersion, v))
+}
+
+// TdVersionContainsFold applies the ContainsFold predicate on the "td_version" field.
+func TdVersionContainsFold(v string) predicate.FileIDCheck {
+	return predicate.FileIDCheck(sql.FieldContainsFold(FieldTdVersion, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.FileIDCheck {
+	return predicate.FileIDCheck(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.FileIDCheck {
+	return predicate.FileIDCheck(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.FileIDCheck {
+	return predicate.FileIDCheck(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.FileIDCheck {
+	return predicate.FileIDCheck(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.FileIDCheck {
+	return predicate.FileIDCheck(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.FileIDCheck {
+	return predicate.FileIDCheck(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.FileIDCheck {
+	return predicate.FileIDCheck(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.FileIDCheck {
+	return predicate.FileIDCheck(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.FileIDCheck) predicate.FileIDCheck {
+	return predicate.FileIDCheck(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.FileIDCheck) predicate.FileIDCheck {
+	return predicate.FileIDCheck(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.FileIDCheck) predicate.FileIDCheck {
+	return predicate.FileIDCheck(sql.NotPredicates(p))
+}
@@ -0,0 +1,916 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/gotd/bot/internal/ent/fileidcheck"
+)
+
+// FileIDCheckCreate is the builder for creating a FileIDCheck entity.
+type FileIDCheckCreate struct {
+	config
+	mutation *FileIDCheckMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetType sets the "type" field.
+func (ficc *FileIDCheckCreate) SetType(s string) *FileIDCheckCreate {
+	ficc.mutation.SetType(s)
+	return ficc
+}
+
+// SetDc sets the "dc" field.
+func (ficc *FileIDCheckCreate) SetDc(i int) *FileIDCheckCreate {
+	ficc.mutation.SetDc(i)
+	return ficc
+}
+
+// SetFileID sets the "file_id" field.
+func (ficc *FileIDCheckCreate) SetFileID(s string) *FileIDCheckCreate {
+	ficc.mutation.SetFileID(s)
+	return ficc
+}
+
+// SetNillableFileID sets the "file_id" field if the given value is not nil.
+func (ficc *FileIDCheckCreate) SetNillableFileID(s *string) *FileIDCheckCreate {
+	if s != nil {
+		ficc.SetFileID(*s)
+	}
+	return ficc
+}
+
+// SetBotAPIFileID sets the "bot_api_file_id" field.
+func (ficc *FileIDCheckCreate) SetBotAPIFileID(s string) *FileIDCheckCreate {
+	ficc.mutation.SetBotAPIFileID(s)
+	return ficc
+}
+
+// SetNillableBotAPIFileID sets the "bot_api_file_id" field if the given value is not nil.
+func (ficc *FileIDCheckCreate) SetNillableBotAPIFileID(s *string) *FileIDCheckCreate {
+	if s != nil {
+		ficc.SetBotAPIFileID(*s)
+	}
+	return ficc
+}
+
+// SetResult sets the "result" field.
+func (ficc *FileIDCheckCreate) SetResult(f fileidcheck.Result) *FileIDCheckCreate {
+	ficc.mutation.SetResult(f)
+	return ficc
+}
+
+// SetError sets the "error" field.
+func (ficc *FileIDCheckCreate) SetError(s string) *FileIDCheckCreate {
+	ficc.mutation.SetError(s)
+	return ficc
+}
+
+// SetNillableError sets the "error" field if the given value is not nil.
+func (ficc *FileIDCheckCreate) SetNillableError(s *string) *FileIDCheckCreate {
+	if s != nil {
+		ficc.SetError(*s)
+	}
+	return ficc
+}
+
+// SetTdVersion sets the "td_version" field.
+func (ficc *FileIDCheckCreate) SetTdVersion(s string) *FileIDCheckCreate {
+	ficc.mutation.SetTdVersion(s)
+	return ficc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (ficc *FileIDCheckCreate) SetCreatedAt(t time.Time) *FileIDCheckCreate {
+	ficc.mutation.SetCreatedAt(t)
+	return ficc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (ficc *FileIDCheckCreate) SetNillableCreatedAt(t *time.Time) *FileIDCheckCreate {
+	if t != nil {
+		ficc.SetCreatedAt(*t)
+	}
+	return ficc
+}
+
+// Mutation returns the FileIDCheckMutation object of the builder.
+func (ficc *FileIDCheckCreate) Mutation() *FileIDCheckMutation {
+	return ficc.mutation
+}
+
+// Save creates the FileIDCheck in the database.
+func (ficc *FileIDCheckCreate) Save(ctx context.Context) (*FileIDCheck, error) {
+	ficc.defaults()
+	return withHooks(ctx, ficc.sqlSave, ficc.mutation, ficc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (ficc *FileIDCheckCreate) SaveX(ctx context.Context) *FileIDCheck {
+	v, err := ficc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (ficc *FileIDCheckCreate) Exec(ctx context.Context) error {
+	_, err := ficc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ficc *FileIDCheckCreate) ExecX(ctx context.Context) {
+	if err := ficc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (ficc *FileIDCheckCreate) defaults() {
+	if _, ok := ficc.mutation.CreatedAt(); !ok {
+		v := fileidcheck.DefaultCreatedAt()
+		ficc.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (ficc *FileIDCheckCreate) check() error {
+	if _, ok := ficc.mutation.GetType(); !ok {
+		return &ValidationError{Name: "type", err: errors.New(`ent: missing required field "FileIDCheck.type"`)}
+	}
+	if _, ok := ficc.mutation.Dc(); !ok {
+		return &ValidationError{Name: "dc", err: errors.New(`ent: missing required field "FileIDCheck.dc"`)}
+	}
+	if _, ok := ficc.mutation.Result(); !ok {
+		return &ValidationError{Name: "result", err: errors.New(`ent: missing required field "FileIDCheck.result"`)}
+	}
+	if v, ok := ficc.mutation.Result(); ok {
+		if err := fileidcheck.ResultValidator(v); err != nil {
+			return &ValidationError{Name: "result", err: fmt.Errorf(`ent: validator failed for field "FileIDCheck.result": %w`, err)}
+		}
+	}
+	if _, ok := ficc.mutation.TdVersion(); !ok {
+		return &ValidationError{Name: "td_version", err: errors.New(`ent: missing required field "FileIDCheck.td_version"`)}
+	}
+	if _, ok := ficc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "FileIDCheck.created_at"`)}
+	}
+	return nil
+}
+
+func (ficc *FileIDCheckCreate) sqlSave(ctx context.Context) (*FileIDCheck, error) {
+	if err := ficc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := ficc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, ficc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	ficc.mutation.id = &_node.ID
+	ficc.mutation.done = true
+	return _node, nil
+}
+
+func (ficc *FileIDCheckCreate) createSpec() (*FileIDCheck, *sqlgraph.CreateSpec) {
+	var (
+		_node = &FileIDCheck{config: ficc.config}
+		_spec = sqlgraph.NewCreateSpec(fileidcheck.Table, sqlgraph.NewFieldSpec(fileidcheck.FieldID, field.TypeInt))
+	)
+	_spec.OnConflict = ficc.conflict
+	if value, ok := ficc.mutation.GetType(); ok {
+		_spec.SetField(fileidcheck.FieldType, field.TypeString, value)
+		_node.Type = value
+	}
+	if value, ok := ficc.mutation.Dc(); ok {
+		_spec.SetField(fileidcheck.FieldDc, field.TypeInt, value)
+		_node.Dc = value
+	}
+	if value, ok := ficc.mutation.FileID(); ok {
+		_spec.SetField(fileidcheck.FieldFileID, field.TypeString, value)
+		_node.FileID = value
+	}
+	if value, ok := ficc.mutation.BotAPIFileID(); ok {
+		_spec.SetField(fileidcheck.FieldBotAPIFileID, field.TypeString, value)
+		_node.BotAPIFileID = value
+	}
+	if value, ok := ficc.mutation.Result(); ok {
+		_spec.SetField(fileidcheck.FieldResult, field.TypeEnum, value)
+		_node.Result = value
+	}
+	if value, ok := ficc.mutation.Error(); ok {
+		_spec.SetField(fileidcheck.FieldError, field.TypeString, value)
+		_node.Error = value
+	}
+	if value, ok := ficc.mutation.TdVersion(); ok {
+		_spec.SetField(fileidcheck.FieldTdVersion, field.TypeString, value)
+		_node.TdVersion = value
+	}
+	if value, ok := ficc.mutation.CreatedAt(); ok {
+		_spec.SetField(fileidcheck.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.FileIDCheck.Create().
+//		SetType(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.FileIDCheckUpsert) {
+//			SetType(v+v).
+//		}).
+//		Exec(ctx)
+func (ficc *FileIDCheckCreate) OnConflict(opts ...sql.ConflictOption) *FileIDCheckUpsertOne {
+	ficc.conflict = opts
+	return &FileIDCheckUpsertOne{
+		create: ficc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.FileIDCheck.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (ficc *FileIDCheckCreate) OnConflictColumns(columns ...string) *FileIDCheckUpsertOne {
+	ficc.conflict = append(ficc.conflict, sql.ConflictColumns(columns...))
+	return &FileIDCheckUpsertOne{
+		create: ficc,
+	}
+}
+
+type (
+	// FileIDCheckUpsertOne is the builder for "upsert"-ing
+	//  one FileIDCheck node.
+	FileIDCheckUpsertOne struct {
+		create *FileIDCheckCreate
+	}
+
+	// FileIDCheckUpsert is the "OnConflict" setter.
+	FileIDCheckUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetType sets the "type" field.
+func (u *FileIDCheckUpsert) SetType(v string) *FileIDCheckUpsert {
+	u.Set(fileidcheck.FieldType, v)
+	return u
+}
+
+// UpdateType sets the "type" field to the value that was provided on create.
+func (u *FileIDCheckUpsert) UpdateType() *FileIDCheckUpsert {
+	u.SetExcluded(fileidcheck.FieldType)
+	return u
+}
+
+// SetDc sets the "dc" field.
+func (u *FileIDCheckUpsert) SetDc(v int) *FileIDCheckUpsert {
+	u.Set(fileidcheck.FieldDc, v)
+	return u
+}
+
+// UpdateDc sets the "dc" field to the value that was provided on create.
+func (u *FileIDCheckUpsert) UpdateDc() *FileIDCheckUpsert {
+	u.SetExcluded(fileidcheck.FieldDc)
+	return u
+}
+
+// AddDc adds v to the "dc" field.
+func (u *FileIDCheckUpsert) AddDc(v int) *FileIDCheckUpsert {
+	u.Add(fileidcheck.FieldDc, v)
+	return u
+}
+
+// SetFileID sets the "file_id" field.
+func (u *FileIDCheckUpsert) SetFileID(v string) *FileIDCheckUpsert {
+	u.Set(fileidcheck.FieldFileID, v)
+	return u
+}
+
+// UpdateFileID sets the "file_id" field to the value that was provided on create.
+func (u *FileIDCheckUpsert) UpdateFileID() *FileIDCheckUpsert {
+	u.SetExcluded(fileidcheck.FieldFileID)
+	return u
+}
+
+// ClearFileID clears the value of the "file_id" field.
+func (u *FileIDCheckUpsert) ClearFileID() *FileIDCheckUpsert {
+	u.SetNull(fileidcheck.FieldFileID)
+	return u
+}
+
+// SetBotAPIFileID sets the "bot_api_file_id" field.
+func (u *FileIDCheckUpsert) SetBotAPIFileID(v string) *FileIDCheckUpsert {
+	u.Set(fileidcheck.FieldBotAPIFileID, v)
+	return u
+}
+
+// UpdateBotAPIFileID sets the "bot_api_file_id" field to the value that was provided on create.
+func (u *FileIDCheckUpsert) UpdateBotAPIFileID() *FileIDCheckUpsert {
+	u.SetExcluded(fileidcheck.FieldBotAPIFileID)
+	return u
+}
+
+// ClearBotAPIFileID clears the value of the "bot_api_file_id" field.
+func (u *FileIDCheckUpsert) ClearBotAPIFileID() *FileIDCheckUpsert {
+	u.SetNull(fileidcheck.FieldBotAPIFileID)
+	return u
+}
+
+// SetResult sets the "result" field.
+func (u *FileIDCheckUpsert) SetResult(v fileidcheck.Result) *FileIDCheckUpsert {
+	u.Set(fileidcheck.FieldResult, v)
+	return u
+}
+
+// UpdateResult sets the "result" field to the value that was provided on create.
+func (u *FileIDCheckUpsert) UpdateResult() *FileIDCheckUpsert {
+	u.SetExcluded(fileidcheck.FieldResult)
+	return u
+}
+
+// SetError sets the "error" field.
+func (u *FileIDCheckUpsert) SetError(v string) *FileIDCheckUpsert {
+	u.Set(fileidcheck.FieldError, v)
+	return u
+}
+
+// UpdateError sets the "error" field to the value that was provided on create.
+func (u *FileIDCheckUpsert) UpdateError() *FileIDCheckUpsert {
+	u.SetExcluded(fileidcheck.FieldError)
+	return u
+}
+
+// ClearError clears the value of the "error" field.
+func (u *FileIDCheckUpsert) ClearError() *FileIDCheckUpsert {
+	u.SetNull(fileidcheck.FieldError)
+	return u
+}
+
+// SetTdVersion sets the "td_version" field.
+func (u *FileIDCheckUpsert) SetTdVersion(v string) *FileIDCheckUpsert {
+	u.Set(fileidcheck.FieldTdVersion, v)
+	return u
+}
+
+// UpdateTdVersion sets the "td_version" field to the value that was provided on create.
+func (u *FileIDCheckUpsert) UpdateTdVersion() *FileIDCheckUpsert {
+	u.SetExcluded(fileidcheck.FieldTdVersion)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create.
+// Using this option is equivalent to using:
+//
+//	client.FileIDCheck.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *FileIDCheckUpsertOne) UpdateNewValues() *FileIDCheckUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(fileidcheck.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.FileIDCheck.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *FileIDCheckUpsertOne) Ignore() *FileIDCheckUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *FileIDCheckUpsertOne) DoNothing() *FileIDCheckUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the FileIDCheckCreate.OnConflict
+// documentation for more info.
+func (u *FileIDCheckUpsertOne) Update(set func(*FileIDCheckUpsert)) *FileIDCheckUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&FileIDCheckUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetType sets the "type" field.
+func (u *FileIDCheckUpsertOne) SetType(v string) *FileIDCheckUpsertOne {
+	return u.Update(func(s *FileIDCheckUpsert) {
+		s.SetType(v)
+	})
+}
+
+// UpdateType sets the "type" field to the value that was provided on create.
+func (u *FileIDCheckUpsertOne) UpdateType() *FileIDCheckUpsertOne {
+	return u.Update(func(s *FileIDCheckUpsert) {
+		s.UpdateType()
+	})
+}
+
+// SetDc sets the "dc" field.
+func (u *FileIDCheckUpsertOne) SetDc(v int) *FileIDCheckUpsertOne {
+	return u.Update(func(s *FileIDCheckUpsert) {
+		s.SetDc(v)
+	})
+}
+
+// AddDc adds v to the "dc" field.
+func (u *FileIDCheckUpsertOne) AddDc(v int) *FileIDCheckUpsertOne {
+	return u.Update(func(s *FileIDCheckUpsert) {
+		s.AddDc(v)
+	})
+}
+
+// UpdateDc sets the "dc" field to the value that was provided on create.
+func (u *FileIDCheckUpsertOne) UpdateDc() *FileIDCheckUpsertOne {
+	return u.Update(func(s *FileIDCheckUpsert) {
+		s.UpdateDc()
+	})
+}
+
+// SetFileID sets the "file_id" field.
+func (u *FileIDCheckUpsertOne) SetFileID(v string) *FileIDCheckUpsertOne {
+	return u.Update(func(s *FileIDCheckUpsert) {
+		s.SetFileID(v)
+	})
+}
+
+// UpdateFileID sets the "file_id" field to the value that was provided on create.
+func (u *FileIDCheckUpsertOne) UpdateFileID() *FileIDCheckUpsertOne {
+	return u.Update(func(s *FileIDCheckUpsert) {
+		s.UpdateFileID()
+	})
+}
+
+// ClearFileID clears the value of the "file_id" field.
+func (u *FileIDCheckUpsertOne) ClearFileID() *FileIDCheckUpsertOne {
+	return u.Update(func(s *FileIDCheckUpsert) {
+		s.ClearFileID()
+	})
+}
+
+// SetBotAPIFileID sets the "bot_api_file_id" field.
+func (u *FileIDCheckUpsertOne) SetBotAPIFileID(v string) *FileIDCheckUpsertOne {
+	return u.Update(func(s *FileIDCheckUpsert) {
+		s.SetBotAPIFileID(v)
+	})
+}
+
+// UpdateBotAPIFileID sets the "bot_api_file_id" field to the value that was provided on create.
+func (u *FileIDCheckUpsertOne) UpdateBotAPIFileID() *FileIDCheckUpsertOne {
+	return u.Update(func(s *FileIDCheckUpsert) {
+		s.UpdateBotAPIFileID()
+	})
+}
+
+// ClearBotAPIFileID clears the value of the "bot_api_file_id" field.
+func (u *FileIDCheckUpsertOne) ClearBotAPIFileID() *FileIDCheckUpsertOne {
+	return u.Update(func(s *FileIDCheckUpsert) {
+		s.ClearBotAPIFileID()
+	})
+}
+
+// SetResult sets the "result" field.
+func (u *FileIDCheckUpsertOne) SetResult(v fileidcheck.Result) *FileIDCheckUpsertOne {
+	return u.Update(func(s *FileIDCheckUpsert) {
+		s.SetResult(v)
+	})
+}
+
+// UpdateResult sets the "result" field to the value that was provided on create.
+func (u *FileIDCheckUpsertOne) UpdateResult() *FileIDCheckUpsertOne {
+	return u.Update(func(s *FileIDCheckUpsert) {
+		s.UpdateResult()
+	})
+}
+
+// SetError sets the "error" field.
+func (u *FileIDCheckUpsertOne) SetError(v string) *FileIDCheckUpsertOne {
+	return u.Update(func(s *FileIDCheckUpsert) {
+		s.SetError(v)
+	})
+}
+
+// UpdateError sets the "error" field to the value that was provided on create.
+func (u *FileIDCheckUpsertOne) UpdateError() *FileIDCheckUpsertOne {
+	return u.Update(func(s *FileIDCheckUpsert) {
+		s.UpdateError()
+	})
+}
+
+// ClearError clears the value of the "error" field.
+func (u *FileIDCheckUpsertOne) ClearError() *FileIDCheckUpsertOne {
+	return u.Update(func(s *FileIDCheckUpsert) {
+		s.ClearError()
+	})
+}
+
+// SetTdVersion sets the "td_version" field.
+func (u *FileIDCheckUpsertOne) SetTdVersion(v string) *FileIDCheckUpsertOne {
+	return u.Update(func(s *FileIDCheckUpsert) {
+		s.SetTdVersion(v)
+	})
+}
+
+// UpdateTdVersion sets the "td_version" field to the value that was provided on create.
+func (u *FileIDCheckUpsertOne) UpdateTdVersion() *FileIDCheckUpsertOne {
+	return u.Update(func(s *FileIDCheckUpsert) {
+		s.UpdateTdVersion()
+	})
+}
+
+// Exec executes the query.
+func (u *FileIDCheckUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for FileIDCheckCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *FileIDCheckUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *FileIDCheckUpsertOne) ID(ctx context.Context) (id int, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *FileIDCheckUpsertOne) IDX(ctx context.Context) int {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// FileIDCheckCreateBulk is the builder for creating many FileIDCheck entities in bulk.
+type FileIDCheckCreateBulk struct {
+	config
+	err      error
+	builders []*FileIDCheckCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the FileIDCheck entities in the database.
+func (ficcb *FileIDCheckCreateBulk) Save(ctx context.Context) ([]*FileIDCheck, error) {
+	if ficcb.err != nil {
+		return nil, ficcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(ficcb.builders))
+	nodes := make([]*FileIDCheck, len(ficcb.builders))
+	mutators := make([]Mutator, len(ficcb.builders))
+	for i := range ficcb.builders {
+		func(i int, root context.Context) {
+			builder := ficcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*FileIDCheckMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, ficcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = ficcb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, ficcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, ficcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ficcb *FileIDCheckCreateBulk) SaveX(ctx context.Context) []*FileIDCheck {
+	v, err := ficcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (ficcb *FileIDCheckCreateBulk) Exec(ctx context.Context) error {
+	_, err := ficcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ficcb *FileIDCheckCreateBulk) ExecX(ctx context.Context) {
+	if err := ficcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.FileIDCheck.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.FileIDCheckUpsert) {
+//			SetType(v+v).
+//		}).
+//		Exec(ctx)
+func (ficcb *FileIDCheckCreateBulk) OnConflict(opts ...sql.ConflictOption) *FileIDCheckUpsertBulk {
+	ficcb.conflict = opts
+	return &FileIDCheckUpsertBulk{
+		create: ficcb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.FileIDCheck.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (ficcb *FileIDCheckCreateBulk) OnConflictColumns(columns ...string) *FileIDCheckUpsertBulk {
+	ficcb.conflict = append(ficcb.conflict, sql.ConflictColumns(columns...))
+	return &FileIDCheckUpsertBulk{
+		create: ficcb,
+	}
+}
+
+// FileIDCheckUpsertBulk is the builder for "upsert"-ing
+// a bulk of FileIDCheck nodes.
+type FileIDCheckUpsertBulk struct {
+	create *FileIDCheckCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.FileIDCheck.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *FileIDCheckUpsertBulk) UpdateNewValues() *FileIDCheckUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(fileidcheck.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.FileIDCheck.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *FileIDCheckUpsertBulk) Ignore() *FileIDCheckUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *FileIDCheckUpsertBulk) DoNothing() *FileIDCheckUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the FileIDCheckCreateBulk.OnConflict
+// documentation for more info.
+func (u *FileIDCheckUpsertBulk) Update(set func(*FileIDCheckUpsert)) *FileIDCheckUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&FileIDCheckUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetType sets the "type" field.
+func (u *FileIDCheckUpsertBulk) SetType(v string) *FileIDCheckUpsertBulk {
+	return u.Update(func(s *FileIDCheckUpsert) {
+		s.SetType(v)
+	})
+}
+
+// UpdateType sets the "type" field to the value that was provided on create.
+func (u *FileIDCheckUpsertBulk) UpdateType() *FileIDCheckUpsertBulk {
+	return u.Update(func(s *FileIDCheckUpsert) {
+		s.UpdateType()
+	})
+}
+
+// SetDc sets the "dc" field.
+func (u *FileIDCheckUpsertBulk) SetDc(v int) *FileIDCheckUpsertBulk {
+	return u.Update(func(s *FileIDCheckUpsert) {
+		s.SetDc(v)
+	})
+}
+
+// AddDc adds v to the "dc" field.
+func (u *FileIDCheckUpsertBulk) AddDc(v int) *FileIDCheckUpsertBulk {
+	return u.Update(func(s *FileIDCheckUpsert) {
+		s.AddDc(v)
+	})
+}
+
+// UpdateDc sets the "dc" field to the value that was provided on create.
+func (u *FileIDCheckUpsertBulk) UpdateDc() *FileIDCheckUpsertBulk {
+	return u.Update(func(s *FileIDCheckUpsert) {
+		s.UpdateDc()
+	})
+}
+
+// SetFileID sets the "file_id" field.
+func (u *FileIDCheckUpsertBulk) SetFileID(v string) *FileIDCheckUpsertBulk {
+	return u.Update(func(s *FileIDCheckUpsert) {
+		s.SetFileID(v)
+	})
+}
+
+// UpdateFileID sets the "file_id" field to the value that was provided on create.
+func (u *FileIDCheckUpsertBulk) UpdateFileID() *FileIDCheckUpsertBulk {
+	return u.Update(func(s *FileIDCheckUpsert) {
+		s.UpdateFileID()
+	})
+}
+
+// ClearFileID clears the value of the "file_id" field.
+func (u *FileIDCheckUpsertBulk) ClearFileID() *FileIDCheckUpsertBulk {
+	return u.Update(func(s *FileIDCheckUpsert) {
+		s.ClearFileID()
+	})
+}
+
+// SetBotAPIFileID sets the "bot_api_file_id" field.
+func (u *FileIDCheckUpsertBulk) SetBotAPIFileID(v string) *FileIDCheckUpsertBulk {
+	return u.Update(func(s *FileIDCheckUpsert) {
+		s.SetBotAPIFileID(v)
+	})
+}
+
+// UpdateBotAPIFileID sets the "bot_api_file_id" field to the value that was provided on create.
+func (u *FileIDCheckUpsertBulk) UpdateBotAPIFileID() *FileIDCheckUpsertBulk {
+	return u.Update(func(s *FileIDCheckUpsert) {
+		s.UpdateBotAPIFileID()
+	})
+}
+
+// ClearBotAPIFileID clears the value of the "bot_api_file_id" field.
+func (u *FileIDCheckUpsertBulk) ClearBotAPIFileID() *FileIDCheckUpsertBulk {
+	return u.Update(func(s *FileIDCheckUpsert) {
+		s.ClearBotAPIFileID()
+	})
+}
+
+// SetResult sets the "result" field.
+func (u *FileIDCheckUpsertBulk) SetResult(v fileidcheck.Result) *FileIDCheckUpsertBulk {
+	return u.Update(func(s *FileIDCheckUpsert) {
+		s.SetResult(v)
+	})
+}
+
+// UpdateResult sets the "result" field to the value that was provided on create.
+func (u *FileIDCheckUpsertBulk) UpdateResult() *FileIDCheckUpsertBulk {
+	return u.Update(func(s *FileIDCheckUpsert) {
+		s.UpdateResult()
+	})
+}
+
+// SetError sets the "error" field.
+func (u *FileIDCheckUpsertBulk) SetError(v string) *FileIDCheckUpsertBulk {
+	return u.Update(func(s *FileIDCheckUpsert) {
+		s.SetError(v)
+	})
+}
+
+// UpdateError sets the "error" field to the value that was provided on create.
+func (u *FileIDCheckUpsertBulk) UpdateError() *FileIDCheckUpsertBulk {
+	return u.Update(func(s *FileIDCheckUpsert) {
+		s.UpdateError()
+	})
+}
+
+// ClearError clears the value of the "error" field.
+func (u *FileIDCheckUpsertBulk) ClearError() *FileIDCheckUpsertBulk {
+	return u.Update(func(s *FileIDCheckUpsert) {
+		s.ClearError()
+	})
+}
+
+// SetTdVersion sets the "td_version" field.
+func (u *FileIDCheckUpsertBulk) SetTdVersion(v string) *FileIDCheckUpsertBulk {
+	return u.Update(func(s *FileIDCheckUpsert) {
+		s.SetTdVersion(v)
+	})
+}
+
+// UpdateTdVersion sets the "td_version" field to the value that was provided on create.
+func (u *FileIDCheckUpsertBulk) UpdateTdVersion() *FileIDCheckUpsertBulk {
+	return u.Update(func(s *FileIDCheckUpsert) {
+		s.UpdateTdVersion()
+	})
+}
+
+// Exec executes the query.
+func (u *FileIDCheckUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the FileIDCheckCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for FileIDCheckCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *FileIDCheckUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/gotd/bot/internal/ent/fileidcheck"
+	"github.com/gotd/bot/internal/ent/predicate"
+)
+
+// FileIDCheckDelete is the builder for deleting a FileIDCheck entity.
+type FileIDCheckDelete struct {
+	config
+	hooks    []Hook
+	mutation *FileIDCheckMutation
+}
+
+// Where appends a list predicates to the FileIDCheckDelete builder.
+func (ficd *FileIDCheckDelete) Where(ps ...predicate.FileIDCheck) *FileIDCheckDelete {
+	ficd.mutation.Where(ps...)
+	return ficd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (ficd *FileIDCheckDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, ficd.sqlExec, ficd.mutation, ficd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ficd *FileIDCheckDelete) ExecX(ctx context.Context) int {
+	n, err := ficd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (ficd *FileIDCheckDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(fileidcheck.Table, sqlgraph.NewFieldSpec(fileidcheck.FieldID, field.TypeInt))
+	if ps := ficd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, ficd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	ficd.mutation.done = true
+	return affected, err
+}
+
+// FileIDCheckDeleteOne is the builder for deleting a single FileIDCheck entity.
+type FileIDCheckDeleteOne struct {
+	ficd *FileIDCheckDelete
+}
+
+// Where appends a list predicates to the FileIDCheckDelete builder.
+func (ficdo *FileIDCheckDeleteOne) Where(ps ...predicate.FileIDCheck) *FileIDCheckDeleteOne {
+	ficdo.ficd.mutation.Where(ps...)
+	return ficdo
+}
+
+// Exec executes the deletion query.
+func (ficdo *FileIDCheckDeleteOne) Exec(ctx context.Context) error {
+	n, err := ficdo.ficd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{fileidcheck.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ficdo *FileIDCheckDeleteOne) ExecX(ctx context.Context) {
+	if err := ficdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,527 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/gotd/bot/internal/ent/fileidcheck"
+	"github.com/gotd/bot/internal/ent/predicate"
+)
+
+// FileIDCheckQuery is the builder for querying FileIDCheck entities.
+type FileIDCheckQuery struct {
+	config
+	ctx        *QueryContext
+	order      []fileidcheck.OrderOption
+	inters     []Interceptor
+	predicates []predicate.FileIDCheck
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the FileIDCheckQuery builder.
+func (ficq *FileIDCheckQuery) Where(ps ...predicate.FileIDCheck) *FileIDCheckQuery {
+	ficq.predicates = append(ficq.predicates, ps...)
+	return ficq
+}
+
+// Limit the number of records to be returned by this query.
+func (ficq *FileIDCheckQuery) Limit(limit int) *FileIDCheckQuery {
+	ficq.ctx.Limit = &limit
+	return ficq
+}
+
+// Offset to start from.
+func (ficq *FileIDCheckQuery) Offset(offset int) *FileIDCheckQuery {
+	ficq.ctx.Offset = &offset
+	return ficq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (ficq *FileIDCheckQuery) Unique(unique bool) *FileIDCheckQuery {
+	ficq.ctx.Unique = &unique
+	return ficq
+}
+
+// Order specifies how the records should be ordered.
+func (ficq *FileIDCheckQuery) Order(o ...fileidcheck.OrderOption) *FileIDCheckQuery {
+	ficq.order = append(ficq.order, o...)
+	return ficq
+}
+
+// First returns the first FileIDCheck entity from the query.
+// Returns a *NotFoundError when no FileIDCheck was found.
+func (ficq *FileIDCheckQuery) First(ctx context.Context) (*FileIDCheck, error) {
+	nodes, err := ficq.Limit(1).All(setContextOp(ctx, ficq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{fileidcheck.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (ficq *FileIDCheckQuery) FirstX(ctx context.Context) *FileIDCheck {
+	node, err := ficq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first FileIDCheck ID from the query.
+// Returns a *NotFoundError when no FileIDCheck ID was found.
+func (ficq *FileIDCheckQuery) FirstID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = ficq.Limit(1).IDs(setContextOp(ctx, ficq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{fileidcheck.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (ficq *FileIDCheckQuery) FirstIDX(ctx context.Context) int {
+	id, err := ficq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single FileIDCheck entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one FileIDCheck entity is found.
+// Returns a *NotFoundError when no FileIDCheck entities are found.
+func (ficq *FileIDCheckQuery) Only(ctx context.Context) (*FileIDCheck, error) {
+	nodes, err := ficq.Limit(2).All(setContextOp(ctx, ficq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{fileidcheck.Label}
+	default:
+		return nil, &NotSingularError{fileidcheck.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (ficq *FileIDCheckQuery) OnlyX(ctx context.Context) *FileIDCheck {
+	node, err := ficq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only FileIDCheck ID in the query.
+// Returns a *NotSingularError when more than one FileIDCheck ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (ficq *FileIDCheckQuery) OnlyID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = ficq.Limit(2).IDs(setContextOp(ctx, ficq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{fileidcheck.Label}
+	default:
+		err = &NotSingularError{fileidcheck.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (ficq *FileIDCheckQuery) OnlyIDX(ctx context.Context) int {
+	id, err := ficq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of FileIDChecks.
+func (ficq *FileIDCheckQuery) All(ctx context.Context) ([]*FileIDCheck, error) {
+	ctx = setContextOp(ctx, ficq.ctx, ent.OpQueryAll)
+	if err := ficq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*FileIDCheck, *FileIDCheckQuery]()
+	return withInterceptors[[]*FileIDCheck](ctx, ficq, qr, ficq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (ficq *FileIDCheckQuery) AllX(ctx context.Context) []*FileIDCheck {
+	nodes, err := ficq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of FileIDCheck IDs.
+func (ficq *FileIDCheckQuery) IDs(ctx context.Context) (ids []int, err error) {
+	if ficq.ctx.Unique == nil && ficq.path != nil {
+		ficq.Unique(true)
+	}
+	ctx = setContextOp(ctx, ficq.ctx, ent.OpQueryIDs)
+	if err = ficq.Select(fileidcheck.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (ficq *FileIDCheckQuery) IDsX(ctx context.Context) []int {
+	ids, err := ficq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (ficq *FileIDCheckQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, ficq.ctx, ent.OpQueryCount)
+	if err := ficq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, ficq, querierCount[*FileIDCheckQuery](), ficq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (ficq *FileIDCheckQuery) CountX(ctx context.Context) int {
+	count, err := ficq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (ficq *FileIDCheckQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, ficq.ctx, ent.OpQueryExist)
+	switch _, err := ficq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (ficq *FileIDCheckQuery) ExistX(ctx context.Context) bool {
+	exist, err := ficq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the FileIDCheckQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (ficq *FileIDCheckQuery) Clone() *FileIDCheckQuery {
+	if ficq == nil {
+		return nil
+	}
+	return &FileIDCheckQuery{
+		config:     ficq.config,
+		ctx:        ficq.ctx.Clone(),
+		order:      append([]fileidcheck.OrderOption{}, ficq.order...),
+		inters:     append([]Interceptor{}, ficq.inters...),
+		predicates: append([]predicate.FileIDCheck{}, ficq.predicates...),
+		// clone intermediate query.
+		sql:  ficq.sql.Clone(),
+		path: ficq.path,
+	}
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		Type string `json:"type,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.FileIDCheck.Query().
+//		GroupBy(fileidcheck.FieldType).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (ficq *FileIDCheckQuery) GroupBy(field string, fields ...string) *FileIDCheckGroupBy {
+	ficq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &FileIDCheckGroupBy{build: ficq}
+	grbuild.flds = &ficq.ctx.Fields
+	grbuild.label = fileidcheck.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		Type string `json:"type,omitempty"`
+//	}
+//
+//	client.FileIDCheck.Query().
+//		Select(fileidcheck.FieldType).
+//		Scan(ctx, &v)
+func (ficq *FileIDCheckQuery) Select(fields ...string) *FileIDCheckSelect {
+	ficq.ctx.Fields = append(ficq.ctx.Fields, fields...)
+	sbuild := &FileIDCheckSelect{FileIDCheckQuery: ficq}
+	sbuild.label = fileidcheck.Label
+	sbuild.flds, sbuild.scan = &ficq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a FileIDCheckSelect configured with the given aggregations.
+func (ficq *FileIDCheckQuery) Aggregate(fns ...AggregateFunc) *FileIDCheckSelect {
+	return ficq.Select().Aggregate(fns...)
+}
+
+func (ficq *FileIDCheckQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range ficq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, ficq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range ficq.ctx.Fields {
+		if !fileidcheck.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if ficq.path != nil {
+		prev, err := ficq.path(ctx)
+		if err != nil {
+			return err
+		}
+		ficq.sql = prev
+	}
+	return nil
+}
+
+func (ficq *FileIDCheckQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*FileIDCheck, error) {
+	var (
+		nodes = []*FileIDCheck{}
+		_spec = ficq.querySpec()
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*FileIDCheck).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &FileIDCheck{config: ficq.config}
+		nodes = append(nodes, node)
+		return node.assignValues(columns, values)
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, ficq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	return nodes, nil
+}
+
+func (ficq *FileIDCheckQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := ficq.querySpec()
+	_spec.Node.Columns = ficq.ctx.Fields
+	if len(ficq.ctx.Fields) > 0 {
+		_spec.Unique = ficq.ctx.Unique != nil && *ficq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, ficq.driver, _spec)
+}
+
+func (ficq *FileIDCheckQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(fileidcheck.Table, fileidcheck.Columns, sqlgraph.NewFieldSpec(fileidcheck.FieldID, field.TypeInt))
+	_spec.From = ficq.sql
+	if unique := ficq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if ficq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := ficq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, fileidcheck.FieldID)
+		for i := range fields {
+			if fields[i] != fileidcheck.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := ficq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := ficq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := ficq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := ficq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (ficq *FileIDCheckQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(ficq.driver.Dialect())
+	t1 := builder.Table(fileidcheck.Table)
+	columns := ficq.ctx.Fields
+	if len(columns) == 0 {
+		columns = fileidcheck.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if ficq.sql != nil {
+		selector = ficq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if ficq.ctx.Unique != nil && *ficq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, p := range ficq.predicates {
+		p(selector)
+	}
+	for _, p := range ficq.order {
+		p(selector)
+	}
+	if offset := ficq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := ficq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// FileIDCheckGroupBy is the group-by builder for FileIDCheck entities.
+type FileIDCheckGroupBy struct {
+	selector
+	build *FileIDCheckQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (ficgb *FileIDCheckGroupBy) Aggregate(fns ...AggregateFunc) *FileIDCheckGroupBy {
+	ficgb.fns = append(ficgb.fns, fns...)
+	return ficgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (ficgb *FileIDCheckGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, ficgb.build.ctx, ent.OpQueryGroupBy)
+	if err := ficgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*FileIDCheckQuery, *FileIDCheckGroupBy](ctx, ficgb.build, ficgb, ficgb.build.inters, v)
+}
+
+func (ficgb *FileIDCheckGroupBy) sqlScan(ctx context.Context, root *FileIDCheckQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(ficgb.fns))
+	for _, fn := range ficgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*ficgb.flds)+len(ficgb.fns))
+		for _, f := range *ficgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*ficgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := ficgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// FileIDCheckSelect is the builder for selecting fields of FileIDCheck entities.
+type FileIDCheckSelect struct {
+	*FileIDCheckQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (fics *FileIDCheckSelect) Aggregate(fns ...AggregateFunc) *FileIDCheckSelect {
+	fics.fns = append(fics.fns, fns...)
+	return fics
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (fics *FileIDCheckSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, fics.ctx, ent.OpQuerySelect)
+	if err := fics.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*FileIDCheckQuery, *FileIDCheckSelect](ctx, fics.FileIDCheckQuery, fics, fics.inters, v)
+}
+
+func (fics *FileIDCheckSelect) sqlScan(ctx context.Context, root *FileIDCheckQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(fics.fns))
+	for _, fn := range fics.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*fics.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := fics.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
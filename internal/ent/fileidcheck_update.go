@@ -0,0 +1,513 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/gotd/bot/internal/ent/fileidcheck"
+	"github.com/gotd/bot/internal/ent/predicate"
+)
+
+// FileIDCheckUpdate is the builder for updating FileIDCheck entities.
+type FileIDCheckUpdate struct {
+	config
+	hooks    []Hook
+	mutation *FileIDCheckMutation
+}
+
+// Where appends a list predicates to the FileIDCheckUpdate builder.
+func (ficu *FileIDCheckUpdate) Where(ps ...predicate.FileIDCheck) *FileIDCheckUpdate {
+	ficu.mutation.Where(ps...)
+	return ficu
+}
+
+// SetType sets the "type" field.
+func (ficu *FileIDCheckUpdate) SetType(s string) *FileIDCheckUpdate {
+	ficu.mutation.SetType(s)
+	return ficu
+}
+
+// SetNillableType sets the "type" field if the given value is not nil.
+func (ficu *FileIDCheckUpdate) SetNillableType(s *string) *FileIDCheckUpdate {
+	if s != nil {
+		ficu.SetType(*s)
+	}
+	return ficu
+}
+
+// SetDc sets the "dc" field.
+func (ficu *FileIDCheckUpdate) SetDc(i int) *FileIDCheckUpdate {
+	ficu.mutation.ResetDc()
+	ficu.mutation.SetDc(i)
+	return ficu
+}
+
+// SetNillableDc sets the "dc" field if the given value is not nil.
+func (ficu *FileIDCheckUpdate) SetNillableDc(i *int) *FileIDCheckUpdate {
+	if i != nil {
+		ficu.SetDc(*i)
+	}
+	return ficu
+}
+
+// AddDc adds i to the "dc" field.
+func (ficu *FileIDCheckUpdate) AddDc(i int) *FileIDCheckUpdate {
+	ficu.mutation.AddDc(i)
+	return ficu
+}
+
+// SetFileID sets the "file_id" field.
+func (ficu *FileIDCheckUpdate) SetFileID(s string) *FileIDCheckUpdate {
+	ficu.mutation.SetFileID(s)
+	return ficu
+}
+
+// SetNillableFileID sets the "file_id" field if the given value is not nil.
+func (ficu *FileIDCheckUpdate) SetNillableFileID(s *string) *FileIDCheckUpdate {
+	if s != nil {
+		ficu.SetFileID(*s)
+	}
+	return ficu
+}
+
+// ClearFileID clears the value of the "file_id" field.
+func (ficu *FileIDCheckUpdate) ClearFileID() *FileIDCheckUpdate {
+	ficu.mutation.ClearFileID()
+	return ficu
+}
+
+// SetBotAPIFileID sets the "bot_api_file_id" field.
+func (ficu *FileIDCheckUpdate) SetBotAPIFileID(s string) *FileIDCheckUpdate {
+	ficu.mutation.SetBotAPIFileID(s)
+	return ficu
+}
+
+// SetNillableBotAPIFileID sets the "bot_api_file_id" field if the given value is not nil.
+func (ficu *FileIDCheckUpdate) SetNillableBotAPIFileID(s *string) *FileIDCheckUpdate {
+	if s != nil {
+		ficu.SetBotAPIFileID(*s)
+	}
+	return ficu
+}
+
+// ClearBotAPIFileID clears the value of the "bot_api_file_id" field.
+func (ficu *FileIDCheckUpdate) ClearBotAPIFileID() *FileIDCheckUpdate {
+	ficu.mutation.ClearBotAPIFileID()
+	return ficu
+}
+
+// SetResult sets the "result" field.
+func (ficu *FileIDCheckUpdate) SetResult(f fileidcheck.Result) *FileIDCheckUpdate {
+	ficu.mutation.SetResult(f)
+	return ficu
+}
+
+// SetNillableResult sets the "result" field if the given value is not nil.
+func (ficu *FileIDCheckUpdate) SetNillableResult(f *fileidcheck.Result) *FileIDCheckUpdate {
+	if f != nil {
+		ficu.SetResult(*f)
+	}
+	return ficu
+}
+
+// SetError sets the "error" field.
+func (ficu *FileIDCheckUpdate) SetError(s string) *FileIDCheckUpdate {
+	ficu.mutation.SetError(s)
+	return ficu
+}
+
+// SetNillableError sets the "error" field if the given value is not nil.
+func (ficu *FileIDCheckUpdate) SetNillableError(s *string) *FileIDCheckUpdate {
+	if s != nil {
+		ficu.SetError(*s)
+	}
+	return ficu
+}
+
+// ClearError clears the value of the "error" field.
+func (ficu *FileIDCheckUpdate) ClearError() *FileIDCheckUpdate {
+	ficu.mutation.ClearError()
+	return ficu
+}
+
+// SetTdVersion sets the "td_version" field.
+func (ficu *FileIDCheckUpdate) SetTdVersion(s string) *FileIDCheckUpdate {
+	ficu.mutation.SetTdVersion(s)
+	return ficu
+}
+
+// SetNillableTdVersion sets the "td_version" field if the given value is not nil.
+func (ficu *FileIDCheckUpdate) SetNillableTdVersion(s *string) *FileIDCheckUpdate {
+	if s != nil {
+		ficu.SetTdVersion(*s)
+	}
+	return ficu
+}
+
+// Mutation returns the FileIDCheckMutation object of the builder.
+func (ficu *FileIDCheckUpdate) Mutation() *FileIDCheckMutation {
+	return ficu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (ficu *FileIDCheckUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, ficu.sqlSave, ficu.mutation, ficu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ficu *FileIDCheckUpdate) SaveX(ctx context.Context) int {
+	affected, err := ficu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (ficu *FileIDCheckUpdate) Exec(ctx context.Context) error {
+	_, err := ficu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ficu *FileIDCheckUpdate) ExecX(ctx context.Context) {
+	if err := ficu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (ficu *FileIDCheckUpdate) check() error {
+	if v, ok := ficu.mutation.Result(); ok {
+		if err := fileidcheck.ResultValidator(v); err != nil {
+			return &ValidationError{Name: "result", err: fmt.Errorf(`ent: validator failed for field "FileIDCheck.result": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (ficu *FileIDCheckUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := ficu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(fileidcheck.Table, fileidcheck.Columns, sqlgraph.NewFieldSpec(fileidcheck.FieldID, field.TypeInt))
+	if ps := ficu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := ficu.mutation.GetType(); ok {
+		_spec.SetField(fileidcheck.FieldType, field.TypeString, value)
+	}
+	if value, ok := ficu.mutation.Dc(); ok {
+		_spec.SetField(fileidcheck.FieldDc, field.TypeInt, value)
+	}
+	if value, ok := ficu.mutation.AddedDc(); ok {
+		_spec.AddField(fileidcheck.FieldDc, field.TypeInt, value)
+	}
+	if value, ok := ficu.mutation.FileID(); ok {
+		_spec.SetField(fileidcheck.FieldFileID, field.TypeString, value)
+	}
+	if ficu.mutation.FileIDCleared() {
+		_spec.ClearField(fileidcheck.FieldFileID, field.TypeString)
+	}
+	if value, ok := ficu.mutation.BotAPIFileID(); ok {
+		_spec.SetField(fileidcheck.FieldBotAPIFileID, field.TypeString, value)
+	}
+	if ficu.mutation.BotAPIFileIDCleared() {
+		_spec.ClearField(fileidcheck.FieldBotAPIFileID, field.TypeString)
+	}
+	if value, ok := ficu.mutation.Result(); ok {
+		_spec.SetField(fileidcheck.FieldResult, field.TypeEnum, value)
+	}
+	if value, ok := ficu.mutation.Error(); ok {
+		_spec.SetField(fileidcheck.FieldError, field.TypeString, value)
+	}
+	if ficu.mutation.ErrorCleared() {
+		_spec.ClearField(fileidcheck.FieldError, field.TypeString)
+	}
+	if value, ok := ficu.mutation.TdVersion(); ok {
+		_spec.SetField(fileidcheck.FieldTdVersion, field.TypeString, value)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, ficu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{fileidcheck.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	ficu.mutation.done = true
+	return n, nil
+}
+
+// FileIDCheckUpdateOne is the builder for updating a single FileIDCheck entity.
+type FileIDCheckUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *FileIDCheckMutation
+}
+
+// SetType sets the "type" field.
+func (ficuo *FileIDCheckUpdateOne) SetType(s string) *FileIDCheckUpdateOne {
+	ficuo.mutation.SetType(s)
+	return ficuo
+}
+
+// SetNillableType sets the "type" field if the given value is not nil.
+func (ficuo *FileIDCheckUpdateOne) SetNillableType(s *string) *FileIDCheckUpdateOne {
+	if s != nil {
+		ficuo.SetType(*s)
+	}
+	return ficuo
+}
+
+// SetDc sets the "dc" field.
+func (ficuo *FileIDCheckUpdateOne) SetDc(i int) *FileIDCheckUpdateOne {
+	ficuo.mutation.ResetDc()
+	ficuo.mutation.SetDc(i)
+	return ficuo
+}
+
+// SetNillableDc sets the "dc" field if the given value is not nil.
+func (ficuo *FileIDCheckUpdateOne) SetNillableDc(i *int) *FileIDCheckUpdateOne {
+	if i != nil {
+		ficuo.SetDc(*i)
+	}
+	return ficuo
+}
+
+// AddDc adds i to the "dc" field.
+func (ficuo *FileIDCheckUpdateOne) AddDc(i int) *FileIDCheckUpdateOne {
+	ficuo.mutation.AddDc(i)
+	return ficuo
+}
+
+// SetFileID sets the "file_id" field.
+func (ficuo *FileIDCheckUpdateOne) SetFileID(s string) *FileIDCheckUpdateOne {
+	ficuo.mutation.SetFileID(s)
+	return ficuo
+}
+
+// SetNillableFileID sets the "file_id" field if the given value is not nil.
+func (ficuo *FileIDCheckUpdateOne) SetNillableFileID(s *string) *FileIDCheckUpdateOne {
+	if s != nil {
+		ficuo.SetFileID(*s)
+	}
+	return ficuo
+}
+
+// ClearFileID clears the value of the "file_id" field.
+func (ficuo *FileIDCheckUpdateOne) ClearFileID() *FileIDCheckUpdateOne {
+	ficuo.mutation.ClearFileID()
+	return ficuo
+}
+
+// SetBotAPIFileID sets the "bot_api_file_id" field.
+func (ficuo *FileIDCheckUpdateOne) SetBotAPIFileID(s string) *FileIDCheckUpdateOne {
+	ficuo.mutation.SetBotAPIFileID(s)
+	return ficuo
+}
+
+// SetNillableBotAPIFileID sets the "bot_api_file_id" field if the given value is not nil.
+func (ficuo *FileIDCheckUpdateOne) SetNillableBotAPIFileID(s *string) *FileIDCheckUpdateOne {
+	if s != nil {
+		ficuo.SetBotAPIFileID(*s)
+	}
+	return ficuo
+}
+
+// ClearBotAPIFileID clears the value of the "bot_api_file_id" field.
+func (ficuo *FileIDCheckUpdateOne) ClearBotAPIFileID() *FileIDCheckUpdateOne {
+	ficuo.mutation.ClearBotAPIFileID()
+	return ficuo
+}
+
+// SetResult sets the "result" field.
+func (ficuo *FileIDCheckUpdateOne) SetResult(f fileidcheck.Result) *FileIDCheckUpdateOne {
+	ficuo.mutation.SetResult(f)
+	return ficuo
+}
+
+// SetNillableResult sets the "result" field if the given value is not nil.
+func (ficuo *FileIDCheckUpdateOne) SetNillableResult(f *fileidcheck.Result) *FileIDCheckUpdateOne {
+	if f != nil {
+		ficuo.SetResult(*f)
+	}
+	return ficuo
+}
+
+// SetError sets the "error" field.
+func (ficuo *FileIDCheckUpdateOne) SetError(s string) *FileIDCheckUpdateOne {
+	ficuo.mutation.SetError(s)
+	return ficuo
+}
+
+// SetNillableError sets the "error" field if the given value is not nil.
+func (ficuo *FileIDCheckUpdateOne) SetNillableError(s *string) *FileIDCheckUpdateOne {
+	if s != nil {
+		ficuo.SetError(*s)
+	}
+	return ficuo
+}
+
+// ClearError clears the value of the "error" field.
+func (ficuo *FileIDCheckUpdateOne) ClearError() *FileIDCheckUpdateOne {
+	ficuo.mutation.ClearError()
+	return ficuo
+}
+
+// SetTdVersion sets the "td_version" field.
+func (ficuo *FileIDCheckUpdateOne) SetTdVersion(s string) *FileIDCheckUpdateOne {
+	ficuo.mutation.SetTdVersion(s)
+	return ficuo
+}
+
+// SetNillableTdVersion sets the "td_version" field if the given value is not nil.
+func (ficuo *FileIDCheckUpdateOne) SetNillableTdVersion(s *string) *FileIDCheckUpdateOne {
+	if s != nil {
+		ficuo.SetTdVersion(*s)
+	}
+	return ficuo
+}
+
+// Mutation returns the FileIDCheckMutation object of the builder.
+func (ficuo *FileIDCheckUpdateOne) Mutation() *FileIDCheckMutation {
+	return ficuo.mutation
+}
+
+// Where appends a list predicates to the FileIDCheckUpdate builder.
+func (ficuo *FileIDCheckUpdateOne) Where(ps ...predicate.FileIDCheck) *FileIDCheckUpdateOne {
+	ficuo.mutation.Where(ps...)
+	return ficuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (ficuo *FileIDCheckUpdateOne) Select(field string, fields ...string) *FileIDCheckUpdateOne {
+	ficuo.fields = append([]string{field}, fields...)
+	return ficuo
+}
+
+// Save executes the query and returns the updated FileIDCheck entity.
+func (ficuo *FileIDCheckUpdateOne) Save(ctx context.Context) (*FileIDCheck, error) {
+	return withHooks(ctx, ficuo.sqlSave, ficuo.mutation, ficuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ficuo *FileIDCheckUpdateOne) SaveX(ctx context.Context) *FileIDCheck {
+	node, err := ficuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (ficuo *FileIDCheckUpdateOne) Exec(ctx context.Context) error {
+	_, err := ficuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ficuo *FileIDCheckUpdateOne) ExecX(ctx context.Context) {
+	if err := ficuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (ficuo *FileIDCheckUpdateOne) check() error {
+	if v, ok := ficuo.mutation.Result(); ok {
+		if err := fileidcheck.ResultValidator(v); err != nil {
+			return &ValidationError{Name: "result", err: fmt.Errorf(`ent: validator failed for field "FileIDCheck.result": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (ficuo *FileIDCheckUpdateOne) sqlSave(ctx context.Context) (_node *FileIDCheck, err error) {
+	if err := ficuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(fileidcheck.Table, fileidcheck.Columns, sqlgraph.NewFieldSpec(fileidcheck.FieldID, field.TypeInt))
+	id, ok := ficuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "FileIDCheck.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := ficuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, fileidcheck.FieldID)
+		for _, f := range fields {
+			if !fileidcheck.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != fileidcheck.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := ficuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := ficuo.mutation.GetType(); ok {
+		_spec.SetField(fileidcheck.FieldType, field.TypeString, value)
+	}
+	if value, ok := ficuo.mutation.Dc(); ok {
+		_spec.SetField(fileidcheck.FieldDc, field.TypeInt, value)
+	}
+	if value, ok := ficuo.mutation.AddedDc(); ok {
+		_spec.AddField(fileidcheck.FieldDc, field.TypeInt, value)
+	}
+	if value, ok := ficuo.mutation.FileID(); ok {
+		_spec.SetField(fileidcheck.FieldFileID, field.TypeString, value)
+	}
+	if ficuo.mutation.FileIDCleared() {
+		_spec.ClearField(fileidcheck.FieldFileID, field.TypeString)
+	}
+	if value, ok := ficuo.mutation.BotAPIFileID(); ok {
+		_spec.SetField(fileidcheck.FieldBotAPIFileID, field.TypeString, value)
+	}
+	if ficuo.mutation.BotAPIFileIDCleared() {
+		_spec.ClearField(fileidcheck.FieldBotAPIFileID, field.TypeString)
+	}
+	if value, ok := ficuo.mutation.Result(); ok {
+		_spec.SetField(fileidcheck.FieldResult, field.TypeEnum, value)
+	}
+	if value, ok := ficuo.mutation.Error(); ok {
+		_spec.SetField(fileidcheck.FieldError, field.TypeString, value)
+	}
+	if ficuo.mutation.ErrorCleared() {
+		_spec.ClearField(fileidcheck.FieldError, field.TypeString)
+	}
+	if value, ok := ficuo.mutation.TdVersion(); ok {
+		_spec.SetField(fileidcheck.FieldTdVersion, field.TypeString, value)
+	}
+	_node = &FileIDCheck{config: ficuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, ficuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{fileidcheck.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	ficuo.mutation.done = true
+	return _node, nil
+}
@@ -9,6 +9,18 @@ import (
 	"github.com/gotd/bot/internal/ent"
 )
 
+// The FileIDCheckFunc type is an adapter to allow the use of ordinary
+// function as FileIDCheck mutator.
+type FileIDCheckFunc func(context.Context, *ent.FileIDCheckMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f FileIDCheckFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.FileIDCheckMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.FileIDCheckMutation", m)
+}
+
 // The LastChannelMessageFunc type is an adapter to allow the use of ordinary
 // function as LastChannelMessage mutator.
 type LastChannelMessageFunc func(context.Context, *ent.LastChannelMessageMutation) (ent.Value, error)
@@ -57,6 +69,18 @@ func (f TelegramChannelStateFunc) Mutate(ctx context.Context, m ent.Mutation) (e
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.TelegramChannelStateMutation", m)
 }
 
+// The TelegramServiceMessageFunc type is an adapter to allow the use of ordinary
+// function as TelegramServiceMessage mutator.
+type TelegramServiceMessageFunc func(context.Context, *ent.TelegramServiceMessageMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f TelegramServiceMessageFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.TelegramServiceMessageMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.TelegramServiceMessageMutation", m)
+}
+
 // The TelegramSessionFunc type is an adapter to allow the use of ordinary
 // function as TelegramSession mutator.
 type TelegramSessionFunc func(context.Context, *ent.TelegramSessionMutation) (ent.Value, error)
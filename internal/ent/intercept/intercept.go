@@ -8,11 +8,13 @@ import (
 
 	"entgo.io/ent/dialect/sql"
 	"github.com/gotd/bot/internal/ent"
+	"github.com/gotd/bot/internal/ent/fileidcheck"
 	"github.com/gotd/bot/internal/ent/lastchannelmessage"
 	"github.com/gotd/bot/internal/ent/predicate"
 	"github.com/gotd/bot/internal/ent/prnotification"
 	"github.com/gotd/bot/internal/ent/telegramaccount"
 	"github.com/gotd/bot/internal/ent/telegramchannelstate"
+	"github.com/gotd/bot/internal/ent/telegramservicemessage"
 	"github.com/gotd/bot/internal/ent/telegramsession"
 	"github.com/gotd/bot/internal/ent/telegramuserstate"
 )
@@ -73,6 +75,33 @@ func (f TraverseFunc) Traverse(ctx context.Context, q ent.Query) error {
 	return f(ctx, query)
 }
 
+// The FileIDCheckFunc type is an adapter to allow the use of ordinary function as a Querier.
+type FileIDCheckFunc func(context.Context, *ent.FileIDCheckQuery) (ent.Value, error)
+
+// Query calls f(ctx, q).
+func (f FileIDCheckFunc) Query(ctx context.Context, q ent.Query) (ent.Value, error) {
+	if q, ok := q.(*ent.FileIDCheckQuery); ok {
+		return f(ctx, q)
+	}
+	return nil, fmt.Errorf("unexpected query type %T. expect *ent.FileIDCheckQuery", q)
+}
+
+// The TraverseFileIDCheck type is an adapter to allow the use of ordinary function as Traverser.
+type TraverseFileIDCheck func(context.Context, *ent.FileIDCheckQuery) error
+
+// Intercept is a dummy implementation of Intercept that returns the next Querier in the pipeline.
+func (f TraverseFileIDCheck) Intercept(next ent.Querier) ent.Querier {
+	return next
+}
+
+// Traverse calls f(ctx, q).
+func (f TraverseFileIDCheck) Traverse(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.FileIDCheckQuery); ok {
+		return f(ctx, q)
+	}
+	return fmt.Errorf("unexpected query type %T. expect *ent.FileIDCheckQuery", q)
+}
+
 // The LastChannelMessageFunc type is an adapter to allow the use of ordinary function as a Querier.
 type LastChannelMessageFunc func(context.Context, *ent.LastChannelMessageQuery) (ent.Value, error)
 
@@ -181,6 +210,33 @@ func (f TraverseTelegramChannelState) Traverse(ctx context.Context, q ent.Query)
 	return fmt.Errorf("unexpected query type %T. expect *ent.TelegramChannelStateQuery", q)
 }
 
+// The TelegramServiceMessageFunc type is an adapter to allow the use of ordinary function as a Querier.
+type TelegramServiceMessageFunc func(context.Context, *ent.TelegramServiceMessageQuery) (ent.Value, error)
+
+// Query calls f(ctx, q).
+func (f TelegramServiceMessageFunc) Query(ctx context.Context, q ent.Query) (ent.Value, error) {
+	if q, ok := q.(*ent.TelegramServiceMessageQuery); ok {
+		return f(ctx, q)
+	}
+	return nil, fmt.Errorf("unexpected query type %T. expect *ent.TelegramServiceMessageQuery", q)
+}
+
+// The TraverseTelegramServiceMessage type is an adapter to allow the use of ordinary function as Traverser.
+type TraverseTelegramServiceMessage func(context.Context, *ent.TelegramServiceMessageQuery) error
+
+// Intercept is a dummy implementation of Intercept that returns the next Querier in the pipeline.
+func (f TraverseTelegramServiceMessage) Intercept(next ent.Querier) ent.Querier {
+	return next
+}
+
+// Traverse calls f(ctx, q).
+func (f TraverseTelegramServiceMessage) Traverse(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.TelegramServiceMessageQuery); ok {
+		return f(ctx, q)
+	}
+	return fmt.Errorf("unexpected query type %T. expect *ent.TelegramServiceMessageQuery", q)
+}
+
 // The TelegramSessionFunc type is an adapter to allow the use of ordinary function as a Querier.
 type TelegramSessionFunc func(context.Context, *ent.TelegramSessionQuery) (ent.Value, error)
 
@@ -238,6 +294,8 @@ func (f TraverseTelegramUserState) Traverse(ctx context.Context, q ent.Query) er
 // NewQuery returns the generic Query interface for the given typed query.
 func NewQuery(q ent.Query) (Query, error) {
 	switch q := q.(type) {
+	case *ent.FileIDCheckQuery:
+		return &query[*ent.FileIDCheckQuery, predicate.FileIDCheck, fileidcheck.OrderOption]{typ: ent.TypeFileIDCheck, tq: q}, nil
 	case *ent.LastChannelMessageQuery:
 		return &query[*ent.LastChannelMessageQuery, predicate.LastChannelMessage, lastchannelmessage.OrderOption]{typ: ent.TypeLastChannelMessage, tq: q}, nil
 	case *ent.PRNotificationQuery:
@@ -246,6 +304,8 @@ func NewQuery(q ent.Query) (Query, error) {
 		return &query[*ent.TelegramAccountQuery, predicate.TelegramAccount, telegramaccount.OrderOption]{typ: ent.TypeTelegramAccount, tq: q}, nil
 	case *ent.TelegramChannelStateQuery:
 		return &query[*ent.TelegramChannelStateQuery, predicate.TelegramChannelState, telegramchannelstate.OrderOption]{typ: ent.TypeTelegramChannelState, tq: q}, nil
+	case *ent.TelegramServiceMessageQuery:
+		return &query[*ent.TelegramServiceMessageQuery, predicate.TelegramServiceMessage, telegramservicemessage.OrderOption]{typ: ent.TypeTelegramServiceMessage, tq: q}, nil
 	case *ent.TelegramSessionQuery:
 		return &query[*ent.TelegramSessionQuery, predicate.TelegramSession, telegramsession.OrderOption]{typ: ent.TypeTelegramSession, tq: q}, nil
 	case *ent.TelegramUserStateQuery:
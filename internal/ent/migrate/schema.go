@@ -8,6 +8,36 @@ import (
 )
 
 var (
+	// FileIDChecksColumns holds the columns for the "file_id_checks" table.
+	FileIDChecksColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "type", Type: field.TypeString},
+		{Name: "dc", Type: field.TypeInt},
+		{Name: "file_id", Type: field.TypeString, Nullable: true},
+		{Name: "bot_api_file_id", Type: field.TypeString, Nullable: true},
+		{Name: "result", Type: field.TypeEnum, Enums: []string{"OK", "EncodeError", "Rejected", "Mismatch"}},
+		{Name: "error", Type: field.TypeString, Nullable: true},
+		{Name: "td_version", Type: field.TypeString},
+		{Name: "created_at", Type: field.TypeTime},
+	}
+	// FileIDChecksTable holds the schema information for the "file_id_checks" table.
+	FileIDChecksTable = &schema.Table{
+		Name:       "file_id_checks",
+		Columns:    FileIDChecksColumns,
+		PrimaryKey: []*schema.Column{FileIDChecksColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "fileidcheck_type_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{FileIDChecksColumns[1], FileIDChecksColumns[8]},
+			},
+			{
+				Name:    "fileidcheck_type_td_version",
+				Unique:  false,
+				Columns: []*schema.Column{FileIDChecksColumns[1], FileIDChecksColumns[7]},
+			},
+		},
+	}
 	// LastChannelMessagesColumns holds the columns for the "last_channel_messages" table.
 	LastChannelMessagesColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt64, Increment: true},
@@ -50,6 +80,12 @@ var (
 		{Name: "state", Type: field.TypeEnum, Enums: []string{"New", "CodeSent", "Active", "Error"}, Default: "New"},
 		{Name: "status", Type: field.TypeString},
 		{Name: "session_data", Type: field.TypeBytes, Nullable: true},
+		{Name: "password", Type: field.TypeBytes, Nullable: true},
+		{Name: "disabled", Type: field.TypeBool, Default: false},
+		{Name: "dc_list", Type: field.TypeEnum, Enums: []string{"Test", "Production"}, Default: "Test"},
+		{Name: "dc", Type: field.TypeInt, Nullable: true},
+		{Name: "app_id", Type: field.TypeInt, Nullable: true},
+		{Name: "app_hash", Type: field.TypeString, Nullable: true},
 	}
 	// TelegramAccountsTable holds the schema information for the "telegram_accounts" table.
 	TelegramAccountsTable = &schema.Table{
@@ -85,6 +121,41 @@ var (
 			},
 		},
 	}
+	// TelegramServiceMessagesColumns holds the columns for the "telegram_service_messages" table.
+	TelegramServiceMessagesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "message_id", Type: field.TypeInt},
+		{Name: "type", Type: field.TypeEnum, Enums: []string{"Code", "NewLogin", "EmailVerification", "Frozen", "Other"}},
+		{Name: "text", Type: field.TypeString},
+		{Name: "date", Type: field.TypeTime},
+		{Name: "account_id", Type: field.TypeString},
+	}
+	// TelegramServiceMessagesTable holds the schema information for the "telegram_service_messages" table.
+	TelegramServiceMessagesTable = &schema.Table{
+		Name:       "telegram_service_messages",
+		Columns:    TelegramServiceMessagesColumns,
+		PrimaryKey: []*schema.Column{TelegramServiceMessagesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "telegram_service_messages_telegram_accounts_service_messages",
+				Columns:    []*schema.Column{TelegramServiceMessagesColumns[5]},
+				RefColumns: []*schema.Column{TelegramAccountsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "telegramservicemessage_account_id_message_id",
+				Unique:  true,
+				Columns: []*schema.Column{TelegramServiceMessagesColumns[5], TelegramServiceMessagesColumns[1]},
+			},
+			{
+				Name:    "telegramservicemessage_account_id_date",
+				Unique:  false,
+				Columns: []*schema.Column{TelegramServiceMessagesColumns[5], TelegramServiceMessagesColumns[4]},
+			},
+		},
+	}
 	// TelegramSessionsColumns holds the columns for the "telegram_sessions" table.
 	TelegramSessionsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeUUID},
@@ -112,10 +183,12 @@ var (
 	}
 	// Tables holds all the tables in the schema.
 	Tables = []*schema.Table{
+		FileIDChecksTable,
 		LastChannelMessagesTable,
 		PrNotificationsTable,
 		TelegramAccountsTable,
 		TelegramChannelStatesTable,
+		TelegramServiceMessagesTable,
 		TelegramSessionsTable,
 		TelegramUserStatesTable,
 	}
@@ -123,4 +196,5 @@ var (
 
 func init() {
 	TelegramChannelStatesTable.ForeignKeys[0].RefTable = TelegramUserStatesTable
+	TelegramServiceMessagesTable.ForeignKeys[0].RefTable = TelegramAccountsTable
 }
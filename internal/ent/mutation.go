@@ -12,11 +12,13 @@ import (
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
 	"github.com/google/uuid"
+	"github.com/gotd/bot/internal/ent/fileidcheck"
 	"github.com/gotd/bot/internal/ent/lastchannelmessage"
 	"github.com/gotd/bot/internal/ent/predicate"
 	"github.com/gotd/bot/internal/ent/prnotification"
 	"github.com/gotd/bot/internal/ent/telegramaccount"
 	"github.com/gotd/bot/internal/ent/telegramchannelstate"
+	"github.com/gotd/bot/internal/ent/telegramservicemessage"
 	"github.com/gotd/bot/internal/ent/telegramsession"
 	"github.com/gotd/bot/internal/ent/telegramuserstate"
 )
@@ -30,39 +32,48 @@ const (
 	OpUpdateOne = ent.OpUpdateOne
 
 	// Node types.
-	TypeLastChannelMessage   = "LastChannelMessage"
-	TypePRNotification       = "PRNotification"
-	TypeTelegramAccount      = "TelegramAccount"
-	TypeTelegramChannelState = "TelegramChannelState"
-	TypeTelegramSession      = "TelegramSession"
-	TypeTelegramUserState    = "TelegramUserState"
+	TypeFileIDCheck            = "FileIDCheck"
+	TypeLastChannelMessage     = "LastChannelMessage"
+	TypePRNotification         = "PRNotification"
+	TypeTelegramAccount        = "TelegramAccount"
+	TypeTelegramChannelState   = "TelegramChannelState"
+	TypeTelegramServiceMessage = "TelegramServiceMessage"
+	TypeTelegramSession        = "TelegramSession"
+	TypeTelegramUserState      = "TelegramUserState"
 )
 
-// LastChannelMessageMutation represents an operation that mutates the LastChannelMessage nodes in the graph.
-type LastChannelMessageMutation struct {
+// FileIDCheckMutation represents an operation that mutates the FileIDCheck nodes in the graph.
+type FileIDCheckMutation struct {
 	config
-	op            Op
-	typ           string
-	id            *int64
-	message_id    *int
-	addmessage_id *int
-	clearedFields map[string]struct{}
-	done          bool
-	oldValue      func(context.Context) (*LastChannelMessage, error)
-	predicates    []predicate.LastChannelMessage
+	op              Op
+	typ             string
+	id              *int
+	_type           *string
+	dc              *int
+	adddc           *int
+	file_id         *string
+	bot_api_file_id *string
+	result          *fileidcheck.Result
+	error           *string
+	td_version      *string
+	created_at      *time.Time
+	clearedFields   map[string]struct{}
+	done            bool
+	oldValue        func(context.Context) (*FileIDCheck, error)
+	predicates      []predicate.FileIDCheck
 }
 
-var _ ent.Mutation = (*LastChannelMessageMutation)(nil)
+var _ ent.Mutation = (*FileIDCheckMutation)(nil)
 
-// lastchannelmessageOption allows management of the mutation configuration using functional options.
-type lastchannelmessageOption func(*LastChannelMessageMutation)
+// fileidcheckOption allows management of the mutation configuration using functional options.
+type fileidcheckOption func(*FileIDCheckMutation)
 
-// newLastChannelMessageMutation creates new mutation for the LastChannelMessage entity.
-func newLastChannelMessageMutation(c config, op Op, opts ...lastchannelmessageOption) *LastChannelMessageMutation {
-	m := &LastChannelMessageMutation{
+// newFileIDCheckMutation creates new mutation for the FileIDCheck entity.
+func newFileIDCheckMutation(c config, op Op, opts ...fileidcheckOption) *FileIDCheckMutation {
+	m := &FileIDCheckMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeLastChannelMessage,
+		typ:           TypeFileIDCheck,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -71,20 +82,20 @@ func newLastChannelMessageMutation(c config, op Op, opts ...lastchannelmessageOp
 	return m
 }
 
-// withLastChannelMessageID sets the ID field of the mutation.
-func withLastChannelMessageID(id int64) lastchannelmessageOption {
-	return func(m *LastChannelMessageMutation) {
+// withFileIDCheckID sets the ID field of the mutation.
+func withFileIDCheckID(id int) fileidcheckOption {
+	return func(m *FileIDCheckMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *LastChannelMessage
+			value *FileIDCheck
 		)
-		m.oldValue = func(ctx context.Context) (*LastChannelMessage, error) {
+		m.oldValue = func(ctx context.Context) (*FileIDCheck, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().LastChannelMessage.Get(ctx, id)
+					value, err = m.Client().FileIDCheck.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -93,10 +104,10 @@ func withLastChannelMessageID(id int64) lastchannelmessageOption {
 	}
 }
 
-// withLastChannelMessage sets the old LastChannelMessage of the mutation.
-func withLastChannelMessage(node *LastChannelMessage) lastchannelmessageOption {
-	return func(m *LastChannelMessageMutation) {
-		m.oldValue = func(context.Context) (*LastChannelMessage, error) {
+// withFileIDCheck sets the old FileIDCheck of the mutation.
+func withFileIDCheck(node *FileIDCheck) fileidcheckOption {
+	return func(m *FileIDCheckMutation) {
+		m.oldValue = func(context.Context) (*FileIDCheck, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -105,7 +116,7 @@ func withLastChannelMessage(node *LastChannelMessage) lastchannelmessageOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m LastChannelMessageMutation) Client() *Client {
+func (m FileIDCheckMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -113,7 +124,7 @@ func (m LastChannelMessageMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m LastChannelMessageMutation) Tx() (*Tx, error) {
+func (m FileIDCheckMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -122,15 +133,9 @@ func (m LastChannelMessageMutation) Tx() (*Tx, error) {
 	return tx, nil
 }
 
-// SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of LastChannelMessage entities.
-func (m *LastChannelMessageMutation) SetID(id int64) {
-	m.id = &id
-}
-
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *LastChannelMessageMutation) ID() (id int64, exists bool) {
+func (m *FileIDCheckMutation) ID() (id int, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -141,86 +146,377 @@ func (m *LastChannelMessageMutation) ID() (id int64, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *LastChannelMessageMutation) IDs(ctx context.Context) ([]int64, error) {
+func (m *FileIDCheckMutation) IDs(ctx context.Context) ([]int, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
 		if exists {
-			return []int64{id}, nil
+			return []int{id}, nil
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().LastChannelMessage.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().FileIDCheck.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetMessageID sets the "message_id" field.
-func (m *LastChannelMessageMutation) SetMessageID(i int) {
-	m.message_id = &i
-	m.addmessage_id = nil
+// SetType sets the "type" field.
+func (m *FileIDCheckMutation) SetType(s string) {
+	m._type = &s
 }
 
-// MessageID returns the value of the "message_id" field in the mutation.
-func (m *LastChannelMessageMutation) MessageID() (r int, exists bool) {
-	v := m.message_id
+// GetType returns the value of the "type" field in the mutation.
+func (m *FileIDCheckMutation) GetType() (r string, exists bool) {
+	v := m._type
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldMessageID returns the old "message_id" field's value of the LastChannelMessage entity.
-// If the LastChannelMessage object wasn't provided to the builder, the object is fetched from the database.
+// OldType returns the old "type" field's value of the FileIDCheck entity.
+// If the FileIDCheck object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *LastChannelMessageMutation) OldMessageID(ctx context.Context) (v int, err error) {
+func (m *FileIDCheckMutation) OldType(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldMessageID is only allowed on UpdateOne operations")
+		return v, errors.New("OldType is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldMessageID requires an ID field in the mutation")
+		return v, errors.New("OldType requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldMessageID: %w", err)
+		return v, fmt.Errorf("querying old value for OldType: %w", err)
 	}
-	return oldValue.MessageID, nil
+	return oldValue.Type, nil
 }
 
-// AddMessageID adds i to the "message_id" field.
-func (m *LastChannelMessageMutation) AddMessageID(i int) {
-	if m.addmessage_id != nil {
-		*m.addmessage_id += i
+// ResetType resets all changes to the "type" field.
+func (m *FileIDCheckMutation) ResetType() {
+	m._type = nil
+}
+
+// SetDc sets the "dc" field.
+func (m *FileIDCheckMutation) SetDc(i int) {
+	m.dc = &i
+	m.adddc = nil
+}
+
+// Dc returns the value of the "dc" field in the mutation.
+func (m *FileIDCheckMutation) Dc() (r int, exists bool) {
+	v := m.dc
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDc returns the old "dc" field's value of the FileIDCheck entity.
+// If the FileIDCheck object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FileIDCheckMutation) OldDc(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDc is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDc requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDc: %w", err)
+	}
+	return oldValue.Dc, nil
+}
+
+// AddDc adds i to the "dc" field.
+func (m *FileIDCheckMutation) AddDc(i int) {
+	if m.adddc != nil {
+		*m.adddc += i
 	} else {
-		m.addmessage_id = &i
+		m.adddc = &i
 	}
 }
 
-// AddedMessageID returns the value that was added to the "message_id" field in this mutation.
-func (m *LastChannelMessageMutation) AddedMessageID() (r int, exists bool) {
-	v := m.addmessage_id
+// AddedDc returns the value that was added to the "dc" field in this mutation.
+func (m *FileIDCheckMutation) AddedDc() (r int, exists bool) {
+	v := m.adddc
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// ResetMessageID resets all changes to the "message_id" field.
-func (m *LastChannelMessageMutation) ResetMessageID() {
-	m.message_id = nil
-	m.addmessage_id = nil
+// ResetDc resets all changes to the "dc" field.
+func (m *FileIDCheckMutation) ResetDc() {
+	m.dc = nil
+	m.adddc = nil
 }
 
-// Where appends a list predicates to the LastChannelMessageMutation builder.
-func (m *LastChannelMessageMutation) Where(ps ...predicate.LastChannelMessage) {
+// SetFileID sets the "file_id" field.
+func (m *FileIDCheckMutation) SetFileID(s string) {
+	m.file_id = &s
+}
+
+// FileID returns the value of the "file_id" field in the mutation.
+func (m *FileIDCheckMutation) FileID() (r string, exists bool) {
+	v := m.file_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFileID returns the old "file_id" field's value of the FileIDCheck entity.
+// If the FileIDCheck object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FileIDCheckMutation) OldFileID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFileID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFileID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFileID: %w", err)
+	}
+	return oldValue.FileID, nil
+}
+
+// ClearFileID clears the value of the "file_id" field.
+func (m *FileIDCheckMutation) ClearFileID() {
+	m.file_id = nil
+	m.clearedFields[fileidcheck.FieldFileID] = struct{}{}
+}
+
+// FileIDCleared returns if the "file_id" field was cleared in this mutation.
+func (m *FileIDCheckMutation) FileIDCleared() bool {
+	_, ok := m.clearedFields[fileidcheck.FieldFileID]
+	return ok
+}
+
+// ResetFileID resets all changes to the "file_id" field.
+func (m *FileIDCheckMutation) ResetFileID() {
+	m.file_id = nil
+	delete(m.clearedFields, fileidcheck.FieldFileID)
+}
+
+// SetBotAPIFileID sets the "bot_api_file_id" field.
+func (m *FileIDCheckMutation) SetBotAPIFileID(s string) {
+	m.bot_api_file_id = &s
+}
+
+// BotAPIFileID returns the value of the "bot_api_file_id" field in the mutation.
+func (m *FileIDCheckMutation) BotAPIFileID() (r string, exists bool) {
+	v := m.bot_api_file_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldBotAPIFileID returns the old "bot_api_file_id" field's value of the FileIDCheck entity.
+// If the FileIDCheck object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FileIDCheckMutation) OldBotAPIFileID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldBotAPIFileID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldBotAPIFileID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldBotAPIFileID: %w", err)
+	}
+	return oldValue.BotAPIFileID, nil
+}
+
+// ClearBotAPIFileID clears the value of the "bot_api_file_id" field.
+func (m *FileIDCheckMutation) ClearBotAPIFileID() {
+	m.bot_api_file_id = nil
+	m.clearedFields[fileidcheck.FieldBotAPIFileID] = struct{}{}
+}
+
+// BotAPIFileIDCleared returns if the "bot_api_file_id" field was cleared in this mutation.
+func (m *FileIDCheckMutation) BotAPIFileIDCleared() bool {
+	_, ok := m.clearedFields[fileidcheck.FieldBotAPIFileID]
+	return ok
+}
+
+// ResetBotAPIFileID resets all changes to the "bot_api_file_id" field.
+func (m *FileIDCheckMutation) ResetBotAPIFileID() {
+	m.bot_api_file_id = nil
+	delete(m.clearedFields, fileidcheck.FieldBotAPIFileID)
+}
+
+// SetResult sets the "result" field.
+func (m *FileIDCheckMutation) SetResult(f fileidcheck.Result) {
+	m.result = &f
+}
+
+// Result returns the value of the "result" field in the mutation.
+func (m *FileIDCheckMutation) Result() (r fileidcheck.Result, exists bool) {
+	v := m.result
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldResult returns the old "result" field's value of the FileIDCheck entity.
+// If the FileIDCheck object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FileIDCheckMutation) OldResult(ctx context.Context) (v fileidcheck.Result, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldResult is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldResult requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldResult: %w", err)
+	}
+	return oldValue.Result, nil
+}
+
+// ResetResult resets all changes to the "result" field.
+func (m *FileIDCheckMutation) ResetResult() {
+	m.result = nil
+}
+
+// SetError sets the "error" field.
+func (m *FileIDCheckMutation) SetError(s string) {
+	m.error = &s
+}
+
+// Error returns the value of the "error" field in the mutation.
+func (m *FileIDCheckMutation) Error() (r string, exists bool) {
+	v := m.error
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldError returns the old "error" field's value of the FileIDCheck entity.
+// If the FileIDCheck object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FileIDCheckMutation) OldError(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldError is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldError requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldError: %w", err)
+	}
+	return oldValue.Error, nil
+}
+
+// ClearError clears the value of the "error" field.
+func (m *FileIDCheckMutation) ClearError() {
+	m.error = nil
+	m.clearedFields[fileidcheck.FieldError] = struct{}{}
+}
+
+// ErrorCleared returns if the "error" field was cleared in this mutation.
+func (m *FileIDCheckMutation) ErrorCleared() bool {
+	_, ok := m.clearedFields[fileidcheck.FieldError]
+	return ok
+}
+
+// ResetError resets all changes to the "error" field.
+func (m *FileIDCheckMutation) ResetError() {
+	m.error = nil
+	delete(m.clearedFields, fileidcheck.FieldError)
+}
+
+// SetTdVersion sets the "td_version" field.
+func (m *FileIDCheckMutation) SetTdVersion(s string) {
+	m.td_version = &s
+}
+
+// TdVersion returns the value of the "td_version" field in the mutation.
+func (m *FileIDCheckMutation) TdVersion() (r string, exists bool) {
+	v := m.td_version
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTdVersion returns the old "td_version" field's value of the FileIDCheck entity.
+// If the FileIDCheck object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FileIDCheckMutation) OldTdVersion(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTdVersion is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTdVersion requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTdVersion: %w", err)
+	}
+	return oldValue.TdVersion, nil
+}
+
+// ResetTdVersion resets all changes to the "td_version" field.
+func (m *FileIDCheckMutation) ResetTdVersion() {
+	m.td_version = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *FileIDCheckMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *FileIDCheckMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the FileIDCheck entity.
+// If the FileIDCheck object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FileIDCheckMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *FileIDCheckMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// Where appends a list predicates to the FileIDCheckMutation builder.
+func (m *FileIDCheckMutation) Where(ps ...predicate.FileIDCheck) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the LastChannelMessageMutation builder. Using this method,
+// WhereP appends storage-level predicates to the FileIDCheckMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *LastChannelMessageMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.LastChannelMessage, len(ps))
+func (m *FileIDCheckMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.FileIDCheck, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -228,27 +524,48 @@ func (m *LastChannelMessageMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *LastChannelMessageMutation) Op() Op {
+func (m *FileIDCheckMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *LastChannelMessageMutation) SetOp(op Op) {
+func (m *FileIDCheckMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (LastChannelMessage).
-func (m *LastChannelMessageMutation) Type() string {
+// Type returns the node type of this mutation (FileIDCheck).
+func (m *FileIDCheckMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *LastChannelMessageMutation) Fields() []string {
-	fields := make([]string, 0, 1)
-	if m.message_id != nil {
-		fields = append(fields, lastchannelmessage.FieldMessageID)
+func (m *FileIDCheckMutation) Fields() []string {
+	fields := make([]string, 0, 8)
+	if m._type != nil {
+		fields = append(fields, fileidcheck.FieldType)
+	}
+	if m.dc != nil {
+		fields = append(fields, fileidcheck.FieldDc)
+	}
+	if m.file_id != nil {
+		fields = append(fields, fileidcheck.FieldFileID)
+	}
+	if m.bot_api_file_id != nil {
+		fields = append(fields, fileidcheck.FieldBotAPIFileID)
+	}
+	if m.result != nil {
+		fields = append(fields, fileidcheck.FieldResult)
+	}
+	if m.error != nil {
+		fields = append(fields, fileidcheck.FieldError)
+	}
+	if m.td_version != nil {
+		fields = append(fields, fileidcheck.FieldTdVersion)
+	}
+	if m.created_at != nil {
+		fields = append(fields, fileidcheck.FieldCreatedAt)
 	}
 	return fields
 }
@@ -256,10 +573,24 @@ func (m *LastChannelMessageMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *LastChannelMessageMutation) Field(name string) (ent.Value, bool) {
+func (m *FileIDCheckMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case lastchannelmessage.FieldMessageID:
-		return m.MessageID()
+	case fileidcheck.FieldType:
+		return m.GetType()
+	case fileidcheck.FieldDc:
+		return m.Dc()
+	case fileidcheck.FieldFileID:
+		return m.FileID()
+	case fileidcheck.FieldBotAPIFileID:
+		return m.BotAPIFileID()
+	case fileidcheck.FieldResult:
+		return m.Result()
+	case fileidcheck.FieldError:
+		return m.Error()
+	case fileidcheck.FieldTdVersion:
+		return m.TdVersion()
+	case fileidcheck.FieldCreatedAt:
+		return m.CreatedAt()
 	}
 	return nil, false
 }
@@ -267,36 +598,99 @@ func (m *LastChannelMessageMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *LastChannelMessageMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *FileIDCheckMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case lastchannelmessage.FieldMessageID:
-		return m.OldMessageID(ctx)
-	}
-	return nil, fmt.Errorf("unknown LastChannelMessage field %s", name)
+	case fileidcheck.FieldType:
+		return m.OldType(ctx)
+	case fileidcheck.FieldDc:
+		return m.OldDc(ctx)
+	case fileidcheck.FieldFileID:
+		return m.OldFileID(ctx)
+	case fileidcheck.FieldBotAPIFileID:
+		return m.OldBotAPIFileID(ctx)
+	case fileidcheck.FieldResult:
+		return m.OldResult(ctx)
+	case fileidcheck.FieldError:
+		return m.OldError(ctx)
+	case fileidcheck.FieldTdVersion:
+		return m.OldTdVersion(ctx)
+	case fileidcheck.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown FileIDCheck field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *LastChannelMessageMutation) SetField(name string, value ent.Value) error {
+func (m *FileIDCheckMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case lastchannelmessage.FieldMessageID:
+	case fileidcheck.FieldType:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetType(v)
+		return nil
+	case fileidcheck.FieldDc:
 		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetMessageID(v)
+		m.SetDc(v)
+		return nil
+	case fileidcheck.FieldFileID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFileID(v)
+		return nil
+	case fileidcheck.FieldBotAPIFileID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetBotAPIFileID(v)
+		return nil
+	case fileidcheck.FieldResult:
+		v, ok := value.(fileidcheck.Result)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetResult(v)
+		return nil
+	case fileidcheck.FieldError:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetError(v)
+		return nil
+	case fileidcheck.FieldTdVersion:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTdVersion(v)
+		return nil
+	case fileidcheck.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
 		return nil
 	}
-	return fmt.Errorf("unknown LastChannelMessage field %s", name)
+	return fmt.Errorf("unknown FileIDCheck field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *LastChannelMessageMutation) AddedFields() []string {
+func (m *FileIDCheckMutation) AddedFields() []string {
 	var fields []string
-	if m.addmessage_id != nil {
-		fields = append(fields, lastchannelmessage.FieldMessageID)
+	if m.adddc != nil {
+		fields = append(fields, fileidcheck.FieldDc)
 	}
 	return fields
 }
@@ -304,10 +698,10 @@ func (m *LastChannelMessageMutation) AddedFields() []string {
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *LastChannelMessageMutation) AddedField(name string) (ent.Value, bool) {
+func (m *FileIDCheckMutation) AddedField(name string) (ent.Value, bool) {
 	switch name {
-	case lastchannelmessage.FieldMessageID:
-		return m.AddedMessageID()
+	case fileidcheck.FieldDc:
+		return m.AddedDc()
 	}
 	return nil, false
 }
@@ -315,129 +709,164 @@ func (m *LastChannelMessageMutation) AddedField(name string) (ent.Value, bool) {
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *LastChannelMessageMutation) AddField(name string, value ent.Value) error {
+func (m *FileIDCheckMutation) AddField(name string, value ent.Value) error {
 	switch name {
-	case lastchannelmessage.FieldMessageID:
+	case fileidcheck.FieldDc:
 		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.AddMessageID(v)
+		m.AddDc(v)
 		return nil
 	}
-	return fmt.Errorf("unknown LastChannelMessage numeric field %s", name)
+	return fmt.Errorf("unknown FileIDCheck numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *LastChannelMessageMutation) ClearedFields() []string {
-	return nil
+func (m *FileIDCheckMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(fileidcheck.FieldFileID) {
+		fields = append(fields, fileidcheck.FieldFileID)
+	}
+	if m.FieldCleared(fileidcheck.FieldBotAPIFileID) {
+		fields = append(fields, fileidcheck.FieldBotAPIFileID)
+	}
+	if m.FieldCleared(fileidcheck.FieldError) {
+		fields = append(fields, fileidcheck.FieldError)
+	}
+	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *LastChannelMessageMutation) FieldCleared(name string) bool {
+func (m *FileIDCheckMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *LastChannelMessageMutation) ClearField(name string) error {
-	return fmt.Errorf("unknown LastChannelMessage nullable field %s", name)
+func (m *FileIDCheckMutation) ClearField(name string) error {
+	switch name {
+	case fileidcheck.FieldFileID:
+		m.ClearFileID()
+		return nil
+	case fileidcheck.FieldBotAPIFileID:
+		m.ClearBotAPIFileID()
+		return nil
+	case fileidcheck.FieldError:
+		m.ClearError()
+		return nil
+	}
+	return fmt.Errorf("unknown FileIDCheck nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *LastChannelMessageMutation) ResetField(name string) error {
+func (m *FileIDCheckMutation) ResetField(name string) error {
 	switch name {
-	case lastchannelmessage.FieldMessageID:
-		m.ResetMessageID()
+	case fileidcheck.FieldType:
+		m.ResetType()
+		return nil
+	case fileidcheck.FieldDc:
+		m.ResetDc()
+		return nil
+	case fileidcheck.FieldFileID:
+		m.ResetFileID()
+		return nil
+	case fileidcheck.FieldBotAPIFileID:
+		m.ResetBotAPIFileID()
+		return nil
+	case fileidcheck.FieldResult:
+		m.ResetResult()
+		return nil
+	case fileidcheck.FieldError:
+		m.ResetError()
+		return nil
+	case fileidcheck.FieldTdVersion:
+		m.ResetTdVersion()
+		return nil
+	case fileidcheck.FieldCreatedAt:
+		m.ResetCreatedAt()
 		return nil
 	}
-	return fmt.Errorf("unknown LastChannelMessage field %s", name)
+	return fmt.Errorf("unknown FileIDCheck field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *LastChannelMessageMutation) AddedEdges() []string {
+func (m *FileIDCheckMutation) AddedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *LastChannelMessageMutation) AddedIDs(name string) []ent.Value {
+func (m *FileIDCheckMutation) AddedIDs(name string) []ent.Value {
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *LastChannelMessageMutation) RemovedEdges() []string {
+func (m *FileIDCheckMutation) RemovedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *LastChannelMessageMutation) RemovedIDs(name string) []ent.Value {
+func (m *FileIDCheckMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *LastChannelMessageMutation) ClearedEdges() []string {
+func (m *FileIDCheckMutation) ClearedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *LastChannelMessageMutation) EdgeCleared(name string) bool {
+func (m *FileIDCheckMutation) EdgeCleared(name string) bool {
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *LastChannelMessageMutation) ClearEdge(name string) error {
-	return fmt.Errorf("unknown LastChannelMessage unique edge %s", name)
+func (m *FileIDCheckMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown FileIDCheck unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *LastChannelMessageMutation) ResetEdge(name string) error {
-	return fmt.Errorf("unknown LastChannelMessage edge %s", name)
+func (m *FileIDCheckMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown FileIDCheck edge %s", name)
 }
 
-// PRNotificationMutation represents an operation that mutates the PRNotification nodes in the graph.
-type PRNotificationMutation struct {
+// LastChannelMessageMutation represents an operation that mutates the LastChannelMessage nodes in the graph.
+type LastChannelMessageMutation struct {
 	config
-	op                        Op
-	typ                       string
-	id                        *int
-	repo_id                   *int64
-	addrepo_id                *int64
-	pull_request_id           *int
-	addpull_request_id        *int
-	pull_request_title        *string
-	pull_request_body         *string
-	pull_request_author_login *string
-	message_id                *int
-	addmessage_id             *int
-	clearedFields             map[string]struct{}
-	done                      bool
-	oldValue                  func(context.Context) (*PRNotification, error)
-	predicates                []predicate.PRNotification
+	op            Op
+	typ           string
+	id            *int64
+	message_id    *int
+	addmessage_id *int
+	clearedFields map[string]struct{}
+	done          bool
+	oldValue      func(context.Context) (*LastChannelMessage, error)
+	predicates    []predicate.LastChannelMessage
 }
 
-var _ ent.Mutation = (*PRNotificationMutation)(nil)
+var _ ent.Mutation = (*LastChannelMessageMutation)(nil)
 
-// prnotificationOption allows management of the mutation configuration using functional options.
-type prnotificationOption func(*PRNotificationMutation)
+// lastchannelmessageOption allows management of the mutation configuration using functional options.
+type lastchannelmessageOption func(*LastChannelMessageMutation)
 
-// newPRNotificationMutation creates new mutation for the PRNotification entity.
-func newPRNotificationMutation(c config, op Op, opts ...prnotificationOption) *PRNotificationMutation {
-	m := &PRNotificationMutation{
+// newLastChannelMessageMutation creates new mutation for the LastChannelMessage entity.
+func newLastChannelMessageMutation(c config, op Op, opts ...lastchannelmessageOption) *LastChannelMessageMutation {
+	m := &LastChannelMessageMutation{
 		config:        c,
 		op:            op,
-		typ:           TypePRNotification,
+		typ:           TypeLastChannelMessage,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -446,20 +875,20 @@ func newPRNotificationMutation(c config, op Op, opts ...prnotificationOption) *P
 	return m
 }
 
-// withPRNotificationID sets the ID field of the mutation.
-func withPRNotificationID(id int) prnotificationOption {
-	return func(m *PRNotificationMutation) {
+// withLastChannelMessageID sets the ID field of the mutation.
+func withLastChannelMessageID(id int64) lastchannelmessageOption {
+	return func(m *LastChannelMessageMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *PRNotification
+			value *LastChannelMessage
 		)
-		m.oldValue = func(ctx context.Context) (*PRNotification, error) {
+		m.oldValue = func(ctx context.Context) (*LastChannelMessage, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().PRNotification.Get(ctx, id)
+					value, err = m.Client().LastChannelMessage.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -468,10 +897,10 @@ func withPRNotificationID(id int) prnotificationOption {
 	}
 }
 
-// withPRNotification sets the old PRNotification of the mutation.
-func withPRNotification(node *PRNotification) prnotificationOption {
-	return func(m *PRNotificationMutation) {
-		m.oldValue = func(context.Context) (*PRNotification, error) {
+// withLastChannelMessage sets the old LastChannelMessage of the mutation.
+func withLastChannelMessage(node *LastChannelMessage) lastchannelmessageOption {
+	return func(m *LastChannelMessageMutation) {
+		m.oldValue = func(context.Context) (*LastChannelMessage, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -480,7 +909,7 @@ func withPRNotification(node *PRNotification) prnotificationOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m PRNotificationMutation) Client() *Client {
+func (m LastChannelMessageMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -488,7 +917,7 @@ func (m PRNotificationMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m PRNotificationMutation) Tx() (*Tx, error) {
+func (m LastChannelMessageMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -497,9 +926,15 @@ func (m PRNotificationMutation) Tx() (*Tx, error) {
 	return tx, nil
 }
 
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of LastChannelMessage entities.
+func (m *LastChannelMessageMutation) SetID(id int64) {
+	m.id = &id
+}
+
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *PRNotificationMutation) ID() (id int, exists bool) {
+func (m *LastChannelMessageMutation) ID() (id int64, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -510,306 +945,1693 @@ func (m *PRNotificationMutation) ID() (id int, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *PRNotificationMutation) IDs(ctx context.Context) ([]int, error) {
+func (m *LastChannelMessageMutation) IDs(ctx context.Context) ([]int64, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
 		if exists {
-			return []int{id}, nil
+			return []int64{id}, nil
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().PRNotification.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().LastChannelMessage.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetRepoID sets the "repo_id" field.
-func (m *PRNotificationMutation) SetRepoID(i int64) {
-	m.repo_id = &i
-	m.addrepo_id = nil
+// SetMessageID sets the "message_id" field.
+func (m *LastChannelMessageMutation) SetMessageID(i int) {
+	m.message_id = &i
+	m.addmessage_id = nil
 }
 
-// RepoID returns the value of the "repo_id" field in the mutation.
-func (m *PRNotificationMutation) RepoID() (r int64, exists bool) {
-	v := m.repo_id
+// MessageID returns the value of the "message_id" field in the mutation.
+func (m *LastChannelMessageMutation) MessageID() (r int, exists bool) {
+	v := m.message_id
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldRepoID returns the old "repo_id" field's value of the PRNotification entity.
-// If the PRNotification object wasn't provided to the builder, the object is fetched from the database.
+// OldMessageID returns the old "message_id" field's value of the LastChannelMessage entity.
+// If the LastChannelMessage object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PRNotificationMutation) OldRepoID(ctx context.Context) (v int64, err error) {
+func (m *LastChannelMessageMutation) OldMessageID(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldRepoID is only allowed on UpdateOne operations")
+		return v, errors.New("OldMessageID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldRepoID requires an ID field in the mutation")
+		return v, errors.New("OldMessageID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldRepoID: %w", err)
+		return v, fmt.Errorf("querying old value for OldMessageID: %w", err)
 	}
-	return oldValue.RepoID, nil
+	return oldValue.MessageID, nil
 }
 
-// AddRepoID adds i to the "repo_id" field.
-func (m *PRNotificationMutation) AddRepoID(i int64) {
-	if m.addrepo_id != nil {
-		*m.addrepo_id += i
+// AddMessageID adds i to the "message_id" field.
+func (m *LastChannelMessageMutation) AddMessageID(i int) {
+	if m.addmessage_id != nil {
+		*m.addmessage_id += i
 	} else {
-		m.addrepo_id = &i
+		m.addmessage_id = &i
 	}
 }
 
-// AddedRepoID returns the value that was added to the "repo_id" field in this mutation.
-func (m *PRNotificationMutation) AddedRepoID() (r int64, exists bool) {
-	v := m.addrepo_id
+// AddedMessageID returns the value that was added to the "message_id" field in this mutation.
+func (m *LastChannelMessageMutation) AddedMessageID() (r int, exists bool) {
+	v := m.addmessage_id
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// ResetRepoID resets all changes to the "repo_id" field.
-func (m *PRNotificationMutation) ResetRepoID() {
-	m.repo_id = nil
-	m.addrepo_id = nil
+// ResetMessageID resets all changes to the "message_id" field.
+func (m *LastChannelMessageMutation) ResetMessageID() {
+	m.message_id = nil
+	m.addmessage_id = nil
 }
 
-// SetPullRequestID sets the "pull_request_id" field.
-func (m *PRNotificationMutation) SetPullRequestID(i int) {
-	m.pull_request_id = &i
-	m.addpull_request_id = nil
+// Where appends a list predicates to the LastChannelMessageMutation builder.
+func (m *LastChannelMessageMutation) Where(ps ...predicate.LastChannelMessage) {
+	m.predicates = append(m.predicates, ps...)
 }
 
-// PullRequestID returns the value of the "pull_request_id" field in the mutation.
-func (m *PRNotificationMutation) PullRequestID() (r int, exists bool) {
-	v := m.pull_request_id
-	if v == nil {
-		return
+// WhereP appends storage-level predicates to the LastChannelMessageMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *LastChannelMessageMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.LastChannelMessage, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
 	}
-	return *v, true
+	m.Where(p...)
 }
 
-// OldPullRequestID returns the old "pull_request_id" field's value of the PRNotification entity.
-// If the PRNotification object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PRNotificationMutation) OldPullRequestID(ctx context.Context) (v int, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldPullRequestID is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldPullRequestID requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldPullRequestID: %w", err)
-	}
-	return oldValue.PullRequestID, nil
+// Op returns the operation name.
+func (m *LastChannelMessageMutation) Op() Op {
+	return m.op
 }
 
-// AddPullRequestID adds i to the "pull_request_id" field.
-func (m *PRNotificationMutation) AddPullRequestID(i int) {
-	if m.addpull_request_id != nil {
+// SetOp allows setting the mutation operation.
+func (m *LastChannelMessageMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (LastChannelMessage).
+func (m *LastChannelMessageMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *LastChannelMessageMutation) Fields() []string {
+	fields := make([]string, 0, 1)
+	if m.message_id != nil {
+		fields = append(fields, lastchannelmessage.FieldMessageID)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *LastChannelMessageMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case lastchannelmessage.FieldMessageID:
+		return m.MessageID()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *LastChannelMessageMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case lastchannelmessage.FieldMessageID:
+		return m.OldMessageID(ctx)
+	}
+	return nil, fmt.Errorf("unknown LastChannelMessage field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *LastChannelMessageMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case lastchannelmessage.FieldMessageID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMessageID(v)
+		return nil
+	}
+	return fmt.Errorf("unknown LastChannelMessage field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *LastChannelMessageMutation) AddedFields() []string {
+	var fields []string
+	if m.addmessage_id != nil {
+		fields = append(fields, lastchannelmessage.FieldMessageID)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *LastChannelMessageMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case lastchannelmessage.FieldMessageID:
+		return m.AddedMessageID()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *LastChannelMessageMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case lastchannelmessage.FieldMessageID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddMessageID(v)
+		return nil
+	}
+	return fmt.Errorf("unknown LastChannelMessage numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *LastChannelMessageMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *LastChannelMessageMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *LastChannelMessageMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown LastChannelMessage nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *LastChannelMessageMutation) ResetField(name string) error {
+	switch name {
+	case lastchannelmessage.FieldMessageID:
+		m.ResetMessageID()
+		return nil
+	}
+	return fmt.Errorf("unknown LastChannelMessage field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *LastChannelMessageMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *LastChannelMessageMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *LastChannelMessageMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *LastChannelMessageMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *LastChannelMessageMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *LastChannelMessageMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *LastChannelMessageMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown LastChannelMessage unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *LastChannelMessageMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown LastChannelMessage edge %s", name)
+}
+
+// PRNotificationMutation represents an operation that mutates the PRNotification nodes in the graph.
+type PRNotificationMutation struct {
+	config
+	op                        Op
+	typ                       string
+	id                        *int
+	repo_id                   *int64
+	addrepo_id                *int64
+	pull_request_id           *int
+	addpull_request_id        *int
+	pull_request_title        *string
+	pull_request_body         *string
+	pull_request_author_login *string
+	message_id                *int
+	addmessage_id             *int
+	clearedFields             map[string]struct{}
+	done                      bool
+	oldValue                  func(context.Context) (*PRNotification, error)
+	predicates                []predicate.PRNotification
+}
+
+var _ ent.Mutation = (*PRNotificationMutation)(nil)
+
+// prnotificationOption allows management of the mutation configuration using functional options.
+type prnotificationOption func(*PRNotificationMutation)
+
+// newPRNotificationMutation creates new mutation for the PRNotification entity.
+func newPRNotificationMutation(c config, op Op, opts ...prnotificationOption) *PRNotificationMutation {
+	m := &PRNotificationMutation{
+		config:        c,
+		op:            op,
+		typ:           TypePRNotification,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withPRNotificationID sets the ID field of the mutation.
+func withPRNotificationID(id int) prnotificationOption {
+	return func(m *PRNotificationMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *PRNotification
+		)
+		m.oldValue = func(ctx context.Context) (*PRNotification, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().PRNotification.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withPRNotification sets the old PRNotification of the mutation.
+func withPRNotification(node *PRNotification) prnotificationOption {
+	return func(m *PRNotificationMutation) {
+		m.oldValue = func(context.Context) (*PRNotification, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m PRNotificationMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m PRNotificationMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *PRNotificationMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *PRNotificationMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().PRNotification.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetRepoID sets the "repo_id" field.
+func (m *PRNotificationMutation) SetRepoID(i int64) {
+	m.repo_id = &i
+	m.addrepo_id = nil
+}
+
+// RepoID returns the value of the "repo_id" field in the mutation.
+func (m *PRNotificationMutation) RepoID() (r int64, exists bool) {
+	v := m.repo_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRepoID returns the old "repo_id" field's value of the PRNotification entity.
+// If the PRNotification object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PRNotificationMutation) OldRepoID(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRepoID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRepoID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRepoID: %w", err)
+	}
+	return oldValue.RepoID, nil
+}
+
+// AddRepoID adds i to the "repo_id" field.
+func (m *PRNotificationMutation) AddRepoID(i int64) {
+	if m.addrepo_id != nil {
+		*m.addrepo_id += i
+	} else {
+		m.addrepo_id = &i
+	}
+}
+
+// AddedRepoID returns the value that was added to the "repo_id" field in this mutation.
+func (m *PRNotificationMutation) AddedRepoID() (r int64, exists bool) {
+	v := m.addrepo_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetRepoID resets all changes to the "repo_id" field.
+func (m *PRNotificationMutation) ResetRepoID() {
+	m.repo_id = nil
+	m.addrepo_id = nil
+}
+
+// SetPullRequestID sets the "pull_request_id" field.
+func (m *PRNotificationMutation) SetPullRequestID(i int) {
+	m.pull_request_id = &i
+	m.addpull_request_id = nil
+}
+
+// PullRequestID returns the value of the "pull_request_id" field in the mutation.
+func (m *PRNotificationMutation) PullRequestID() (r int, exists bool) {
+	v := m.pull_request_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPullRequestID returns the old "pull_request_id" field's value of the PRNotification entity.
+// If the PRNotification object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PRNotificationMutation) OldPullRequestID(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPullRequestID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPullRequestID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPullRequestID: %w", err)
+	}
+	return oldValue.PullRequestID, nil
+}
+
+// AddPullRequestID adds i to the "pull_request_id" field.
+func (m *PRNotificationMutation) AddPullRequestID(i int) {
+	if m.addpull_request_id != nil {
 		*m.addpull_request_id += i
 	} else {
-		m.addpull_request_id = &i
+		m.addpull_request_id = &i
+	}
+}
+
+// AddedPullRequestID returns the value that was added to the "pull_request_id" field in this mutation.
+func (m *PRNotificationMutation) AddedPullRequestID() (r int, exists bool) {
+	v := m.addpull_request_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetPullRequestID resets all changes to the "pull_request_id" field.
+func (m *PRNotificationMutation) ResetPullRequestID() {
+	m.pull_request_id = nil
+	m.addpull_request_id = nil
+}
+
+// SetPullRequestTitle sets the "pull_request_title" field.
+func (m *PRNotificationMutation) SetPullRequestTitle(s string) {
+	m.pull_request_title = &s
+}
+
+// PullRequestTitle returns the value of the "pull_request_title" field in the mutation.
+func (m *PRNotificationMutation) PullRequestTitle() (r string, exists bool) {
+	v := m.pull_request_title
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPullRequestTitle returns the old "pull_request_title" field's value of the PRNotification entity.
+// If the PRNotification object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PRNotificationMutation) OldPullRequestTitle(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPullRequestTitle is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPullRequestTitle requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPullRequestTitle: %w", err)
+	}
+	return oldValue.PullRequestTitle, nil
+}
+
+// ResetPullRequestTitle resets all changes to the "pull_request_title" field.
+func (m *PRNotificationMutation) ResetPullRequestTitle() {
+	m.pull_request_title = nil
+}
+
+// SetPullRequestBody sets the "pull_request_body" field.
+func (m *PRNotificationMutation) SetPullRequestBody(s string) {
+	m.pull_request_body = &s
+}
+
+// PullRequestBody returns the value of the "pull_request_body" field in the mutation.
+func (m *PRNotificationMutation) PullRequestBody() (r string, exists bool) {
+	v := m.pull_request_body
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPullRequestBody returns the old "pull_request_body" field's value of the PRNotification entity.
+// If the PRNotification object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PRNotificationMutation) OldPullRequestBody(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPullRequestBody is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPullRequestBody requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPullRequestBody: %w", err)
+	}
+	return oldValue.PullRequestBody, nil
+}
+
+// ResetPullRequestBody resets all changes to the "pull_request_body" field.
+func (m *PRNotificationMutation) ResetPullRequestBody() {
+	m.pull_request_body = nil
+}
+
+// SetPullRequestAuthorLogin sets the "pull_request_author_login" field.
+func (m *PRNotificationMutation) SetPullRequestAuthorLogin(s string) {
+	m.pull_request_author_login = &s
+}
+
+// PullRequestAuthorLogin returns the value of the "pull_request_author_login" field in the mutation.
+func (m *PRNotificationMutation) PullRequestAuthorLogin() (r string, exists bool) {
+	v := m.pull_request_author_login
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPullRequestAuthorLogin returns the old "pull_request_author_login" field's value of the PRNotification entity.
+// If the PRNotification object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PRNotificationMutation) OldPullRequestAuthorLogin(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPullRequestAuthorLogin is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPullRequestAuthorLogin requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPullRequestAuthorLogin: %w", err)
+	}
+	return oldValue.PullRequestAuthorLogin, nil
+}
+
+// ResetPullRequestAuthorLogin resets all changes to the "pull_request_author_login" field.
+func (m *PRNotificationMutation) ResetPullRequestAuthorLogin() {
+	m.pull_request_author_login = nil
+}
+
+// SetMessageID sets the "message_id" field.
+func (m *PRNotificationMutation) SetMessageID(i int) {
+	m.message_id = &i
+	m.addmessage_id = nil
+}
+
+// MessageID returns the value of the "message_id" field in the mutation.
+func (m *PRNotificationMutation) MessageID() (r int, exists bool) {
+	v := m.message_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMessageID returns the old "message_id" field's value of the PRNotification entity.
+// If the PRNotification object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PRNotificationMutation) OldMessageID(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMessageID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMessageID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMessageID: %w", err)
+	}
+	return oldValue.MessageID, nil
+}
+
+// AddMessageID adds i to the "message_id" field.
+func (m *PRNotificationMutation) AddMessageID(i int) {
+	if m.addmessage_id != nil {
+		*m.addmessage_id += i
+	} else {
+		m.addmessage_id = &i
+	}
+}
+
+// AddedMessageID returns the value that was added to the "message_id" field in this mutation.
+func (m *PRNotificationMutation) AddedMessageID() (r int, exists bool) {
+	v := m.addmessage_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetMessageID resets all changes to the "message_id" field.
+func (m *PRNotificationMutation) ResetMessageID() {
+	m.message_id = nil
+	m.addmessage_id = nil
+}
+
+// Where appends a list predicates to the PRNotificationMutation builder.
+func (m *PRNotificationMutation) Where(ps ...predicate.PRNotification) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the PRNotificationMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *PRNotificationMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.PRNotification, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *PRNotificationMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *PRNotificationMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (PRNotification).
+func (m *PRNotificationMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *PRNotificationMutation) Fields() []string {
+	fields := make([]string, 0, 6)
+	if m.repo_id != nil {
+		fields = append(fields, prnotification.FieldRepoID)
+	}
+	if m.pull_request_id != nil {
+		fields = append(fields, prnotification.FieldPullRequestID)
+	}
+	if m.pull_request_title != nil {
+		fields = append(fields, prnotification.FieldPullRequestTitle)
+	}
+	if m.pull_request_body != nil {
+		fields = append(fields, prnotification.FieldPullRequestBody)
+	}
+	if m.pull_request_author_login != nil {
+		fields = append(fields, prnotification.FieldPullRequestAuthorLogin)
+	}
+	if m.message_id != nil {
+		fields = append(fields, prnotification.FieldMessageID)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *PRNotificationMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case prnotification.FieldRepoID:
+		return m.RepoID()
+	case prnotification.FieldPullRequestID:
+		return m.PullRequestID()
+	case prnotification.FieldPullRequestTitle:
+		return m.PullRequestTitle()
+	case prnotification.FieldPullRequestBody:
+		return m.PullRequestBody()
+	case prnotification.FieldPullRequestAuthorLogin:
+		return m.PullRequestAuthorLogin()
+	case prnotification.FieldMessageID:
+		return m.MessageID()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *PRNotificationMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case prnotification.FieldRepoID:
+		return m.OldRepoID(ctx)
+	case prnotification.FieldPullRequestID:
+		return m.OldPullRequestID(ctx)
+	case prnotification.FieldPullRequestTitle:
+		return m.OldPullRequestTitle(ctx)
+	case prnotification.FieldPullRequestBody:
+		return m.OldPullRequestBody(ctx)
+	case prnotification.FieldPullRequestAuthorLogin:
+		return m.OldPullRequestAuthorLogin(ctx)
+	case prnotification.FieldMessageID:
+		return m.OldMessageID(ctx)
+	}
+	return nil, fmt.Errorf("unknown PRNotification field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *PRNotificationMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case prnotification.FieldRepoID:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRepoID(v)
+		return nil
+	case prnotification.FieldPullRequestID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPullRequestID(v)
+		return nil
+	case prnotification.FieldPullRequestTitle:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPullRequestTitle(v)
+		return nil
+	case prnotification.FieldPullRequestBody:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPullRequestBody(v)
+		return nil
+	case prnotification.FieldPullRequestAuthorLogin:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPullRequestAuthorLogin(v)
+		return nil
+	case prnotification.FieldMessageID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMessageID(v)
+		return nil
+	}
+	return fmt.Errorf("unknown PRNotification field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *PRNotificationMutation) AddedFields() []string {
+	var fields []string
+	if m.addrepo_id != nil {
+		fields = append(fields, prnotification.FieldRepoID)
+	}
+	if m.addpull_request_id != nil {
+		fields = append(fields, prnotification.FieldPullRequestID)
+	}
+	if m.addmessage_id != nil {
+		fields = append(fields, prnotification.FieldMessageID)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *PRNotificationMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case prnotification.FieldRepoID:
+		return m.AddedRepoID()
+	case prnotification.FieldPullRequestID:
+		return m.AddedPullRequestID()
+	case prnotification.FieldMessageID:
+		return m.AddedMessageID()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *PRNotificationMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case prnotification.FieldRepoID:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddRepoID(v)
+		return nil
+	case prnotification.FieldPullRequestID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddPullRequestID(v)
+		return nil
+	case prnotification.FieldMessageID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddMessageID(v)
+		return nil
+	}
+	return fmt.Errorf("unknown PRNotification numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *PRNotificationMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *PRNotificationMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *PRNotificationMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown PRNotification nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *PRNotificationMutation) ResetField(name string) error {
+	switch name {
+	case prnotification.FieldRepoID:
+		m.ResetRepoID()
+		return nil
+	case prnotification.FieldPullRequestID:
+		m.ResetPullRequestID()
+		return nil
+	case prnotification.FieldPullRequestTitle:
+		m.ResetPullRequestTitle()
+		return nil
+	case prnotification.FieldPullRequestBody:
+		m.ResetPullRequestBody()
+		return nil
+	case prnotification.FieldPullRequestAuthorLogin:
+		m.ResetPullRequestAuthorLogin()
+		return nil
+	case prnotification.FieldMessageID:
+		m.ResetMessageID()
+		return nil
+	}
+	return fmt.Errorf("unknown PRNotification field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *PRNotificationMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *PRNotificationMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *PRNotificationMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *PRNotificationMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *PRNotificationMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *PRNotificationMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *PRNotificationMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown PRNotification unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *PRNotificationMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown PRNotification edge %s", name)
+}
+
+// TelegramAccountMutation represents an operation that mutates the TelegramAccount nodes in the graph.
+type TelegramAccountMutation struct {
+	config
+	op                      Op
+	typ                     string
+	id                      *string
+	code                    *string
+	code_at                 *time.Time
+	state                   *telegramaccount.State
+	status                  *string
+	session_data            *[]byte
+	password                *[]byte
+	disabled                *bool
+	dc_list                 *telegramaccount.DcList
+	dc                      *int
+	adddc                   *int
+	app_id                  *int
+	addapp_id               *int
+	app_hash                *string
+	clearedFields           map[string]struct{}
+	service_messages        map[int]struct{}
+	removedservice_messages map[int]struct{}
+	clearedservice_messages bool
+	done                    bool
+	oldValue                func(context.Context) (*TelegramAccount, error)
+	predicates              []predicate.TelegramAccount
+}
+
+var _ ent.Mutation = (*TelegramAccountMutation)(nil)
+
+// telegramaccountOption allows management of the mutation configuration using functional options.
+type telegramaccountOption func(*TelegramAccountMutation)
+
+// newTelegramAccountMutation creates new mutation for the TelegramAccount entity.
+func newTelegramAccountMutation(c config, op Op, opts ...telegramaccountOption) *TelegramAccountMutation {
+	m := &TelegramAccountMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeTelegramAccount,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withTelegramAccountID sets the ID field of the mutation.
+func withTelegramAccountID(id string) telegramaccountOption {
+	return func(m *TelegramAccountMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *TelegramAccount
+		)
+		m.oldValue = func(ctx context.Context) (*TelegramAccount, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().TelegramAccount.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withTelegramAccount sets the old TelegramAccount of the mutation.
+func withTelegramAccount(node *TelegramAccount) telegramaccountOption {
+	return func(m *TelegramAccountMutation) {
+		m.oldValue = func(context.Context) (*TelegramAccount, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m TelegramAccountMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m TelegramAccountMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of TelegramAccount entities.
+func (m *TelegramAccountMutation) SetID(id string) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *TelegramAccountMutation) ID() (id string, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *TelegramAccountMutation) IDs(ctx context.Context) ([]string, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []string{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().TelegramAccount.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetCode sets the "code" field.
+func (m *TelegramAccountMutation) SetCode(s string) {
+	m.code = &s
+}
+
+// Code returns the value of the "code" field in the mutation.
+func (m *TelegramAccountMutation) Code() (r string, exists bool) {
+	v := m.code
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCode returns the old "code" field's value of the TelegramAccount entity.
+// If the TelegramAccount object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TelegramAccountMutation) OldCode(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCode is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCode requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCode: %w", err)
+	}
+	return oldValue.Code, nil
+}
+
+// ClearCode clears the value of the "code" field.
+func (m *TelegramAccountMutation) ClearCode() {
+	m.code = nil
+	m.clearedFields[telegramaccount.FieldCode] = struct{}{}
+}
+
+// CodeCleared returns if the "code" field was cleared in this mutation.
+func (m *TelegramAccountMutation) CodeCleared() bool {
+	_, ok := m.clearedFields[telegramaccount.FieldCode]
+	return ok
+}
+
+// ResetCode resets all changes to the "code" field.
+func (m *TelegramAccountMutation) ResetCode() {
+	m.code = nil
+	delete(m.clearedFields, telegramaccount.FieldCode)
+}
+
+// SetCodeAt sets the "code_at" field.
+func (m *TelegramAccountMutation) SetCodeAt(t time.Time) {
+	m.code_at = &t
+}
+
+// CodeAt returns the value of the "code_at" field in the mutation.
+func (m *TelegramAccountMutation) CodeAt() (r time.Time, exists bool) {
+	v := m.code_at
+	if v == nil {
+		return
 	}
+	return *v, true
 }
 
-// AddedPullRequestID returns the value that was added to the "pull_request_id" field in this mutation.
-func (m *PRNotificationMutation) AddedPullRequestID() (r int, exists bool) {
-	v := m.addpull_request_id
+// OldCodeAt returns the old "code_at" field's value of the TelegramAccount entity.
+// If the TelegramAccount object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TelegramAccountMutation) OldCodeAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCodeAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCodeAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCodeAt: %w", err)
+	}
+	return oldValue.CodeAt, nil
+}
+
+// ClearCodeAt clears the value of the "code_at" field.
+func (m *TelegramAccountMutation) ClearCodeAt() {
+	m.code_at = nil
+	m.clearedFields[telegramaccount.FieldCodeAt] = struct{}{}
+}
+
+// CodeAtCleared returns if the "code_at" field was cleared in this mutation.
+func (m *TelegramAccountMutation) CodeAtCleared() bool {
+	_, ok := m.clearedFields[telegramaccount.FieldCodeAt]
+	return ok
+}
+
+// ResetCodeAt resets all changes to the "code_at" field.
+func (m *TelegramAccountMutation) ResetCodeAt() {
+	m.code_at = nil
+	delete(m.clearedFields, telegramaccount.FieldCodeAt)
+}
+
+// SetState sets the "state" field.
+func (m *TelegramAccountMutation) SetState(t telegramaccount.State) {
+	m.state = &t
+}
+
+// State returns the value of the "state" field in the mutation.
+func (m *TelegramAccountMutation) State() (r telegramaccount.State, exists bool) {
+	v := m.state
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// ResetPullRequestID resets all changes to the "pull_request_id" field.
-func (m *PRNotificationMutation) ResetPullRequestID() {
-	m.pull_request_id = nil
-	m.addpull_request_id = nil
+// OldState returns the old "state" field's value of the TelegramAccount entity.
+// If the TelegramAccount object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TelegramAccountMutation) OldState(ctx context.Context) (v telegramaccount.State, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldState is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldState requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldState: %w", err)
+	}
+	return oldValue.State, nil
 }
 
-// SetPullRequestTitle sets the "pull_request_title" field.
-func (m *PRNotificationMutation) SetPullRequestTitle(s string) {
-	m.pull_request_title = &s
+// ResetState resets all changes to the "state" field.
+func (m *TelegramAccountMutation) ResetState() {
+	m.state = nil
 }
 
-// PullRequestTitle returns the value of the "pull_request_title" field in the mutation.
-func (m *PRNotificationMutation) PullRequestTitle() (r string, exists bool) {
-	v := m.pull_request_title
+// SetStatus sets the "status" field.
+func (m *TelegramAccountMutation) SetStatus(s string) {
+	m.status = &s
+}
+
+// Status returns the value of the "status" field in the mutation.
+func (m *TelegramAccountMutation) Status() (r string, exists bool) {
+	v := m.status
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldPullRequestTitle returns the old "pull_request_title" field's value of the PRNotification entity.
-// If the PRNotification object wasn't provided to the builder, the object is fetched from the database.
+// OldStatus returns the old "status" field's value of the TelegramAccount entity.
+// If the TelegramAccount object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PRNotificationMutation) OldPullRequestTitle(ctx context.Context) (v string, err error) {
+func (m *TelegramAccountMutation) OldStatus(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldPullRequestTitle is only allowed on UpdateOne operations")
+		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldPullRequestTitle requires an ID field in the mutation")
+		return v, errors.New("OldStatus requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldPullRequestTitle: %w", err)
+		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
 	}
-	return oldValue.PullRequestTitle, nil
+	return oldValue.Status, nil
 }
 
-// ResetPullRequestTitle resets all changes to the "pull_request_title" field.
-func (m *PRNotificationMutation) ResetPullRequestTitle() {
-	m.pull_request_title = nil
+// ResetStatus resets all changes to the "status" field.
+func (m *TelegramAccountMutation) ResetStatus() {
+	m.status = nil
 }
 
-// SetPullRequestBody sets the "pull_request_body" field.
-func (m *PRNotificationMutation) SetPullRequestBody(s string) {
-	m.pull_request_body = &s
+// SetSessionData sets the "session_data" field.
+func (m *TelegramAccountMutation) SetSessionData(b []byte) {
+	m.session_data = &b
 }
 
-// PullRequestBody returns the value of the "pull_request_body" field in the mutation.
-func (m *PRNotificationMutation) PullRequestBody() (r string, exists bool) {
-	v := m.pull_request_body
+// SessionData returns the value of the "session_data" field in the mutation.
+func (m *TelegramAccountMutation) SessionData() (r []byte, exists bool) {
+	v := m.session_data
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldPullRequestBody returns the old "pull_request_body" field's value of the PRNotification entity.
-// If the PRNotification object wasn't provided to the builder, the object is fetched from the database.
+// OldSessionData returns the old "session_data" field's value of the TelegramAccount entity.
+// If the TelegramAccount object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PRNotificationMutation) OldPullRequestBody(ctx context.Context) (v string, err error) {
+func (m *TelegramAccountMutation) OldSessionData(ctx context.Context) (v *[]byte, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldPullRequestBody is only allowed on UpdateOne operations")
+		return v, errors.New("OldSessionData is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldPullRequestBody requires an ID field in the mutation")
+		return v, errors.New("OldSessionData requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldPullRequestBody: %w", err)
+		return v, fmt.Errorf("querying old value for OldSessionData: %w", err)
 	}
-	return oldValue.PullRequestBody, nil
+	return oldValue.SessionData, nil
 }
 
-// ResetPullRequestBody resets all changes to the "pull_request_body" field.
-func (m *PRNotificationMutation) ResetPullRequestBody() {
-	m.pull_request_body = nil
+// ClearSessionData clears the value of the "session_data" field.
+func (m *TelegramAccountMutation) ClearSessionData() {
+	m.session_data = nil
+	m.clearedFields[telegramaccount.FieldSessionData] = struct{}{}
+}
+
+// SessionDataCleared returns if the "session_data" field was cleared in this mutation.
+func (m *TelegramAccountMutation) SessionDataCleared() bool {
+	_, ok := m.clearedFields[telegramaccount.FieldSessionData]
+	return ok
+}
+
+// ResetSessionData resets all changes to the "session_data" field.
+func (m *TelegramAccountMutation) ResetSessionData() {
+	m.session_data = nil
+	delete(m.clearedFields, telegramaccount.FieldSessionData)
+}
+
+// SetPassword sets the "password" field.
+func (m *TelegramAccountMutation) SetPassword(b []byte) {
+	m.password = &b
+}
+
+// Password returns the value of the "password" field in the mutation.
+func (m *TelegramAccountMutation) Password() (r []byte, exists bool) {
+	v := m.password
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPassword returns the old "password" field's value of the TelegramAccount entity.
+// If the TelegramAccount object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TelegramAccountMutation) OldPassword(ctx context.Context) (v *[]byte, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPassword is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPassword requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPassword: %w", err)
+	}
+	return oldValue.Password, nil
+}
+
+// ClearPassword clears the value of the "password" field.
+func (m *TelegramAccountMutation) ClearPassword() {
+	m.password = nil
+	m.clearedFields[telegramaccount.FieldPassword] = struct{}{}
+}
+
+// PasswordCleared returns if the "password" field was cleared in this mutation.
+func (m *TelegramAccountMutation) PasswordCleared() bool {
+	_, ok := m.clearedFields[telegramaccount.FieldPassword]
+	return ok
+}
+
+// ResetPassword resets all changes to the "password" field.
+func (m *TelegramAccountMutation) ResetPassword() {
+	m.password = nil
+	delete(m.clearedFields, telegramaccount.FieldPassword)
+}
+
+// SetDisabled sets the "disabled" field.
+func (m *TelegramAccountMutation) SetDisabled(b bool) {
+	m.disabled = &b
+}
+
+// Disabled returns the value of the "disabled" field in the mutation.
+func (m *TelegramAccountMutation) Disabled() (r bool, exists bool) {
+	v := m.disabled
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDisabled returns the old "disabled" field's value of the TelegramAccount entity.
+// If the TelegramAccount object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TelegramAccountMutation) OldDisabled(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDisabled is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDisabled requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDisabled: %w", err)
+	}
+	return oldValue.Disabled, nil
+}
+
+// ResetDisabled resets all changes to the "disabled" field.
+func (m *TelegramAccountMutation) ResetDisabled() {
+	m.disabled = nil
+}
+
+// SetDcList sets the "dc_list" field.
+func (m *TelegramAccountMutation) SetDcList(tl telegramaccount.DcList) {
+	m.dc_list = &tl
+}
+
+// DcList returns the value of the "dc_list" field in the mutation.
+func (m *TelegramAccountMutation) DcList() (r telegramaccount.DcList, exists bool) {
+	v := m.dc_list
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDcList returns the old "dc_list" field's value of the TelegramAccount entity.
+// If the TelegramAccount object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TelegramAccountMutation) OldDcList(ctx context.Context) (v telegramaccount.DcList, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDcList is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDcList requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDcList: %w", err)
+	}
+	return oldValue.DcList, nil
+}
+
+// ResetDcList resets all changes to the "dc_list" field.
+func (m *TelegramAccountMutation) ResetDcList() {
+	m.dc_list = nil
+}
+
+// SetDc sets the "dc" field.
+func (m *TelegramAccountMutation) SetDc(i int) {
+	m.dc = &i
+	m.adddc = nil
+}
+
+// Dc returns the value of the "dc" field in the mutation.
+func (m *TelegramAccountMutation) Dc() (r int, exists bool) {
+	v := m.dc
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDc returns the old "dc" field's value of the TelegramAccount entity.
+// If the TelegramAccount object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TelegramAccountMutation) OldDc(ctx context.Context) (v *int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDc is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDc requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDc: %w", err)
+	}
+	return oldValue.Dc, nil
+}
+
+// AddDc adds i to the "dc" field.
+func (m *TelegramAccountMutation) AddDc(i int) {
+	if m.adddc != nil {
+		*m.adddc += i
+	} else {
+		m.adddc = &i
+	}
+}
+
+// AddedDc returns the value that was added to the "dc" field in this mutation.
+func (m *TelegramAccountMutation) AddedDc() (r int, exists bool) {
+	v := m.adddc
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearDc clears the value of the "dc" field.
+func (m *TelegramAccountMutation) ClearDc() {
+	m.dc = nil
+	m.adddc = nil
+	m.clearedFields[telegramaccount.FieldDc] = struct{}{}
+}
+
+// DcCleared returns if the "dc" field was cleared in this mutation.
+func (m *TelegramAccountMutation) DcCleared() bool {
+	_, ok := m.clearedFields[telegramaccount.FieldDc]
+	return ok
 }
 
-// SetPullRequestAuthorLogin sets the "pull_request_author_login" field.
-func (m *PRNotificationMutation) SetPullRequestAuthorLogin(s string) {
-	m.pull_request_author_login = &s
+// ResetDc resets all changes to the "dc" field.
+func (m *TelegramAccountMutation) ResetDc() {
+	m.dc = nil
+	m.adddc = nil
+	delete(m.clearedFields, telegramaccount.FieldDc)
 }
 
-// PullRequestAuthorLogin returns the value of the "pull_request_author_login" field in the mutation.
-func (m *PRNotificationMutation) PullRequestAuthorLogin() (r string, exists bool) {
-	v := m.pull_request_author_login
+// SetAppID sets the "app_id" field.
+func (m *TelegramAccountMutation) SetAppID(i int) {
+	m.app_id = &i
+	m.addapp_id = nil
+}
+
+// AppID returns the value of the "app_id" field in the mutation.
+func (m *TelegramAccountMutation) AppID() (r int, exists bool) {
+	v := m.app_id
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldPullRequestAuthorLogin returns the old "pull_request_author_login" field's value of the PRNotification entity.
-// If the PRNotification object wasn't provided to the builder, the object is fetched from the database.
+// OldAppID returns the old "app_id" field's value of the TelegramAccount entity.
+// If the TelegramAccount object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PRNotificationMutation) OldPullRequestAuthorLogin(ctx context.Context) (v string, err error) {
+func (m *TelegramAccountMutation) OldAppID(ctx context.Context) (v *int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldPullRequestAuthorLogin is only allowed on UpdateOne operations")
+		return v, errors.New("OldAppID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldPullRequestAuthorLogin requires an ID field in the mutation")
+		return v, errors.New("OldAppID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldPullRequestAuthorLogin: %w", err)
+		return v, fmt.Errorf("querying old value for OldAppID: %w", err)
 	}
-	return oldValue.PullRequestAuthorLogin, nil
+	return oldValue.AppID, nil
 }
 
-// ResetPullRequestAuthorLogin resets all changes to the "pull_request_author_login" field.
-func (m *PRNotificationMutation) ResetPullRequestAuthorLogin() {
-	m.pull_request_author_login = nil
+// AddAppID adds i to the "app_id" field.
+func (m *TelegramAccountMutation) AddAppID(i int) {
+	if m.addapp_id != nil {
+		*m.addapp_id += i
+	} else {
+		m.addapp_id = &i
+	}
 }
 
-// SetMessageID sets the "message_id" field.
-func (m *PRNotificationMutation) SetMessageID(i int) {
-	m.message_id = &i
-	m.addmessage_id = nil
+// AddedAppID returns the value that was added to the "app_id" field in this mutation.
+func (m *TelegramAccountMutation) AddedAppID() (r int, exists bool) {
+	v := m.addapp_id
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// MessageID returns the value of the "message_id" field in the mutation.
-func (m *PRNotificationMutation) MessageID() (r int, exists bool) {
-	v := m.message_id
+// ClearAppID clears the value of the "app_id" field.
+func (m *TelegramAccountMutation) ClearAppID() {
+	m.app_id = nil
+	m.addapp_id = nil
+	m.clearedFields[telegramaccount.FieldAppID] = struct{}{}
+}
+
+// AppIDCleared returns if the "app_id" field was cleared in this mutation.
+func (m *TelegramAccountMutation) AppIDCleared() bool {
+	_, ok := m.clearedFields[telegramaccount.FieldAppID]
+	return ok
+}
+
+// ResetAppID resets all changes to the "app_id" field.
+func (m *TelegramAccountMutation) ResetAppID() {
+	m.app_id = nil
+	m.addapp_id = nil
+	delete(m.clearedFields, telegramaccount.FieldAppID)
+}
+
+// SetAppHash sets the "app_hash" field.
+func (m *TelegramAccountMutation) SetAppHash(s string) {
+	m.app_hash = &s
+}
+
+// AppHash returns the value of the "app_hash" field in the mutation.
+func (m *TelegramAccountMutation) AppHash() (r string, exists bool) {
+	v := m.app_hash
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldMessageID returns the old "message_id" field's value of the PRNotification entity.
-// If the PRNotification object wasn't provided to the builder, the object is fetched from the database.
+// OldAppHash returns the old "app_hash" field's value of the TelegramAccount entity.
+// If the TelegramAccount object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PRNotificationMutation) OldMessageID(ctx context.Context) (v int, err error) {
+func (m *TelegramAccountMutation) OldAppHash(ctx context.Context) (v *string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldMessageID is only allowed on UpdateOne operations")
+		return v, errors.New("OldAppHash is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldMessageID requires an ID field in the mutation")
+		return v, errors.New("OldAppHash requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldMessageID: %w", err)
+		return v, fmt.Errorf("querying old value for OldAppHash: %w", err)
 	}
-	return oldValue.MessageID, nil
+	return oldValue.AppHash, nil
 }
 
-// AddMessageID adds i to the "message_id" field.
-func (m *PRNotificationMutation) AddMessageID(i int) {
-	if m.addmessage_id != nil {
-		*m.addmessage_id += i
-	} else {
-		m.addmessage_id = &i
+// ClearAppHash clears the value of the "app_hash" field.
+func (m *TelegramAccountMutation) ClearAppHash() {
+	m.app_hash = nil
+	m.clearedFields[telegramaccount.FieldAppHash] = struct{}{}
+}
+
+// AppHashCleared returns if the "app_hash" field was cleared in this mutation.
+func (m *TelegramAccountMutation) AppHashCleared() bool {
+	_, ok := m.clearedFields[telegramaccount.FieldAppHash]
+	return ok
+}
+
+// ResetAppHash resets all changes to the "app_hash" field.
+func (m *TelegramAccountMutation) ResetAppHash() {
+	m.app_hash = nil
+	delete(m.clearedFields, telegramaccount.FieldAppHash)
+}
+
+// AddServiceMessageIDs adds the "service_messages" edge to the TelegramServiceMessage entity by ids.
+func (m *TelegramAccountMutation) AddServiceMessageIDs(ids ...int) {
+	if m.service_messages == nil {
+		m.service_messages = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.service_messages[ids[i]] = struct{}{}
 	}
 }
 
-// AddedMessageID returns the value that was added to the "message_id" field in this mutation.
-func (m *PRNotificationMutation) AddedMessageID() (r int, exists bool) {
-	v := m.addmessage_id
-	if v == nil {
-		return
+// ClearServiceMessages clears the "service_messages" edge to the TelegramServiceMessage entity.
+func (m *TelegramAccountMutation) ClearServiceMessages() {
+	m.clearedservice_messages = true
+}
+
+// ServiceMessagesCleared reports if the "service_messages" edge to the TelegramServiceMessage entity was cleared.
+func (m *TelegramAccountMutation) ServiceMessagesCleared() bool {
+	return m.clearedservice_messages
+}
+
+// RemoveServiceMessageIDs removes the "service_messages" edge to the TelegramServiceMessage entity by IDs.
+func (m *TelegramAccountMutation) RemoveServiceMessageIDs(ids ...int) {
+	if m.removedservice_messages == nil {
+		m.removedservice_messages = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.service_messages, ids[i])
+		m.removedservice_messages[ids[i]] = struct{}{}
 	}
-	return *v, true
 }
 
-// ResetMessageID resets all changes to the "message_id" field.
-func (m *PRNotificationMutation) ResetMessageID() {
-	m.message_id = nil
-	m.addmessage_id = nil
+// RemovedServiceMessages returns the removed IDs of the "service_messages" edge to the TelegramServiceMessage entity.
+func (m *TelegramAccountMutation) RemovedServiceMessagesIDs() (ids []int) {
+	for id := range m.removedservice_messages {
+		ids = append(ids, id)
+	}
+	return
 }
 
-// Where appends a list predicates to the PRNotificationMutation builder.
-func (m *PRNotificationMutation) Where(ps ...predicate.PRNotification) {
+// ServiceMessagesIDs returns the "service_messages" edge IDs in the mutation.
+func (m *TelegramAccountMutation) ServiceMessagesIDs() (ids []int) {
+	for id := range m.service_messages {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetServiceMessages resets all changes to the "service_messages" edge.
+func (m *TelegramAccountMutation) ResetServiceMessages() {
+	m.service_messages = nil
+	m.clearedservice_messages = false
+	m.removedservice_messages = nil
+}
+
+// Where appends a list predicates to the TelegramAccountMutation builder.
+func (m *TelegramAccountMutation) Where(ps ...predicate.TelegramAccount) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the PRNotificationMutation builder. Using this method,
+// WhereP appends storage-level predicates to the TelegramAccountMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *PRNotificationMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.PRNotification, len(ps))
+func (m *TelegramAccountMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.TelegramAccount, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -817,42 +2639,57 @@ func (m *PRNotificationMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *PRNotificationMutation) Op() Op {
+func (m *TelegramAccountMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *PRNotificationMutation) SetOp(op Op) {
+func (m *TelegramAccountMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (PRNotification).
-func (m *PRNotificationMutation) Type() string {
+// Type returns the node type of this mutation (TelegramAccount).
+func (m *TelegramAccountMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *PRNotificationMutation) Fields() []string {
-	fields := make([]string, 0, 6)
-	if m.repo_id != nil {
-		fields = append(fields, prnotification.FieldRepoID)
+func (m *TelegramAccountMutation) Fields() []string {
+	fields := make([]string, 0, 11)
+	if m.code != nil {
+		fields = append(fields, telegramaccount.FieldCode)
 	}
-	if m.pull_request_id != nil {
-		fields = append(fields, prnotification.FieldPullRequestID)
+	if m.code_at != nil {
+		fields = append(fields, telegramaccount.FieldCodeAt)
 	}
-	if m.pull_request_title != nil {
-		fields = append(fields, prnotification.FieldPullRequestTitle)
+	if m.state != nil {
+		fields = append(fields, telegramaccount.FieldState)
 	}
-	if m.pull_request_body != nil {
-		fields = append(fields, prnotification.FieldPullRequestBody)
+	if m.status != nil {
+		fields = append(fields, telegramaccount.FieldStatus)
 	}
-	if m.pull_request_author_login != nil {
-		fields = append(fields, prnotification.FieldPullRequestAuthorLogin)
+	if m.session_data != nil {
+		fields = append(fields, telegramaccount.FieldSessionData)
 	}
-	if m.message_id != nil {
-		fields = append(fields, prnotification.FieldMessageID)
+	if m.password != nil {
+		fields = append(fields, telegramaccount.FieldPassword)
+	}
+	if m.disabled != nil {
+		fields = append(fields, telegramaccount.FieldDisabled)
+	}
+	if m.dc_list != nil {
+		fields = append(fields, telegramaccount.FieldDcList)
+	}
+	if m.dc != nil {
+		fields = append(fields, telegramaccount.FieldDc)
+	}
+	if m.app_id != nil {
+		fields = append(fields, telegramaccount.FieldAppID)
+	}
+	if m.app_hash != nil {
+		fields = append(fields, telegramaccount.FieldAppHash)
 	}
 	return fields
 }
@@ -860,20 +2697,30 @@ func (m *PRNotificationMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *PRNotificationMutation) Field(name string) (ent.Value, bool) {
+func (m *TelegramAccountMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case prnotification.FieldRepoID:
-		return m.RepoID()
-	case prnotification.FieldPullRequestID:
-		return m.PullRequestID()
-	case prnotification.FieldPullRequestTitle:
-		return m.PullRequestTitle()
-	case prnotification.FieldPullRequestBody:
-		return m.PullRequestBody()
-	case prnotification.FieldPullRequestAuthorLogin:
-		return m.PullRequestAuthorLogin()
-	case prnotification.FieldMessageID:
-		return m.MessageID()
+	case telegramaccount.FieldCode:
+		return m.Code()
+	case telegramaccount.FieldCodeAt:
+		return m.CodeAt()
+	case telegramaccount.FieldState:
+		return m.State()
+	case telegramaccount.FieldStatus:
+		return m.Status()
+	case telegramaccount.FieldSessionData:
+		return m.SessionData()
+	case telegramaccount.FieldPassword:
+		return m.Password()
+	case telegramaccount.FieldDisabled:
+		return m.Disabled()
+	case telegramaccount.FieldDcList:
+		return m.DcList()
+	case telegramaccount.FieldDc:
+		return m.Dc()
+	case telegramaccount.FieldAppID:
+		return m.AppID()
+	case telegramaccount.FieldAppHash:
+		return m.AppHash()
 	}
 	return nil, false
 }
@@ -881,87 +2728,129 @@ func (m *PRNotificationMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *PRNotificationMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *TelegramAccountMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case prnotification.FieldRepoID:
-		return m.OldRepoID(ctx)
-	case prnotification.FieldPullRequestID:
-		return m.OldPullRequestID(ctx)
-	case prnotification.FieldPullRequestTitle:
-		return m.OldPullRequestTitle(ctx)
-	case prnotification.FieldPullRequestBody:
-		return m.OldPullRequestBody(ctx)
-	case prnotification.FieldPullRequestAuthorLogin:
-		return m.OldPullRequestAuthorLogin(ctx)
-	case prnotification.FieldMessageID:
-		return m.OldMessageID(ctx)
+	case telegramaccount.FieldCode:
+		return m.OldCode(ctx)
+	case telegramaccount.FieldCodeAt:
+		return m.OldCodeAt(ctx)
+	case telegramaccount.FieldState:
+		return m.OldState(ctx)
+	case telegramaccount.FieldStatus:
+		return m.OldStatus(ctx)
+	case telegramaccount.FieldSessionData:
+		return m.OldSessionData(ctx)
+	case telegramaccount.FieldPassword:
+		return m.OldPassword(ctx)
+	case telegramaccount.FieldDisabled:
+		return m.OldDisabled(ctx)
+	case telegramaccount.FieldDcList:
+		return m.OldDcList(ctx)
+	case telegramaccount.FieldDc:
+		return m.OldDc(ctx)
+	case telegramaccount.FieldAppID:
+		return m.OldAppID(ctx)
+	case telegramaccount.FieldAppHash:
+		return m.OldAppHash(ctx)
 	}
-	return nil, fmt.Errorf("unknown PRNotification field %s", name)
+	return nil, fmt.Errorf("unknown TelegramAccount field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *PRNotificationMutation) SetField(name string, value ent.Value) error {
+func (m *TelegramAccountMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case prnotification.FieldRepoID:
-		v, ok := value.(int64)
+	case telegramaccount.FieldCode:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetRepoID(v)
+		m.SetCode(v)
 		return nil
-	case prnotification.FieldPullRequestID:
-		v, ok := value.(int)
+	case telegramaccount.FieldCodeAt:
+		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetPullRequestID(v)
+		m.SetCodeAt(v)
 		return nil
-	case prnotification.FieldPullRequestTitle:
-		v, ok := value.(string)
+	case telegramaccount.FieldState:
+		v, ok := value.(telegramaccount.State)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetPullRequestTitle(v)
+		m.SetState(v)
 		return nil
-	case prnotification.FieldPullRequestBody:
+	case telegramaccount.FieldStatus:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetPullRequestBody(v)
+		m.SetStatus(v)
 		return nil
-	case prnotification.FieldPullRequestAuthorLogin:
-		v, ok := value.(string)
+	case telegramaccount.FieldSessionData:
+		v, ok := value.([]byte)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetPullRequestAuthorLogin(v)
+		m.SetSessionData(v)
 		return nil
-	case prnotification.FieldMessageID:
+	case telegramaccount.FieldPassword:
+		v, ok := value.([]byte)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPassword(v)
+		return nil
+	case telegramaccount.FieldDisabled:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDisabled(v)
+		return nil
+	case telegramaccount.FieldDcList:
+		v, ok := value.(telegramaccount.DcList)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDcList(v)
+		return nil
+	case telegramaccount.FieldDc:
 		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetMessageID(v)
+		m.SetDc(v)
+		return nil
+	case telegramaccount.FieldAppID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAppID(v)
+		return nil
+	case telegramaccount.FieldAppHash:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAppHash(v)
 		return nil
 	}
-	return fmt.Errorf("unknown PRNotification field %s", name)
+	return fmt.Errorf("unknown TelegramAccount field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *PRNotificationMutation) AddedFields() []string {
+func (m *TelegramAccountMutation) AddedFields() []string {
 	var fields []string
-	if m.addrepo_id != nil {
-		fields = append(fields, prnotification.FieldRepoID)
-	}
-	if m.addpull_request_id != nil {
-		fields = append(fields, prnotification.FieldPullRequestID)
+	if m.adddc != nil {
+		fields = append(fields, telegramaccount.FieldDc)
 	}
-	if m.addmessage_id != nil {
-		fields = append(fields, prnotification.FieldMessageID)
+	if m.addapp_id != nil {
+		fields = append(fields, telegramaccount.FieldAppID)
 	}
 	return fields
 }
@@ -969,14 +2858,12 @@ func (m *PRNotificationMutation) AddedFields() []string {
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *PRNotificationMutation) AddedField(name string) (ent.Value, bool) {
+func (m *TelegramAccountMutation) AddedField(name string) (ent.Value, bool) {
 	switch name {
-	case prnotification.FieldRepoID:
-		return m.AddedRepoID()
-	case prnotification.FieldPullRequestID:
-		return m.AddedPullRequestID()
-	case prnotification.FieldMessageID:
-		return m.AddedMessageID()
+	case telegramaccount.FieldDc:
+		return m.AddedDc()
+	case telegramaccount.FieldAppID:
+		return m.AddedAppID()
 	}
 	return nil, false
 }
@@ -984,154 +2871,244 @@ func (m *PRNotificationMutation) AddedField(name string) (ent.Value, bool) {
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *PRNotificationMutation) AddField(name string, value ent.Value) error {
+func (m *TelegramAccountMutation) AddField(name string, value ent.Value) error {
 	switch name {
-	case prnotification.FieldRepoID:
-		v, ok := value.(int64)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddRepoID(v)
-		return nil
-	case prnotification.FieldPullRequestID:
+	case telegramaccount.FieldDc:
 		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.AddPullRequestID(v)
+		m.AddDc(v)
 		return nil
-	case prnotification.FieldMessageID:
+	case telegramaccount.FieldAppID:
 		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.AddMessageID(v)
+		m.AddAppID(v)
 		return nil
 	}
-	return fmt.Errorf("unknown PRNotification numeric field %s", name)
+	return fmt.Errorf("unknown TelegramAccount numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *PRNotificationMutation) ClearedFields() []string {
-	return nil
+func (m *TelegramAccountMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(telegramaccount.FieldCode) {
+		fields = append(fields, telegramaccount.FieldCode)
+	}
+	if m.FieldCleared(telegramaccount.FieldCodeAt) {
+		fields = append(fields, telegramaccount.FieldCodeAt)
+	}
+	if m.FieldCleared(telegramaccount.FieldSessionData) {
+		fields = append(fields, telegramaccount.FieldSessionData)
+	}
+	if m.FieldCleared(telegramaccount.FieldPassword) {
+		fields = append(fields, telegramaccount.FieldPassword)
+	}
+	if m.FieldCleared(telegramaccount.FieldDc) {
+		fields = append(fields, telegramaccount.FieldDc)
+	}
+	if m.FieldCleared(telegramaccount.FieldAppID) {
+		fields = append(fields, telegramaccount.FieldAppID)
+	}
+	if m.FieldCleared(telegramaccount.FieldAppHash) {
+		fields = append(fields, telegramaccount.FieldAppHash)
+	}
+	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *PRNotificationMutation) FieldCleared(name string) bool {
+func (m *TelegramAccountMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *PRNotificationMutation) ClearField(name string) error {
-	return fmt.Errorf("unknown PRNotification nullable field %s", name)
+func (m *TelegramAccountMutation) ClearField(name string) error {
+	switch name {
+	case telegramaccount.FieldCode:
+		m.ClearCode()
+		return nil
+	case telegramaccount.FieldCodeAt:
+		m.ClearCodeAt()
+		return nil
+	case telegramaccount.FieldSessionData:
+		m.ClearSessionData()
+		return nil
+	case telegramaccount.FieldPassword:
+		m.ClearPassword()
+		return nil
+	case telegramaccount.FieldDc:
+		m.ClearDc()
+		return nil
+	case telegramaccount.FieldAppID:
+		m.ClearAppID()
+		return nil
+	case telegramaccount.FieldAppHash:
+		m.ClearAppHash()
+		return nil
+	}
+	return fmt.Errorf("unknown TelegramAccount nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *PRNotificationMutation) ResetField(name string) error {
+func (m *TelegramAccountMutation) ResetField(name string) error {
 	switch name {
-	case prnotification.FieldRepoID:
-		m.ResetRepoID()
+	case telegramaccount.FieldCode:
+		m.ResetCode()
 		return nil
-	case prnotification.FieldPullRequestID:
-		m.ResetPullRequestID()
+	case telegramaccount.FieldCodeAt:
+		m.ResetCodeAt()
 		return nil
-	case prnotification.FieldPullRequestTitle:
-		m.ResetPullRequestTitle()
+	case telegramaccount.FieldState:
+		m.ResetState()
 		return nil
-	case prnotification.FieldPullRequestBody:
-		m.ResetPullRequestBody()
+	case telegramaccount.FieldStatus:
+		m.ResetStatus()
 		return nil
-	case prnotification.FieldPullRequestAuthorLogin:
-		m.ResetPullRequestAuthorLogin()
+	case telegramaccount.FieldSessionData:
+		m.ResetSessionData()
 		return nil
-	case prnotification.FieldMessageID:
-		m.ResetMessageID()
+	case telegramaccount.FieldPassword:
+		m.ResetPassword()
+		return nil
+	case telegramaccount.FieldDisabled:
+		m.ResetDisabled()
+		return nil
+	case telegramaccount.FieldDcList:
+		m.ResetDcList()
+		return nil
+	case telegramaccount.FieldDc:
+		m.ResetDc()
+		return nil
+	case telegramaccount.FieldAppID:
+		m.ResetAppID()
+		return nil
+	case telegramaccount.FieldAppHash:
+		m.ResetAppHash()
 		return nil
 	}
-	return fmt.Errorf("unknown PRNotification field %s", name)
+	return fmt.Errorf("unknown TelegramAccount field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *PRNotificationMutation) AddedEdges() []string {
-	edges := make([]string, 0, 0)
+func (m *TelegramAccountMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.service_messages != nil {
+		edges = append(edges, telegramaccount.EdgeServiceMessages)
+	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *PRNotificationMutation) AddedIDs(name string) []ent.Value {
+func (m *TelegramAccountMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case telegramaccount.EdgeServiceMessages:
+		ids := make([]ent.Value, 0, len(m.service_messages))
+		for id := range m.service_messages {
+			ids = append(ids, id)
+		}
+		return ids
+	}
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *PRNotificationMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 0)
+func (m *TelegramAccountMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.removedservice_messages != nil {
+		edges = append(edges, telegramaccount.EdgeServiceMessages)
+	}
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *PRNotificationMutation) RemovedIDs(name string) []ent.Value {
+func (m *TelegramAccountMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case telegramaccount.EdgeServiceMessages:
+		ids := make([]ent.Value, 0, len(m.removedservice_messages))
+		for id := range m.removedservice_messages {
+			ids = append(ids, id)
+		}
+		return ids
+	}
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *PRNotificationMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 0)
+func (m *TelegramAccountMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedservice_messages {
+		edges = append(edges, telegramaccount.EdgeServiceMessages)
+	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *PRNotificationMutation) EdgeCleared(name string) bool {
+func (m *TelegramAccountMutation) EdgeCleared(name string) bool {
+	switch name {
+	case telegramaccount.EdgeServiceMessages:
+		return m.clearedservice_messages
+	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *PRNotificationMutation) ClearEdge(name string) error {
-	return fmt.Errorf("unknown PRNotification unique edge %s", name)
+func (m *TelegramAccountMutation) ClearEdge(name string) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown TelegramAccount unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *PRNotificationMutation) ResetEdge(name string) error {
-	return fmt.Errorf("unknown PRNotification edge %s", name)
+func (m *TelegramAccountMutation) ResetEdge(name string) error {
+	switch name {
+	case telegramaccount.EdgeServiceMessages:
+		m.ResetServiceMessages()
+		return nil
+	}
+	return fmt.Errorf("unknown TelegramAccount edge %s", name)
 }
 
-// TelegramAccountMutation represents an operation that mutates the TelegramAccount nodes in the graph.
-type TelegramAccountMutation struct {
+// TelegramChannelStateMutation represents an operation that mutates the TelegramChannelState nodes in the graph.
+type TelegramChannelStateMutation struct {
 	config
 	op            Op
 	typ           string
-	id            *string
-	code          *string
-	code_at       *time.Time
-	state         *telegramaccount.State
-	status        *string
-	session_data  *[]byte
+	id            *int
+	channel_id    *int64
+	addchannel_id *int64
+	pts           *int
+	addpts        *int
 	clearedFields map[string]struct{}
+	user          *int64
+	cleareduser   bool
 	done          bool
-	oldValue      func(context.Context) (*TelegramAccount, error)
-	predicates    []predicate.TelegramAccount
+	oldValue      func(context.Context) (*TelegramChannelState, error)
+	predicates    []predicate.TelegramChannelState
 }
 
-var _ ent.Mutation = (*TelegramAccountMutation)(nil)
+var _ ent.Mutation = (*TelegramChannelStateMutation)(nil)
 
-// telegramaccountOption allows management of the mutation configuration using functional options.
-type telegramaccountOption func(*TelegramAccountMutation)
+// telegramchannelstateOption allows management of the mutation configuration using functional options.
+type telegramchannelstateOption func(*TelegramChannelStateMutation)
 
-// newTelegramAccountMutation creates new mutation for the TelegramAccount entity.
-func newTelegramAccountMutation(c config, op Op, opts ...telegramaccountOption) *TelegramAccountMutation {
-	m := &TelegramAccountMutation{
+// newTelegramChannelStateMutation creates new mutation for the TelegramChannelState entity.
+func newTelegramChannelStateMutation(c config, op Op, opts ...telegramchannelstateOption) *TelegramChannelStateMutation {
+	m := &TelegramChannelStateMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeTelegramAccount,
+		typ:           TypeTelegramChannelState,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -1140,20 +3117,20 @@ func newTelegramAccountMutation(c config, op Op, opts ...telegramaccountOption)
 	return m
 }
 
-// withTelegramAccountID sets the ID field of the mutation.
-func withTelegramAccountID(id string) telegramaccountOption {
-	return func(m *TelegramAccountMutation) {
+// withTelegramChannelStateID sets the ID field of the mutation.
+func withTelegramChannelStateID(id int) telegramchannelstateOption {
+	return func(m *TelegramChannelStateMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *TelegramAccount
+			value *TelegramChannelState
 		)
-		m.oldValue = func(ctx context.Context) (*TelegramAccount, error) {
+		m.oldValue = func(ctx context.Context) (*TelegramChannelState, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().TelegramAccount.Get(ctx, id)
+					value, err = m.Client().TelegramChannelState.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -1162,10 +3139,10 @@ func withTelegramAccountID(id string) telegramaccountOption {
 	}
 }
 
-// withTelegramAccount sets the old TelegramAccount of the mutation.
-func withTelegramAccount(node *TelegramAccount) telegramaccountOption {
-	return func(m *TelegramAccountMutation) {
-		m.oldValue = func(context.Context) (*TelegramAccount, error) {
+// withTelegramChannelState sets the old TelegramChannelState of the mutation.
+func withTelegramChannelState(node *TelegramChannelState) telegramchannelstateOption {
+	return func(m *TelegramChannelStateMutation) {
+		m.oldValue = func(context.Context) (*TelegramChannelState, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -1174,7 +3151,7 @@ func withTelegramAccount(node *TelegramAccount) telegramaccountOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m TelegramAccountMutation) Client() *Client {
+func (m TelegramChannelStateMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -1182,7 +3159,7 @@ func (m TelegramAccountMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m TelegramAccountMutation) Tx() (*Tx, error) {
+func (m TelegramChannelStateMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -1191,15 +3168,9 @@ func (m TelegramAccountMutation) Tx() (*Tx, error) {
 	return tx, nil
 }
 
-// SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of TelegramAccount entities.
-func (m *TelegramAccountMutation) SetID(id string) {
-	m.id = &id
-}
-
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *TelegramAccountMutation) ID() (id string, exists bool) {
+func (m *TelegramChannelStateMutation) ID() (id int, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -1210,249 +3181,205 @@ func (m *TelegramAccountMutation) ID() (id string, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *TelegramAccountMutation) IDs(ctx context.Context) ([]string, error) {
+func (m *TelegramChannelStateMutation) IDs(ctx context.Context) ([]int, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
-		if exists {
-			return []string{id}, nil
-		}
-		fallthrough
-	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().TelegramAccount.Query().Where(m.predicates...).IDs(ctx)
-	default:
-		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
-	}
-}
-
-// SetCode sets the "code" field.
-func (m *TelegramAccountMutation) SetCode(s string) {
-	m.code = &s
-}
-
-// Code returns the value of the "code" field in the mutation.
-func (m *TelegramAccountMutation) Code() (r string, exists bool) {
-	v := m.code
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// OldCode returns the old "code" field's value of the TelegramAccount entity.
-// If the TelegramAccount object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TelegramAccountMutation) OldCode(ctx context.Context) (v *string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCode is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCode requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCode: %w", err)
-	}
-	return oldValue.Code, nil
-}
-
-// ClearCode clears the value of the "code" field.
-func (m *TelegramAccountMutation) ClearCode() {
-	m.code = nil
-	m.clearedFields[telegramaccount.FieldCode] = struct{}{}
-}
-
-// CodeCleared returns if the "code" field was cleared in this mutation.
-func (m *TelegramAccountMutation) CodeCleared() bool {
-	_, ok := m.clearedFields[telegramaccount.FieldCode]
-	return ok
-}
-
-// ResetCode resets all changes to the "code" field.
-func (m *TelegramAccountMutation) ResetCode() {
-	m.code = nil
-	delete(m.clearedFields, telegramaccount.FieldCode)
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().TelegramChannelState.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
 }
 
-// SetCodeAt sets the "code_at" field.
-func (m *TelegramAccountMutation) SetCodeAt(t time.Time) {
-	m.code_at = &t
+// SetChannelID sets the "channel_id" field.
+func (m *TelegramChannelStateMutation) SetChannelID(i int64) {
+	m.channel_id = &i
+	m.addchannel_id = nil
 }
 
-// CodeAt returns the value of the "code_at" field in the mutation.
-func (m *TelegramAccountMutation) CodeAt() (r time.Time, exists bool) {
-	v := m.code_at
+// ChannelID returns the value of the "channel_id" field in the mutation.
+func (m *TelegramChannelStateMutation) ChannelID() (r int64, exists bool) {
+	v := m.channel_id
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldCodeAt returns the old "code_at" field's value of the TelegramAccount entity.
-// If the TelegramAccount object wasn't provided to the builder, the object is fetched from the database.
+// OldChannelID returns the old "channel_id" field's value of the TelegramChannelState entity.
+// If the TelegramChannelState object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TelegramAccountMutation) OldCodeAt(ctx context.Context) (v *time.Time, err error) {
+func (m *TelegramChannelStateMutation) OldChannelID(ctx context.Context) (v int64, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCodeAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldChannelID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCodeAt requires an ID field in the mutation")
+		return v, errors.New("OldChannelID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCodeAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldChannelID: %w", err)
 	}
-	return oldValue.CodeAt, nil
+	return oldValue.ChannelID, nil
 }
 
-// ClearCodeAt clears the value of the "code_at" field.
-func (m *TelegramAccountMutation) ClearCodeAt() {
-	m.code_at = nil
-	m.clearedFields[telegramaccount.FieldCodeAt] = struct{}{}
+// AddChannelID adds i to the "channel_id" field.
+func (m *TelegramChannelStateMutation) AddChannelID(i int64) {
+	if m.addchannel_id != nil {
+		*m.addchannel_id += i
+	} else {
+		m.addchannel_id = &i
+	}
 }
 
-// CodeAtCleared returns if the "code_at" field was cleared in this mutation.
-func (m *TelegramAccountMutation) CodeAtCleared() bool {
-	_, ok := m.clearedFields[telegramaccount.FieldCodeAt]
-	return ok
+// AddedChannelID returns the value that was added to the "channel_id" field in this mutation.
+func (m *TelegramChannelStateMutation) AddedChannelID() (r int64, exists bool) {
+	v := m.addchannel_id
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// ResetCodeAt resets all changes to the "code_at" field.
-func (m *TelegramAccountMutation) ResetCodeAt() {
-	m.code_at = nil
-	delete(m.clearedFields, telegramaccount.FieldCodeAt)
+// ResetChannelID resets all changes to the "channel_id" field.
+func (m *TelegramChannelStateMutation) ResetChannelID() {
+	m.channel_id = nil
+	m.addchannel_id = nil
 }
 
-// SetState sets the "state" field.
-func (m *TelegramAccountMutation) SetState(t telegramaccount.State) {
-	m.state = &t
+// SetUserID sets the "user_id" field.
+func (m *TelegramChannelStateMutation) SetUserID(i int64) {
+	m.user = &i
 }
 
-// State returns the value of the "state" field in the mutation.
-func (m *TelegramAccountMutation) State() (r telegramaccount.State, exists bool) {
-	v := m.state
+// UserID returns the value of the "user_id" field in the mutation.
+func (m *TelegramChannelStateMutation) UserID() (r int64, exists bool) {
+	v := m.user
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldState returns the old "state" field's value of the TelegramAccount entity.
-// If the TelegramAccount object wasn't provided to the builder, the object is fetched from the database.
+// OldUserID returns the old "user_id" field's value of the TelegramChannelState entity.
+// If the TelegramChannelState object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TelegramAccountMutation) OldState(ctx context.Context) (v telegramaccount.State, err error) {
+func (m *TelegramChannelStateMutation) OldUserID(ctx context.Context) (v int64, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldState is only allowed on UpdateOne operations")
+		return v, errors.New("OldUserID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldState requires an ID field in the mutation")
+		return v, errors.New("OldUserID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldState: %w", err)
+		return v, fmt.Errorf("querying old value for OldUserID: %w", err)
 	}
-	return oldValue.State, nil
+	return oldValue.UserID, nil
 }
 
-// ResetState resets all changes to the "state" field.
-func (m *TelegramAccountMutation) ResetState() {
-	m.state = nil
+// ResetUserID resets all changes to the "user_id" field.
+func (m *TelegramChannelStateMutation) ResetUserID() {
+	m.user = nil
 }
 
-// SetStatus sets the "status" field.
-func (m *TelegramAccountMutation) SetStatus(s string) {
-	m.status = &s
+// SetPts sets the "pts" field.
+func (m *TelegramChannelStateMutation) SetPts(i int) {
+	m.pts = &i
+	m.addpts = nil
 }
 
-// Status returns the value of the "status" field in the mutation.
-func (m *TelegramAccountMutation) Status() (r string, exists bool) {
-	v := m.status
+// Pts returns the value of the "pts" field in the mutation.
+func (m *TelegramChannelStateMutation) Pts() (r int, exists bool) {
+	v := m.pts
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldStatus returns the old "status" field's value of the TelegramAccount entity.
-// If the TelegramAccount object wasn't provided to the builder, the object is fetched from the database.
+// OldPts returns the old "pts" field's value of the TelegramChannelState entity.
+// If the TelegramChannelState object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TelegramAccountMutation) OldStatus(ctx context.Context) (v string, err error) {
+func (m *TelegramChannelStateMutation) OldPts(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
+		return v, errors.New("OldPts is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldStatus requires an ID field in the mutation")
+		return v, errors.New("OldPts requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
+		return v, fmt.Errorf("querying old value for OldPts: %w", err)
 	}
-	return oldValue.Status, nil
-}
-
-// ResetStatus resets all changes to the "status" field.
-func (m *TelegramAccountMutation) ResetStatus() {
-	m.status = nil
+	return oldValue.Pts, nil
 }
 
-// SetSessionData sets the "session_data" field.
-func (m *TelegramAccountMutation) SetSessionData(b []byte) {
-	m.session_data = &b
+// AddPts adds i to the "pts" field.
+func (m *TelegramChannelStateMutation) AddPts(i int) {
+	if m.addpts != nil {
+		*m.addpts += i
+	} else {
+		m.addpts = &i
+	}
 }
 
-// SessionData returns the value of the "session_data" field in the mutation.
-func (m *TelegramAccountMutation) SessionData() (r []byte, exists bool) {
-	v := m.session_data
+// AddedPts returns the value that was added to the "pts" field in this mutation.
+func (m *TelegramChannelStateMutation) AddedPts() (r int, exists bool) {
+	v := m.addpts
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldSessionData returns the old "session_data" field's value of the TelegramAccount entity.
-// If the TelegramAccount object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TelegramAccountMutation) OldSessionData(ctx context.Context) (v *[]byte, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldSessionData is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldSessionData requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldSessionData: %w", err)
-	}
-	return oldValue.SessionData, nil
+// ResetPts resets all changes to the "pts" field.
+func (m *TelegramChannelStateMutation) ResetPts() {
+	m.pts = nil
+	m.addpts = nil
 }
 
-// ClearSessionData clears the value of the "session_data" field.
-func (m *TelegramAccountMutation) ClearSessionData() {
-	m.session_data = nil
-	m.clearedFields[telegramaccount.FieldSessionData] = struct{}{}
+// ClearUser clears the "user" edge to the TelegramUserState entity.
+func (m *TelegramChannelStateMutation) ClearUser() {
+	m.cleareduser = true
+	m.clearedFields[telegramchannelstate.FieldUserID] = struct{}{}
 }
 
-// SessionDataCleared returns if the "session_data" field was cleared in this mutation.
-func (m *TelegramAccountMutation) SessionDataCleared() bool {
-	_, ok := m.clearedFields[telegramaccount.FieldSessionData]
-	return ok
+// UserCleared reports if the "user" edge to the TelegramUserState entity was cleared.
+func (m *TelegramChannelStateMutation) UserCleared() bool {
+	return m.cleareduser
 }
 
-// ResetSessionData resets all changes to the "session_data" field.
-func (m *TelegramAccountMutation) ResetSessionData() {
-	m.session_data = nil
-	delete(m.clearedFields, telegramaccount.FieldSessionData)
+// UserIDs returns the "user" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// UserID instead. It exists only for internal usage by the builders.
+func (m *TelegramChannelStateMutation) UserIDs() (ids []int64) {
+	if id := m.user; id != nil {
+		ids = append(ids, *id)
+	}
+	return
 }
 
-// Where appends a list predicates to the TelegramAccountMutation builder.
-func (m *TelegramAccountMutation) Where(ps ...predicate.TelegramAccount) {
+// ResetUser resets all changes to the "user" edge.
+func (m *TelegramChannelStateMutation) ResetUser() {
+	m.user = nil
+	m.cleareduser = false
+}
+
+// Where appends a list predicates to the TelegramChannelStateMutation builder.
+func (m *TelegramChannelStateMutation) Where(ps ...predicate.TelegramChannelState) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the TelegramAccountMutation builder. Using this method,
+// WhereP appends storage-level predicates to the TelegramChannelStateMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *TelegramAccountMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.TelegramAccount, len(ps))
+func (m *TelegramChannelStateMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.TelegramChannelState, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -1460,39 +3387,33 @@ func (m *TelegramAccountMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *TelegramAccountMutation) Op() Op {
+func (m *TelegramChannelStateMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *TelegramAccountMutation) SetOp(op Op) {
+func (m *TelegramChannelStateMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (TelegramAccount).
-func (m *TelegramAccountMutation) Type() string {
+// Type returns the node type of this mutation (TelegramChannelState).
+func (m *TelegramChannelStateMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *TelegramAccountMutation) Fields() []string {
-	fields := make([]string, 0, 5)
-	if m.code != nil {
-		fields = append(fields, telegramaccount.FieldCode)
-	}
-	if m.code_at != nil {
-		fields = append(fields, telegramaccount.FieldCodeAt)
-	}
-	if m.state != nil {
-		fields = append(fields, telegramaccount.FieldState)
+func (m *TelegramChannelStateMutation) Fields() []string {
+	fields := make([]string, 0, 3)
+	if m.channel_id != nil {
+		fields = append(fields, telegramchannelstate.FieldChannelID)
 	}
-	if m.status != nil {
-		fields = append(fields, telegramaccount.FieldStatus)
+	if m.user != nil {
+		fields = append(fields, telegramchannelstate.FieldUserID)
 	}
-	if m.session_data != nil {
-		fields = append(fields, telegramaccount.FieldSessionData)
+	if m.pts != nil {
+		fields = append(fields, telegramchannelstate.FieldPts)
 	}
 	return fields
 }
@@ -1500,18 +3421,14 @@ func (m *TelegramAccountMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *TelegramAccountMutation) Field(name string) (ent.Value, bool) {
+func (m *TelegramChannelStateMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case telegramaccount.FieldCode:
-		return m.Code()
-	case telegramaccount.FieldCodeAt:
-		return m.CodeAt()
-	case telegramaccount.FieldState:
-		return m.State()
-	case telegramaccount.FieldStatus:
-		return m.Status()
-	case telegramaccount.FieldSessionData:
-		return m.SessionData()
+	case telegramchannelstate.FieldChannelID:
+		return m.ChannelID()
+	case telegramchannelstate.FieldUserID:
+		return m.UserID()
+	case telegramchannelstate.FieldPts:
+		return m.Pts()
 	}
 	return nil, false
 }
@@ -1519,228 +3436,237 @@ func (m *TelegramAccountMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *TelegramAccountMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *TelegramChannelStateMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case telegramaccount.FieldCode:
-		return m.OldCode(ctx)
-	case telegramaccount.FieldCodeAt:
-		return m.OldCodeAt(ctx)
-	case telegramaccount.FieldState:
-		return m.OldState(ctx)
-	case telegramaccount.FieldStatus:
-		return m.OldStatus(ctx)
-	case telegramaccount.FieldSessionData:
-		return m.OldSessionData(ctx)
+	case telegramchannelstate.FieldChannelID:
+		return m.OldChannelID(ctx)
+	case telegramchannelstate.FieldUserID:
+		return m.OldUserID(ctx)
+	case telegramchannelstate.FieldPts:
+		return m.OldPts(ctx)
 	}
-	return nil, fmt.Errorf("unknown TelegramAccount field %s", name)
+	return nil, fmt.Errorf("unknown TelegramChannelState field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *TelegramAccountMutation) SetField(name string, value ent.Value) error {
+func (m *TelegramChannelStateMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case telegramaccount.FieldCode:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetCode(v)
-		return nil
-	case telegramaccount.FieldCodeAt:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetCodeAt(v)
-		return nil
-	case telegramaccount.FieldState:
-		v, ok := value.(telegramaccount.State)
+	case telegramchannelstate.FieldChannelID:
+		v, ok := value.(int64)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetState(v)
+		m.SetChannelID(v)
 		return nil
-	case telegramaccount.FieldStatus:
-		v, ok := value.(string)
+	case telegramchannelstate.FieldUserID:
+		v, ok := value.(int64)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetStatus(v)
+		m.SetUserID(v)
 		return nil
-	case telegramaccount.FieldSessionData:
-		v, ok := value.([]byte)
+	case telegramchannelstate.FieldPts:
+		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetSessionData(v)
+		m.SetPts(v)
 		return nil
 	}
-	return fmt.Errorf("unknown TelegramAccount field %s", name)
+	return fmt.Errorf("unknown TelegramChannelState field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *TelegramAccountMutation) AddedFields() []string {
-	return nil
+func (m *TelegramChannelStateMutation) AddedFields() []string {
+	var fields []string
+	if m.addchannel_id != nil {
+		fields = append(fields, telegramchannelstate.FieldChannelID)
+	}
+	if m.addpts != nil {
+		fields = append(fields, telegramchannelstate.FieldPts)
+	}
+	return fields
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *TelegramAccountMutation) AddedField(name string) (ent.Value, bool) {
+func (m *TelegramChannelStateMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case telegramchannelstate.FieldChannelID:
+		return m.AddedChannelID()
+	case telegramchannelstate.FieldPts:
+		return m.AddedPts()
+	}
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *TelegramAccountMutation) AddField(name string, value ent.Value) error {
+func (m *TelegramChannelStateMutation) AddField(name string, value ent.Value) error {
 	switch name {
+	case telegramchannelstate.FieldChannelID:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddChannelID(v)
+		return nil
+	case telegramchannelstate.FieldPts:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddPts(v)
+		return nil
 	}
-	return fmt.Errorf("unknown TelegramAccount numeric field %s", name)
+	return fmt.Errorf("unknown TelegramChannelState numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *TelegramAccountMutation) ClearedFields() []string {
-	var fields []string
-	if m.FieldCleared(telegramaccount.FieldCode) {
-		fields = append(fields, telegramaccount.FieldCode)
-	}
-	if m.FieldCleared(telegramaccount.FieldCodeAt) {
-		fields = append(fields, telegramaccount.FieldCodeAt)
-	}
-	if m.FieldCleared(telegramaccount.FieldSessionData) {
-		fields = append(fields, telegramaccount.FieldSessionData)
-	}
-	return fields
+func (m *TelegramChannelStateMutation) ClearedFields() []string {
+	return nil
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *TelegramAccountMutation) FieldCleared(name string) bool {
+func (m *TelegramChannelStateMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *TelegramAccountMutation) ClearField(name string) error {
-	switch name {
-	case telegramaccount.FieldCode:
-		m.ClearCode()
-		return nil
-	case telegramaccount.FieldCodeAt:
-		m.ClearCodeAt()
-		return nil
-	case telegramaccount.FieldSessionData:
-		m.ClearSessionData()
-		return nil
-	}
-	return fmt.Errorf("unknown TelegramAccount nullable field %s", name)
+func (m *TelegramChannelStateMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown TelegramChannelState nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *TelegramAccountMutation) ResetField(name string) error {
+func (m *TelegramChannelStateMutation) ResetField(name string) error {
 	switch name {
-	case telegramaccount.FieldCode:
-		m.ResetCode()
-		return nil
-	case telegramaccount.FieldCodeAt:
-		m.ResetCodeAt()
-		return nil
-	case telegramaccount.FieldState:
-		m.ResetState()
+	case telegramchannelstate.FieldChannelID:
+		m.ResetChannelID()
 		return nil
-	case telegramaccount.FieldStatus:
-		m.ResetStatus()
+	case telegramchannelstate.FieldUserID:
+		m.ResetUserID()
 		return nil
-	case telegramaccount.FieldSessionData:
-		m.ResetSessionData()
+	case telegramchannelstate.FieldPts:
+		m.ResetPts()
 		return nil
 	}
-	return fmt.Errorf("unknown TelegramAccount field %s", name)
+	return fmt.Errorf("unknown TelegramChannelState field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *TelegramAccountMutation) AddedEdges() []string {
-	edges := make([]string, 0, 0)
+func (m *TelegramChannelStateMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.user != nil {
+		edges = append(edges, telegramchannelstate.EdgeUser)
+	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *TelegramAccountMutation) AddedIDs(name string) []ent.Value {
+func (m *TelegramChannelStateMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case telegramchannelstate.EdgeUser:
+		if id := m.user; id != nil {
+			return []ent.Value{*id}
+		}
+	}
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *TelegramAccountMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 0)
+func (m *TelegramChannelStateMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *TelegramAccountMutation) RemovedIDs(name string) []ent.Value {
+func (m *TelegramChannelStateMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *TelegramAccountMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 0)
+func (m *TelegramChannelStateMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.cleareduser {
+		edges = append(edges, telegramchannelstate.EdgeUser)
+	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *TelegramAccountMutation) EdgeCleared(name string) bool {
+func (m *TelegramChannelStateMutation) EdgeCleared(name string) bool {
+	switch name {
+	case telegramchannelstate.EdgeUser:
+		return m.cleareduser
+	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *TelegramAccountMutation) ClearEdge(name string) error {
-	return fmt.Errorf("unknown TelegramAccount unique edge %s", name)
+func (m *TelegramChannelStateMutation) ClearEdge(name string) error {
+	switch name {
+	case telegramchannelstate.EdgeUser:
+		m.ClearUser()
+		return nil
+	}
+	return fmt.Errorf("unknown TelegramChannelState unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *TelegramAccountMutation) ResetEdge(name string) error {
-	return fmt.Errorf("unknown TelegramAccount edge %s", name)
+func (m *TelegramChannelStateMutation) ResetEdge(name string) error {
+	switch name {
+	case telegramchannelstate.EdgeUser:
+		m.ResetUser()
+		return nil
+	}
+	return fmt.Errorf("unknown TelegramChannelState edge %s", name)
 }
 
-// TelegramChannelStateMutation represents an operation that mutates the TelegramChannelState nodes in the graph.
-type TelegramChannelStateMutation struct {
+// TelegramServiceMessageMutation represents an operation that mutates the TelegramServiceMessage nodes in the graph.
+type TelegramServiceMessageMutation struct {
 	config
-	op            Op
-	typ           string
-	id            *int
-	channel_id    *int64
-	addchannel_id *int64
-	pts           *int
-	addpts        *int
-	clearedFields map[string]struct{}
-	user          *int64
-	cleareduser   bool
-	done          bool
-	oldValue      func(context.Context) (*TelegramChannelState, error)
-	predicates    []predicate.TelegramChannelState
-}
-
-var _ ent.Mutation = (*TelegramChannelStateMutation)(nil)
-
-// telegramchannelstateOption allows management of the mutation configuration using functional options.
-type telegramchannelstateOption func(*TelegramChannelStateMutation)
-
-// newTelegramChannelStateMutation creates new mutation for the TelegramChannelState entity.
-func newTelegramChannelStateMutation(c config, op Op, opts ...telegramchannelstateOption) *TelegramChannelStateMutation {
-	m := &TelegramChannelStateMutation{
+	op             Op
+	typ            string
+	id             *int
+	message_id     *int
+	addmessage_id  *int
+	_type          *telegramservicemessage.Type
+	text           *string
+	date           *time.Time
+	clearedFields  map[string]struct{}
+	account        *string
+	clearedaccount bool
+	done           bool
+	oldValue       func(context.Context) (*TelegramServiceMessage, error)
+	predicates     []predicate.TelegramServiceMessage
+}
+
+var _ ent.Mutation = (*TelegramServiceMessageMutation)(nil)
+
+// telegramservicemessageOption allows management of the mutation configuration using functional options.
+type telegramservicemessageOption func(*TelegramServiceMessageMutation)
+
+// newTelegramServiceMessageMutation creates new mutation for the TelegramServiceMessage entity.
+func newTelegramServiceMessageMutation(c config, op Op, opts ...telegramservicemessageOption) *TelegramServiceMessageMutation {
+	m := &TelegramServiceMessageMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeTelegramChannelState,
+		typ:           TypeTelegramServiceMessage,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -1749,20 +3675,20 @@ func newTelegramChannelStateMutation(c config, op Op, opts ...telegramchannelsta
 	return m
 }
 
-// withTelegramChannelStateID sets the ID field of the mutation.
-func withTelegramChannelStateID(id int) telegramchannelstateOption {
-	return func(m *TelegramChannelStateMutation) {
+// withTelegramServiceMessageID sets the ID field of the mutation.
+func withTelegramServiceMessageID(id int) telegramservicemessageOption {
+	return func(m *TelegramServiceMessageMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *TelegramChannelState
+			value *TelegramServiceMessage
 		)
-		m.oldValue = func(ctx context.Context) (*TelegramChannelState, error) {
+		m.oldValue = func(ctx context.Context) (*TelegramServiceMessage, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().TelegramChannelState.Get(ctx, id)
+					value, err = m.Client().TelegramServiceMessage.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -1771,10 +3697,10 @@ func withTelegramChannelStateID(id int) telegramchannelstateOption {
 	}
 }
 
-// withTelegramChannelState sets the old TelegramChannelState of the mutation.
-func withTelegramChannelState(node *TelegramChannelState) telegramchannelstateOption {
-	return func(m *TelegramChannelStateMutation) {
-		m.oldValue = func(context.Context) (*TelegramChannelState, error) {
+// withTelegramServiceMessage sets the old TelegramServiceMessage of the mutation.
+func withTelegramServiceMessage(node *TelegramServiceMessage) telegramservicemessageOption {
+	return func(m *TelegramServiceMessageMutation) {
+		m.oldValue = func(context.Context) (*TelegramServiceMessage, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -1783,7 +3709,7 @@ func withTelegramChannelState(node *TelegramChannelState) telegramchannelstateOp
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m TelegramChannelStateMutation) Client() *Client {
+func (m TelegramServiceMessageMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -1791,7 +3717,7 @@ func (m TelegramChannelStateMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m TelegramChannelStateMutation) Tx() (*Tx, error) {
+func (m TelegramServiceMessageMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -1802,7 +3728,7 @@ func (m TelegramChannelStateMutation) Tx() (*Tx, error) {
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *TelegramChannelStateMutation) ID() (id int, exists bool) {
+func (m *TelegramServiceMessageMutation) ID() (id int, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -1813,7 +3739,7 @@ func (m *TelegramChannelStateMutation) ID() (id int, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *TelegramChannelStateMutation) IDs(ctx context.Context) ([]int, error) {
+func (m *TelegramServiceMessageMutation) IDs(ctx context.Context) ([]int, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -1822,196 +3748,248 @@ func (m *TelegramChannelStateMutation) IDs(ctx context.Context) ([]int, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().TelegramChannelState.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().TelegramServiceMessage.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetChannelID sets the "channel_id" field.
-func (m *TelegramChannelStateMutation) SetChannelID(i int64) {
-	m.channel_id = &i
-	m.addchannel_id = nil
+// SetAccountID sets the "account_id" field.
+func (m *TelegramServiceMessageMutation) SetAccountID(s string) {
+	m.account = &s
+}
+
+// AccountID returns the value of the "account_id" field in the mutation.
+func (m *TelegramServiceMessageMutation) AccountID() (r string, exists bool) {
+	v := m.account
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAccountID returns the old "account_id" field's value of the TelegramServiceMessage entity.
+// If the TelegramServiceMessage object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TelegramServiceMessageMutation) OldAccountID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAccountID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAccountID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAccountID: %w", err)
+	}
+	return oldValue.AccountID, nil
+}
+
+// ResetAccountID resets all changes to the "account_id" field.
+func (m *TelegramServiceMessageMutation) ResetAccountID() {
+	m.account = nil
 }
 
-// ChannelID returns the value of the "channel_id" field in the mutation.
-func (m *TelegramChannelStateMutation) ChannelID() (r int64, exists bool) {
-	v := m.channel_id
+// SetMessageID sets the "message_id" field.
+func (m *TelegramServiceMessageMutation) SetMessageID(i int) {
+	m.message_id = &i
+	m.addmessage_id = nil
+}
+
+// MessageID returns the value of the "message_id" field in the mutation.
+func (m *TelegramServiceMessageMutation) MessageID() (r int, exists bool) {
+	v := m.message_id
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldChannelID returns the old "channel_id" field's value of the TelegramChannelState entity.
-// If the TelegramChannelState object wasn't provided to the builder, the object is fetched from the database.
+// OldMessageID returns the old "message_id" field's value of the TelegramServiceMessage entity.
+// If the TelegramServiceMessage object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TelegramChannelStateMutation) OldChannelID(ctx context.Context) (v int64, err error) {
+func (m *TelegramServiceMessageMutation) OldMessageID(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldChannelID is only allowed on UpdateOne operations")
+		return v, errors.New("OldMessageID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldChannelID requires an ID field in the mutation")
+		return v, errors.New("OldMessageID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldChannelID: %w", err)
+		return v, fmt.Errorf("querying old value for OldMessageID: %w", err)
 	}
-	return oldValue.ChannelID, nil
+	return oldValue.MessageID, nil
 }
 
-// AddChannelID adds i to the "channel_id" field.
-func (m *TelegramChannelStateMutation) AddChannelID(i int64) {
-	if m.addchannel_id != nil {
-		*m.addchannel_id += i
+// AddMessageID adds i to the "message_id" field.
+func (m *TelegramServiceMessageMutation) AddMessageID(i int) {
+	if m.addmessage_id != nil {
+		*m.addmessage_id += i
 	} else {
-		m.addchannel_id = &i
+		m.addmessage_id = &i
 	}
 }
 
-// AddedChannelID returns the value that was added to the "channel_id" field in this mutation.
-func (m *TelegramChannelStateMutation) AddedChannelID() (r int64, exists bool) {
-	v := m.addchannel_id
+// AddedMessageID returns the value that was added to the "message_id" field in this mutation.
+func (m *TelegramServiceMessageMutation) AddedMessageID() (r int, exists bool) {
+	v := m.addmessage_id
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// ResetChannelID resets all changes to the "channel_id" field.
-func (m *TelegramChannelStateMutation) ResetChannelID() {
-	m.channel_id = nil
-	m.addchannel_id = nil
+// ResetMessageID resets all changes to the "message_id" field.
+func (m *TelegramServiceMessageMutation) ResetMessageID() {
+	m.message_id = nil
+	m.addmessage_id = nil
 }
 
-// SetUserID sets the "user_id" field.
-func (m *TelegramChannelStateMutation) SetUserID(i int64) {
-	m.user = &i
+// SetType sets the "type" field.
+func (m *TelegramServiceMessageMutation) SetType(t telegramservicemessage.Type) {
+	m._type = &t
 }
 
-// UserID returns the value of the "user_id" field in the mutation.
-func (m *TelegramChannelStateMutation) UserID() (r int64, exists bool) {
-	v := m.user
+// GetType returns the value of the "type" field in the mutation.
+func (m *TelegramServiceMessageMutation) GetType() (r telegramservicemessage.Type, exists bool) {
+	v := m._type
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldUserID returns the old "user_id" field's value of the TelegramChannelState entity.
-// If the TelegramChannelState object wasn't provided to the builder, the object is fetched from the database.
+// OldType returns the old "type" field's value of the TelegramServiceMessage entity.
+// If the TelegramServiceMessage object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TelegramChannelStateMutation) OldUserID(ctx context.Context) (v int64, err error) {
+func (m *TelegramServiceMessageMutation) OldType(ctx context.Context) (v telegramservicemessage.Type, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUserID is only allowed on UpdateOne operations")
+		return v, errors.New("OldType is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUserID requires an ID field in the mutation")
+		return v, errors.New("OldType requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUserID: %w", err)
+		return v, fmt.Errorf("querying old value for OldType: %w", err)
 	}
-	return oldValue.UserID, nil
+	return oldValue.Type, nil
 }
 
-// ResetUserID resets all changes to the "user_id" field.
-func (m *TelegramChannelStateMutation) ResetUserID() {
-	m.user = nil
+// ResetType resets all changes to the "type" field.
+func (m *TelegramServiceMessageMutation) ResetType() {
+	m._type = nil
 }
 
-// SetPts sets the "pts" field.
-func (m *TelegramChannelStateMutation) SetPts(i int) {
-	m.pts = &i
-	m.addpts = nil
+// SetText sets the "text" field.
+func (m *TelegramServiceMessageMutation) SetText(s string) {
+	m.text = &s
 }
 
-// Pts returns the value of the "pts" field in the mutation.
-func (m *TelegramChannelStateMutation) Pts() (r int, exists bool) {
-	v := m.pts
+// Text returns the value of the "text" field in the mutation.
+func (m *TelegramServiceMessageMutation) Text() (r string, exists bool) {
+	v := m.text
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldPts returns the old "pts" field's value of the TelegramChannelState entity.
-// If the TelegramChannelState object wasn't provided to the builder, the object is fetched from the database.
+// OldText returns the old "text" field's value of the TelegramServiceMessage entity.
+// If the TelegramServiceMessage object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TelegramChannelStateMutation) OldPts(ctx context.Context) (v int, err error) {
+func (m *TelegramServiceMessageMutation) OldText(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldPts is only allowed on UpdateOne operations")
+		return v, errors.New("OldText is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldPts requires an ID field in the mutation")
+		return v, errors.New("OldText requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldPts: %w", err)
+		return v, fmt.Errorf("querying old value for OldText: %w", err)
 	}
-	return oldValue.Pts, nil
+	return oldValue.Text, nil
 }
 
-// AddPts adds i to the "pts" field.
-func (m *TelegramChannelStateMutation) AddPts(i int) {
-	if m.addpts != nil {
-		*m.addpts += i
-	} else {
-		m.addpts = &i
-	}
+// ResetText resets all changes to the "text" field.
+func (m *TelegramServiceMessageMutation) ResetText() {
+	m.text = nil
 }
 
-// AddedPts returns the value that was added to the "pts" field in this mutation.
-func (m *TelegramChannelStateMutation) AddedPts() (r int, exists bool) {
-	v := m.addpts
+// SetDate sets the "date" field.
+func (m *TelegramServiceMessageMutation) SetDate(t time.Time) {
+	m.date = &t
+}
+
+// Date returns the value of the "date" field in the mutation.
+func (m *TelegramServiceMessageMutation) Date() (r time.Time, exists bool) {
+	v := m.date
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// ResetPts resets all changes to the "pts" field.
-func (m *TelegramChannelStateMutation) ResetPts() {
-	m.pts = nil
-	m.addpts = nil
+// OldDate returns the old "date" field's value of the TelegramServiceMessage entity.
+// If the TelegramServiceMessage object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TelegramServiceMessageMutation) OldDate(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDate is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDate requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDate: %w", err)
+	}
+	return oldValue.Date, nil
 }
 
-// ClearUser clears the "user" edge to the TelegramUserState entity.
-func (m *TelegramChannelStateMutation) ClearUser() {
-	m.cleareduser = true
-	m.clearedFields[telegramchannelstate.FieldUserID] = struct{}{}
+// ResetDate resets all changes to the "date" field.
+func (m *TelegramServiceMessageMutation) ResetDate() {
+	m.date = nil
 }
 
-// UserCleared reports if the "user" edge to the TelegramUserState entity was cleared.
-func (m *TelegramChannelStateMutation) UserCleared() bool {
-	return m.cleareduser
+// ClearAccount clears the "account" edge to the TelegramAccount entity.
+func (m *TelegramServiceMessageMutation) ClearAccount() {
+	m.clearedaccount = true
+	m.clearedFields[telegramservicemessage.FieldAccountID] = struct{}{}
 }
 
-// UserIDs returns the "user" edge IDs in the mutation.
+// AccountCleared reports if the "account" edge to the TelegramAccount entity was cleared.
+func (m *TelegramServiceMessageMutation) AccountCleared() bool {
+	return m.clearedaccount
+}
+
+// AccountIDs returns the "account" edge IDs in the mutation.
 // Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// UserID instead. It exists only for internal usage by the builders.
-func (m *TelegramChannelStateMutation) UserIDs() (ids []int64) {
-	if id := m.user; id != nil {
+// AccountID instead. It exists only for internal usage by the builders.
+func (m *TelegramServiceMessageMutation) AccountIDs() (ids []string) {
+	if id := m.account; id != nil {
 		ids = append(ids, *id)
 	}
 	return
 }
 
-// ResetUser resets all changes to the "user" edge.
-func (m *TelegramChannelStateMutation) ResetUser() {
-	m.user = nil
-	m.cleareduser = false
+// ResetAccount resets all changes to the "account" edge.
+func (m *TelegramServiceMessageMutation) ResetAccount() {
+	m.account = nil
+	m.clearedaccount = false
 }
 
-// Where appends a list predicates to the TelegramChannelStateMutation builder.
-func (m *TelegramChannelStateMutation) Where(ps ...predicate.TelegramChannelState) {
+// Where appends a list predicates to the TelegramServiceMessageMutation builder.
+func (m *TelegramServiceMessageMutation) Where(ps ...predicate.TelegramServiceMessage) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the TelegramChannelStateMutation builder. Using this method,
+// WhereP appends storage-level predicates to the TelegramServiceMessageMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *TelegramChannelStateMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.TelegramChannelState, len(ps))
+func (m *TelegramServiceMessageMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.TelegramServiceMessage, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -2019,33 +3997,39 @@ func (m *TelegramChannelStateMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *TelegramChannelStateMutation) Op() Op {
+func (m *TelegramServiceMessageMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *TelegramChannelStateMutation) SetOp(op Op) {
+func (m *TelegramServiceMessageMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (TelegramChannelState).
-func (m *TelegramChannelStateMutation) Type() string {
+// Type returns the node type of this mutation (TelegramServiceMessage).
+func (m *TelegramServiceMessageMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *TelegramChannelStateMutation) Fields() []string {
-	fields := make([]string, 0, 3)
-	if m.channel_id != nil {
-		fields = append(fields, telegramchannelstate.FieldChannelID)
+func (m *TelegramServiceMessageMutation) Fields() []string {
+	fields := make([]string, 0, 5)
+	if m.account != nil {
+		fields = append(fields, telegramservicemessage.FieldAccountID)
 	}
-	if m.user != nil {
-		fields = append(fields, telegramchannelstate.FieldUserID)
+	if m.message_id != nil {
+		fields = append(fields, telegramservicemessage.FieldMessageID)
 	}
-	if m.pts != nil {
-		fields = append(fields, telegramchannelstate.FieldPts)
+	if m._type != nil {
+		fields = append(fields, telegramservicemessage.FieldType)
+	}
+	if m.text != nil {
+		fields = append(fields, telegramservicemessage.FieldText)
+	}
+	if m.date != nil {
+		fields = append(fields, telegramservicemessage.FieldDate)
 	}
 	return fields
 }
@@ -2053,14 +4037,18 @@ func (m *TelegramChannelStateMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *TelegramChannelStateMutation) Field(name string) (ent.Value, bool) {
+func (m *TelegramServiceMessageMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case telegramchannelstate.FieldChannelID:
-		return m.ChannelID()
-	case telegramchannelstate.FieldUserID:
-		return m.UserID()
-	case telegramchannelstate.FieldPts:
-		return m.Pts()
+	case telegramservicemessage.FieldAccountID:
+		return m.AccountID()
+	case telegramservicemessage.FieldMessageID:
+		return m.MessageID()
+	case telegramservicemessage.FieldType:
+		return m.GetType()
+	case telegramservicemessage.FieldText:
+		return m.Text()
+	case telegramservicemessage.FieldDate:
+		return m.Date()
 	}
 	return nil, false
 }
@@ -2068,57 +4056,72 @@ func (m *TelegramChannelStateMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *TelegramChannelStateMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *TelegramServiceMessageMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case telegramchannelstate.FieldChannelID:
-		return m.OldChannelID(ctx)
-	case telegramchannelstate.FieldUserID:
-		return m.OldUserID(ctx)
-	case telegramchannelstate.FieldPts:
-		return m.OldPts(ctx)
+	case telegramservicemessage.FieldAccountID:
+		return m.OldAccountID(ctx)
+	case telegramservicemessage.FieldMessageID:
+		return m.OldMessageID(ctx)
+	case telegramservicemessage.FieldType:
+		return m.OldType(ctx)
+	case telegramservicemessage.FieldText:
+		return m.OldText(ctx)
+	case telegramservicemessage.FieldDate:
+		return m.OldDate(ctx)
 	}
-	return nil, fmt.Errorf("unknown TelegramChannelState field %s", name)
+	return nil, fmt.Errorf("unknown TelegramServiceMessage field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *TelegramChannelStateMutation) SetField(name string, value ent.Value) error {
+func (m *TelegramServiceMessageMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case telegramchannelstate.FieldChannelID:
-		v, ok := value.(int64)
+	case telegramservicemessage.FieldAccountID:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetChannelID(v)
+		m.SetAccountID(v)
 		return nil
-	case telegramchannelstate.FieldUserID:
-		v, ok := value.(int64)
+	case telegramservicemessage.FieldMessageID:
+		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetUserID(v)
+		m.SetMessageID(v)
 		return nil
-	case telegramchannelstate.FieldPts:
-		v, ok := value.(int)
+	case telegramservicemessage.FieldType:
+		v, ok := value.(telegramservicemessage.Type)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetPts(v)
+		m.SetType(v)
+		return nil
+	case telegramservicemessage.FieldText:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetText(v)
+		return nil
+	case telegramservicemessage.FieldDate:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDate(v)
 		return nil
 	}
-	return fmt.Errorf("unknown TelegramChannelState field %s", name)
+	return fmt.Errorf("unknown TelegramServiceMessage field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *TelegramChannelStateMutation) AddedFields() []string {
+func (m *TelegramServiceMessageMutation) AddedFields() []string {
 	var fields []string
-	if m.addchannel_id != nil {
-		fields = append(fields, telegramchannelstate.FieldChannelID)
-	}
-	if m.addpts != nil {
-		fields = append(fields, telegramchannelstate.FieldPts)
+	if m.addmessage_id != nil {
+		fields = append(fields, telegramservicemessage.FieldMessageID)
 	}
 	return fields
 }
@@ -2126,12 +4129,10 @@ func (m *TelegramChannelStateMutation) AddedFields() []string {
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *TelegramChannelStateMutation) AddedField(name string) (ent.Value, bool) {
+func (m *TelegramServiceMessageMutation) AddedField(name string) (ent.Value, bool) {
 	switch name {
-	case telegramchannelstate.FieldChannelID:
-		return m.AddedChannelID()
-	case telegramchannelstate.FieldPts:
-		return m.AddedPts()
+	case telegramservicemessage.FieldMessageID:
+		return m.AddedMessageID()
 	}
 	return nil, false
 }
@@ -2139,77 +4140,76 @@ func (m *TelegramChannelStateMutation) AddedField(name string) (ent.Value, bool)
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *TelegramChannelStateMutation) AddField(name string, value ent.Value) error {
+func (m *TelegramServiceMessageMutation) AddField(name string, value ent.Value) error {
 	switch name {
-	case telegramchannelstate.FieldChannelID:
-		v, ok := value.(int64)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddChannelID(v)
-		return nil
-	case telegramchannelstate.FieldPts:
+	case telegramservicemessage.FieldMessageID:
 		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.AddPts(v)
+		m.AddMessageID(v)
 		return nil
 	}
-	return fmt.Errorf("unknown TelegramChannelState numeric field %s", name)
+	return fmt.Errorf("unknown TelegramServiceMessage numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *TelegramChannelStateMutation) ClearedFields() []string {
+func (m *TelegramServiceMessageMutation) ClearedFields() []string {
 	return nil
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *TelegramChannelStateMutation) FieldCleared(name string) bool {
+func (m *TelegramServiceMessageMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *TelegramChannelStateMutation) ClearField(name string) error {
-	return fmt.Errorf("unknown TelegramChannelState nullable field %s", name)
+func (m *TelegramServiceMessageMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown TelegramServiceMessage nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *TelegramChannelStateMutation) ResetField(name string) error {
+func (m *TelegramServiceMessageMutation) ResetField(name string) error {
 	switch name {
-	case telegramchannelstate.FieldChannelID:
-		m.ResetChannelID()
+	case telegramservicemessage.FieldAccountID:
+		m.ResetAccountID()
 		return nil
-	case telegramchannelstate.FieldUserID:
-		m.ResetUserID()
+	case telegramservicemessage.FieldMessageID:
+		m.ResetMessageID()
 		return nil
-	case telegramchannelstate.FieldPts:
-		m.ResetPts()
+	case telegramservicemessage.FieldType:
+		m.ResetType()
+		return nil
+	case telegramservicemessage.FieldText:
+		m.ResetText()
+		return nil
+	case telegramservicemessage.FieldDate:
+		m.ResetDate()
 		return nil
 	}
-	return fmt.Errorf("unknown TelegramChannelState field %s", name)
+	return fmt.Errorf("unknown TelegramServiceMessage field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *TelegramChannelStateMutation) AddedEdges() []string {
+func (m *TelegramServiceMessageMutation) AddedEdges() []string {
 	edges := make([]string, 0, 1)
-	if m.user != nil {
-		edges = append(edges, telegramchannelstate.EdgeUser)
+	if m.account != nil {
+		edges = append(edges, telegramservicemessage.EdgeAccount)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *TelegramChannelStateMutation) AddedIDs(name string) []ent.Value {
+func (m *TelegramServiceMessageMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case telegramchannelstate.EdgeUser:
-		if id := m.user; id != nil {
+	case telegramservicemessage.EdgeAccount:
+		if id := m.account; id != nil {
 			return []ent.Value{*id}
 		}
 	}
@@ -2217,56 +4217,56 @@ func (m *TelegramChannelStateMutation) AddedIDs(name string) []ent.Value {
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *TelegramChannelStateMutation) RemovedEdges() []string {
+func (m *TelegramServiceMessageMutation) RemovedEdges() []string {
 	edges := make([]string, 0, 1)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *TelegramChannelStateMutation) RemovedIDs(name string) []ent.Value {
+func (m *TelegramServiceMessageMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *TelegramChannelStateMutation) ClearedEdges() []string {
+func (m *TelegramServiceMessageMutation) ClearedEdges() []string {
 	edges := make([]string, 0, 1)
-	if m.cleareduser {
-		edges = append(edges, telegramchannelstate.EdgeUser)
+	if m.clearedaccount {
+		edges = append(edges, telegramservicemessage.EdgeAccount)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *TelegramChannelStateMutation) EdgeCleared(name string) bool {
+func (m *TelegramServiceMessageMutation) EdgeCleared(name string) bool {
 	switch name {
-	case telegramchannelstate.EdgeUser:
-		return m.cleareduser
+	case telegramservicemessage.EdgeAccount:
+		return m.clearedaccount
 	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *TelegramChannelStateMutation) ClearEdge(name string) error {
+func (m *TelegramServiceMessageMutation) ClearEdge(name string) error {
 	switch name {
-	case telegramchannelstate.EdgeUser:
-		m.ClearUser()
+	case telegramservicemessage.EdgeAccount:
+		m.ClearAccount()
 		return nil
 	}
-	return fmt.Errorf("unknown TelegramChannelState unique edge %s", name)
+	return fmt.Errorf("unknown TelegramServiceMessage unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *TelegramChannelStateMutation) ResetEdge(name string) error {
+func (m *TelegramServiceMessageMutation) ResetEdge(name string) error {
 	switch name {
-	case telegramchannelstate.EdgeUser:
-		m.ResetUser()
+	case telegramservicemessage.EdgeAccount:
+		m.ResetAccount()
 		return nil
 	}
-	return fmt.Errorf("unknown TelegramChannelState edge %s", name)
+	return fmt.Errorf("unknown TelegramServiceMessage edge %s", name)
 }
 
 // TelegramSessionMutation represents an operation that mutates the TelegramSession nodes in the graph.
@@ -6,6 +6,9 @@ import (
 	"entgo.io/ent/dialect/sql"
 )
 
+// FileIDCheck is the predicate function for fileidcheck builders.
+type FileIDCheck func(*sql.Selector)
+
 // LastChannelMessage is the predicate function for lastchannelmessage builders.
 type LastChannelMessage func(*sql.Selector)
 
@@ -18,6 +21,9 @@ type TelegramAccount func(*sql.Selector)
 // TelegramChannelState is the predicate function for telegramchannelstate builders.
 type TelegramChannelState func(*sql.Selector)
 
+// TelegramServiceMessage is the predicate function for telegramservicemessage builders.
+type TelegramServiceMessage func(*sql.Selector)
+
 // TelegramSession is the predicate function for telegramsession builders.
 type TelegramSession func(*sql.Selector)
 
@@ -3,8 +3,12 @@
 package ent
 
 import (
+	"time"
+
+	"github.com/gotd/bot/internal/ent/fileidcheck"
 	"github.com/gotd/bot/internal/ent/prnotification"
 	"github.com/gotd/bot/internal/ent/schema"
+	"github.com/gotd/bot/internal/ent/telegramaccount"
 	"github.com/gotd/bot/internal/ent/telegramchannelstate"
 	"github.com/gotd/bot/internal/ent/telegramuserstate"
 )
@@ -13,6 +17,12 @@ import (
 // (default values, validators, hooks and policies) and stitches it
 // to their package variables.
 func init() {
+	fileidcheckFields := schema.FileIDCheck{}.Fields()
+	_ = fileidcheckFields
+	// fileidcheckDescCreatedAt is the schema descriptor for created_at field.
+	fileidcheckDescCreatedAt := fileidcheckFields[7].Descriptor()
+	// fileidcheck.DefaultCreatedAt holds the default value on creation for the created_at field.
+	fileidcheck.DefaultCreatedAt = fileidcheckDescCreatedAt.Default.(func() time.Time)
 	prnotificationFields := schema.PRNotification{}.Fields()
 	_ = prnotificationFields
 	// prnotificationDescPullRequestTitle is the schema descriptor for pull_request_title field.
@@ -29,6 +39,10 @@ func init() {
 	prnotification.DefaultPullRequestAuthorLogin = prnotificationDescPullRequestAuthorLogin.Default.(string)
 	telegramaccountFields := schema.TelegramAccount{}.Fields()
 	_ = telegramaccountFields
+	// telegramaccountDescDisabled is the schema descriptor for disabled field.
+	telegramaccountDescDisabled := telegramaccountFields[7].Descriptor()
+	// telegramaccount.DefaultDisabled holds the default value on creation for the disabled field.
+	telegramaccount.DefaultDisabled = telegramaccountDescDisabled.Default.(bool)
 	telegramchannelstateFields := schema.TelegramChannelState{}.Fields()
 	_ = telegramchannelstateFields
 	// telegramchannelstateDescPts is the schema descriptor for pts field.
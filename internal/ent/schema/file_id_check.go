@@ -0,0 +1,43 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// FileIDCheck is result of file_id round-trip check against BotAPI.
+type FileIDCheck struct {
+	ent.Schema
+}
+
+func (FileIDCheck) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("type").Comment("File type, e.g. Sticker"),
+		field.Int("dc").Comment("DC of file"),
+		field.String("file_id").
+			Optional().
+			Comment("file_id encoded by gotd/td"),
+		field.String("bot_api_file_id").
+			Optional().
+			Comment("file_id of the same file returned by BotAPI"),
+		field.Enum("result").
+			Values("OK", "EncodeError", "Rejected", "Mismatch"),
+		field.String("error").
+			Optional().
+			Comment("Error or mismatch details"),
+		field.String("td_version").Comment("Version of gotd/td"),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+	}
+}
+
+func (FileIDCheck) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("type", "created_at"),
+		index.Fields("type", "td_version"),
+	}
+}
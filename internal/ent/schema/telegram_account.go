@@ -2,6 +2,8 @@ package schema
 
 import (
 	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
 )
 
@@ -24,9 +26,37 @@ func (TelegramAccount) Fields() []ent.Field {
 		field.Bytes("session_data").
 			Optional().
 			Nillable(),
+		field.Bytes("password").
+			Optional().
+			Nillable().
+			Sensitive().
+			Comment("Encrypted cloud password (2FA)"),
+		field.Bool("disabled").
+			Default(false).
+			Comment("Disabled accounts are not started and can't be leased"),
+		field.Enum("dc_list").
+			Values("Test", "Production").
+			Default("Test").
+			Comment("DC list to connect to"),
+		field.Int("dc").
+			Optional().
+			Nillable().
+			Comment("DC to connect to first, e.g. home DC of account"),
+		field.Int("app_id").
+			Optional().
+			Nillable().
+			Comment("Telegram app ID, Telegram Desktop is used if not set"),
+		field.String("app_hash").
+			Optional().
+			Nillable().
+			Sensitive().
+			Comment("Telegram app hash, required if app_id is set"),
 	}
 }
 
 func (TelegramAccount) Edges() []ent.Edge {
-	return []ent.Edge{}
+	return []ent.Edge{
+		edge.To("service_messages", TelegramServiceMessage.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+	}
 }
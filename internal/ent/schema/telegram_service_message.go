@@ -0,0 +1,43 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// TelegramServiceMessage is message from Telegram service notifications
+// (user 777000) received by test account.
+type TelegramServiceMessage struct {
+	ent.Schema
+}
+
+func (TelegramServiceMessage) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("account_id").Comment("Phone number of account without +"),
+		field.Int("message_id").Comment("Telegram message ID"),
+		field.Enum("type").
+			Values("Code", "NewLogin", "EmailVerification", "Frozen", "Other").
+			Comment("Parsed message type"),
+		field.String("text"),
+		field.Time("date").Comment("Message date"),
+	}
+}
+
+func (TelegramServiceMessage) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("account_id", "message_id").Unique(),
+		index.Fields("account_id", "date"),
+	}
+}
+
+func (TelegramServiceMessage) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("account", TelegramAccount.Type).
+			Ref("service_messages").
+			Field("account_id").
+			Unique().
+			Required(),
+	}
+}
@@ -27,18 +27,56 @@ type TelegramAccount struct {
 	// Status holds the value of the "status" field.
 	Status string `json:"status,omitempty"`
 	// SessionData holds the value of the "session_data" field.
-	SessionData  *[]byte `json:"session_data,omitempty"`
+	SessionData *[]byte `json:"session_data,omitempty"`
+	// Encrypted cloud password (2FA)
+	Password *[]byte `json:"-"`
+	// Disabled accounts are not started and can't be leased
+	Disabled bool `json:"disabled,omitempty"`
+	// DC list to connect to
+	DcList telegramaccount.DcList `json:"dc_list,omitempty"`
+	// DC to connect to first, e.g. home DC of account
+	Dc *int `json:"dc,omitempty"`
+	// Telegram app ID, Telegram Desktop is used if not set
+	AppID *int `json:"app_id,omitempty"`
+	// Telegram app hash, required if app_id is set
+	AppHash *string `json:"-"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the TelegramAccountQuery when eager-loading is set.
+	Edges        TelegramAccountEdges `json:"edges"`
 	selectValues sql.SelectValues
 }
 
+// TelegramAccountEdges holds the relations/edges for other nodes in the graph.
+type TelegramAccountEdges struct {
+	// ServiceMessages holds the value of the service_messages edge.
+	ServiceMessages []*TelegramServiceMessage `json:"service_messages,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes          [1]bool
+	namedServiceMessages map[string][]*TelegramServiceMessage
+}
+
+// ServiceMessagesOrErr returns the ServiceMessages value or an error if the edge
+// was not loaded in eager-loading.
+func (e TelegramAccountEdges) ServiceMessagesOrErr() ([]*TelegramServiceMessage, error) {
+	if e.loadedTypes[0] {
+		return e.ServiceMessages, nil
+	}
+	return nil, &NotLoadedError{edge: "service_messages"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*TelegramAccount) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case telegramaccount.FieldSessionData:
+		case telegramaccount.FieldSessionData, telegramaccount.FieldPassword:
 			values[i] = new([]byte)
-		case telegramaccount.FieldID, telegramaccount.FieldCode, telegramaccount.FieldState, telegramaccount.FieldStatus:
+		case telegramaccount.FieldDisabled:
+			values[i] = new(sql.NullBool)
+		case telegramaccount.FieldDc, telegramaccount.FieldAppID:
+			values[i] = new(sql.NullInt64)
+		case telegramaccount.FieldID, telegramaccount.FieldCode, telegramaccount.FieldState, telegramaccount.FieldStatus, telegramaccount.FieldDcList, telegramaccount.FieldAppHash:
 			values[i] = new(sql.NullString)
 		case telegramaccount.FieldCodeAt:
 			values[i] = new(sql.NullTime)
@@ -95,6 +133,45 @@ func (ta *TelegramAccount) assignValues(columns []string, values []any) error {
 			} else if value != nil {
 				ta.SessionData = value
 			}
+		case telegramaccount.FieldPassword:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field password", values[i])
+			} else if value != nil {
+				ta.Password = value
+			}
+		case telegramaccount.FieldDisabled:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field disabled", values[i])
+			} else if value.Valid {
+				ta.Disabled = value.Bool
+			}
+		case telegramaccount.FieldDcList:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field dc_list", values[i])
+			} else if value.Valid {
+				ta.DcList = telegramaccount.DcList(value.String)
+			}
+		case telegramaccount.FieldDc:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field dc", values[i])
+			} else if value.Valid {
+				ta.Dc = new(int)
+				*ta.Dc = int(value.Int64)
+			}
+		case telegramaccount.FieldAppID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field app_id", values[i])
+			} else if value.Valid {
+				ta.AppID = new(int)
+				*ta.AppID = int(value.Int64)
+			}
+		case telegramaccount.FieldAppHash:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field app_hash", values[i])
+			} else if value.Valid {
+				ta.AppHash = new(string)
+				*ta.AppHash = value.String
+			}
 		default:
 			ta.selectValues.Set(columns[i], values[i])
 		}
@@ -108,6 +185,11 @@ func (ta *TelegramAccount) Value(name string) (ent.Value, error) {
 	return ta.selectValues.Get(name)
 }
 
+// QueryServiceMessages queries the "service_messages" edge of the TelegramAccount entity.
+func (ta *TelegramAccount) QueryServiceMessages() *TelegramServiceMessageQuery {
+	return NewTelegramAccountClient(ta.config).QueryServiceMessages(ta)
+}
+
 // Update returns a builder for updating this TelegramAccount.
 // Note that you need to call TelegramAccount.Unwrap() before calling this method if this TelegramAccount
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -151,9 +233,53 @@ func (ta *TelegramAccount) String() string {
 		builder.WriteString("session_data=")
 		builder.WriteString(fmt.Sprintf("%v", *v))
 	}
+	builder.WriteString(", ")
+	builder.WriteString("password=<sensitive>")
+	builder.WriteString(", ")
+	builder.WriteString("disabled=")
+	builder.WriteString(fmt.Sprintf("%v", ta.Disabled))
+	builder.WriteString(", ")
+	builder.WriteString("dc_list=")
+	builder.WriteString(fmt.Sprintf("%v", ta.DcList))
+	builder.WriteString(", ")
+	if v := ta.Dc; v != nil {
+		builder.WriteString("dc=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := ta.AppID; v != nil {
+		builder.WriteString("app_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("app_hash=<sensitive>")
 	builder.WriteByte(')')
 	return builder.String()
 }
 
+// NamedServiceMessages returns the ServiceMessages named value or an error if the edge was not
+// loaded in eager-loading with this name.
+func (ta *TelegramAccount) NamedServiceMessages(name string) ([]*TelegramServiceMessage, error) {
+	if ta.Edges.namedServiceMessages == nil {
+		return nil, &NotLoadedError{edge: name}
+	}
+	nodes, ok := ta.Edges.namedServiceMessages[name]
+	if !ok {
+		return nil, &NotLoadedError{edge: name}
+	}
+	return nodes, nil
+}
+
+func (ta *TelegramAccount) appendNamedServiceMessages(name string, edges ...*TelegramServiceMessage) {
+	if ta.Edges.namedServiceMessages == nil {
+		ta.Edges.namedServiceMessages = make(map[string][]*TelegramServiceMessage)
+	}
+	if len(edges) == 0 {
+		ta.Edges.namedServiceMessages[name] = []*TelegramServiceMessage{}
+	} else {
+		ta.Edges.namedServiceMessages[name] = append(ta.Edges.namedServiceMessages[name], edges...)
+	}
+}
+
 // TelegramAccounts is a parsable slice of TelegramAccount.
 type TelegramAccounts []*TelegramAccount
@@ -6,6 +6,7 @@ import (
 	"fmt"
 
 	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
 )
 
 const (
@@ -23,8 +24,29 @@ const (
 	FieldStatus = "status"
 	// FieldSessionData holds the string denoting the session_data field in the database.
 	FieldSessionData = "session_data"
+	// FieldPassword holds the string denoting the password field in the database.
+	FieldPassword = "password"
+	// FieldDisabled holds the string denoting the disabled field in the database.
+	FieldDisabled = "disabled"
+	// FieldDcList holds the string denoting the dc_list field in the database.
+	FieldDcList = "dc_list"
+	// FieldDc holds the string denoting the dc field in the database.
+	FieldDc = "dc"
+	// FieldAppID holds the string denoting the app_id field in the database.
+	FieldAppID = "app_id"
+	// FieldAppHash holds the string denoting the app_hash field in the database.
+	FieldAppHash = "app_hash"
+	// EdgeServiceMessages holds the string denoting the service_messages edge name in mutations.
+	EdgeServiceMessages = "service_messages"
 	// Table holds the table name of the telegramaccount in the database.
 	Table = "telegram_accounts"
+	// ServiceMessagesTable is the table that holds the service_messages relation/edge.
+	ServiceMessagesTable = "telegram_service_messages"
+	// ServiceMessagesInverseTable is the table name for the TelegramServiceMessage entity.
+	// It exists in this package in order to avoid circular dependency with the "telegramservicemessage" package.
+	ServiceMessagesInverseTable = "telegram_service_messages"
+	// ServiceMessagesColumn is the table column denoting the service_messages relation/edge.
+	ServiceMessagesColumn = "account_id"
 )
 
 // Columns holds all SQL columns for telegramaccount fields.
@@ -35,6 +57,12 @@ var Columns = []string{
 	FieldState,
 	FieldStatus,
 	FieldSessionData,
+	FieldPassword,
+	FieldDisabled,
+	FieldDcList,
+	FieldDc,
+	FieldAppID,
+	FieldAppHash,
 }
 
 // ValidColumn reports if the column name is valid (part of the table columns).
@@ -47,6 +75,11 @@ func ValidColumn(column string) bool {
 	return false
 }
 
+var (
+	// DefaultDisabled holds the default value on creation for the "disabled" field.
+	DefaultDisabled bool
+)
+
 // State defines the type for the "state" enum field.
 type State string
 
@@ -75,6 +108,32 @@ func StateValidator(s State) error {
 	}
 }
 
+// DcList defines the type for the "dc_list" enum field.
+type DcList string
+
+// DcListTest is the default value of the DcList enum.
+const DefaultDcList = DcListTest
+
+// DcList values.
+const (
+	DcListTest       DcList = "Test"
+	DcListProduction DcList = "Production"
+)
+
+func (dl DcList) String() string {
+	return string(dl)
+}
+
+// DcListValidator is a validator for the "dc_list" field enum values. It is called by the builders before save.
+func DcListValidator(dl DcList) error {
+	switch dl {
+	case DcListTest, DcListProduction:
+		return nil
+	default:
+		return fmt.Errorf("telegramaccount: invalid enum value for dc_list field: %q", dl)
+	}
+}
+
 // OrderOption defines the ordering options for the TelegramAccount queries.
 type OrderOption func(*sql.Selector)
 
@@ -102,3 +161,49 @@ func ByState(opts ...sql.OrderTermOption) OrderOption {
 func ByStatus(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldStatus, opts...).ToFunc()
 }
+
+// ByDisabled orders the results by the disabled field.
+func ByDisabled(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDisabled, opts...).ToFunc()
+}
+
+// ByDcList orders the results by the dc_list field.
+func ByDcList(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDcList, opts...).ToFunc()
+}
+
+// ByDc orders the results by the dc field.
+func ByDc(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDc, opts...).ToFunc()
+}
+
+// ByAppID orders the results by the app_id field.
+func ByAppID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAppID, opts...).ToFunc()
+}
+
+// ByAppHash orders the results by the app_hash field.
+func ByAppHash(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAppHash, opts...).ToFunc()
+}
+
+// ByServiceMessagesCount orders the results by service_messages count.
+func ByServiceMessagesCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newServiceMessagesStep(), opts...)
+	}
+}
+
+// ByServiceMessages orders the results by service_messages terms.
+func ByServiceMessages(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newServiceMessagesStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+func newServiceMessagesStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(ServiceMessagesInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, ServiceMessagesTable, ServiceMessagesColumn),
+	)
+}
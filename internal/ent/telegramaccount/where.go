@@ -6,6 +6,7 @@ import (
 	"time"
 
 	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
 	"github.com/gotd/bot/internal/ent/predicate"
 )
 
@@ -84,6 +85,31 @@ func SessionData(v []byte) predicate.TelegramAccount {
 	return predicate.TelegramAccount(sql.FieldEQ(FieldSessionData, v))
 }
 
+// Password applies equality check predicate on the "password" field. It's identical to PasswordEQ.
+func Password(v []byte) predicate.TelegramAccount {
+	return predicate.TelegramAccount(sql.FieldEQ(FieldPassword, v))
+}
+
+// Disabled applies equality check predicate on the "disabled" field. It's identical to DisabledEQ.
+func Disabled(v bool) predicate.TelegramAccount {
+	return predicate.TelegramAccount(sql.FieldEQ(FieldDisabled, v))
+}
+
+// Dc applies equality check predicate on the "dc" field. It's identical to DcEQ.
+func Dc(v int) predicate.TelegramAccount {
+	return predicate.TelegramAccount(sql.FieldEQ(FieldDc, v))
+}
+
+// AppID applies equality check predicate on the "app_id" field. It's identical to AppIDEQ.
+func AppID(v int) predicate.TelegramAccount {
+	return predicate.TelegramAccount(sql.FieldEQ(FieldAppID, v))
+}
+
+// AppHash applies equality check predicate on the "app_hash" field. It's identical to AppHashEQ.
+func AppHash(v string) predicate.TelegramAccount {
+	return predicate.TelegramAccount(sql.FieldEQ(FieldAppHash, v))
+}
+
 // CodeEQ applies the EQ predicate on the "code" field.
 func CodeEQ(v string) predicate.TelegramAccount {
 	return predicate.TelegramAccount(sql.FieldEQ(FieldCode, v))
@@ -344,6 +370,284 @@ func SessionDataNotNil() predicate.TelegramAccount {
 	return predicate.TelegramAccount(sql.FieldNotNull(FieldSessionData))
 }
 
+// PasswordEQ applies the EQ predicate on the "password" field.
+func PasswordEQ(v []byte) predicate.TelegramAccount {
+	return predicate.TelegramAccount(sql.FieldEQ(FieldPassword, v))
+}
+
+// PasswordNEQ applies the NEQ predicate on the "password" field.
+func PasswordNEQ(v []byte) predicate.TelegramAccount {
+	return predicate.TelegramAccount(sql.FieldNEQ(FieldPassword, v))
+}
+
+// PasswordIn applies the In predicate on the "password" field.
+func PasswordIn(vs ...[]byte) predicate.TelegramAccount {
+	return predicate.TelegramAccount(sql.FieldIn(FieldPassword, vs...))
+}
+
+// PasswordNotIn applies the NotIn predicate on the "password" field.
+func PasswordNotIn(vs ...[]byte) predicate.TelegramAccount {
+	return predicate.TelegramAccount(sql.FieldNotIn(FieldPassword, vs...))
+}
+
+// PasswordGT applies the GT predicate on the "password" field.
+func PasswordGT(v []byte) predicate.TelegramAccount {
+	return predicate.TelegramAccount(sql.FieldGT(FieldPassword, v))
+}
+
+// PasswordGTE applies the GTE predicate on the "password" field.
+func PasswordGTE(v []byte) predicate.TelegramAccount {
+	return predicate.TelegramAccount(sql.FieldGTE(FieldPassword, v))
+}
+
+// PasswordLT applies the LT predicate on the "password" field.
+func PasswordLT(v []byte) predicate.TelegramAccount {
+	return predicate.TelegramAccount(sql.FieldLT(FieldPassword, v))
+}
+
+// PasswordLTE applies the LTE predicate on the "password" field.
+func PasswordLTE(v []byte) predicate.TelegramAccount {
+	return predicate.TelegramAccount(sql.FieldLTE(FieldPassword, v))
+}
+
+// PasswordIsNil applies the IsNil predicate on the "password" field.
+func PasswordIsNil() predicate.TelegramAccount {
+	return predicate.TelegramAccount(sql.FieldIsNull(FieldPassword))
+}
+
+// PasswordNotNil applies the NotNil predicate on the "password" field.
+func PasswordNotNil() predicate.TelegramAccount {
+	return predicate.TelegramAccount(sql.FieldNotNull(FieldPassword))
+}
+
+// DisabledEQ applies the EQ predicate on the "disabled" field.
+func DisabledEQ(v bool) predicate.TelegramAccount {
+	return predicate.TelegramAccount(sql.FieldEQ(FieldDisabled, v))
+}
+
+// DisabledNEQ applies the NEQ predicate on the "disabled" field.
+func DisabledNEQ(v bool) predicate.TelegramAccount {
+	return predicate.TelegramAccount(sql.FieldNEQ(FieldDisabled, v))
+}
+
+// DcListEQ applies the EQ predicate on the "dc_list" field.
+func DcListEQ(v DcList) predicate.TelegramAccount {
+	return predicate.TelegramAccount(sql.FieldEQ(FieldDcList, v))
+}
+
+// DcListNEQ applies the NEQ predicate on the "dc_list" field.
+func DcListNEQ(v DcList) predicate.TelegramAccount {
+	return predicate.TelegramAccount(sql.FieldNEQ(FieldDcList, v))
+}
+
+// DcListIn applies the In predicate on the "dc_list" field.
+func DcListIn(vs ...DcList) predicate.TelegramAccount {
+	return predicate.TelegramAccount(sql.FieldIn(FieldDcList, vs...))
+}
+
+// DcListNotIn applies the NotIn predicate on the "dc_list" field.
+func DcListNotIn(vs ...DcList) predicate.TelegramAccount {
+	return predicate.TelegramAccount(sql.FieldNotIn(FieldDcList, vs...))
+}
+
+// DcEQ applies the EQ predicate on the "dc" field.
+func DcEQ(v int) predicate.TelegramAccount {
+	return predicate.TelegramAccount(sql.FieldEQ(FieldDc, v))
+}
+
+// DcNEQ applies the NEQ predicate on the "dc" field.
+func DcNEQ(v int) predicate.TelegramAccount {
+	return predicate.TelegramAccount(sql.FieldNEQ(FieldDc, v))
+}
+
+// DcIn applies the In predicate on the "dc" field.
+func DcIn(vs ...int) predicate.TelegramAccount {
+	return predicate.TelegramAccount(sql.FieldIn(FieldDc, vs...))
+}
+
+// DcNotIn applies the NotIn predicate on the "dc" field.
+func DcNotIn(vs ...int) predicate.TelegramAccount {
+	return predicate.TelegramAccount(sql.FieldNotIn(FieldDc, vs...))
+}
+
+// DcGT applies the GT predicate on the "dc" field.
+func DcGT(v int) predicate.TelegramAccount {
+	return predicate.TelegramAccount(sql.FieldGT(FieldDc, v))
+}
+
+// DcGTE applies the GTE predicate on the "dc" field.
+func DcGTE(v int) predicate.TelegramAccount {
+	return predicate.TelegramAccount(sql.FieldGTE(FieldDc, v))
+}
+
+// DcLT applies the LT predicate on the "dc" field.
+func DcLT(v int) predicate.TelegramAccount {
+	return predicate.TelegramAccount(sql.FieldLT(FieldDc, v))
+}
+
+// DcLTE applies the LTE predicate on the "dc" field.
+func DcLTE(v int) predicate.TelegramAccount {
+	return predicate.TelegramAccount(sql.FieldLTE(FieldDc, v))
+}
+
+// DcIsNil applies the IsNil predicate on the "dc" field.
+func DcIsNil() predicate.TelegramAccount {
+	return predicate.TelegramAccount(sql.FieldIsNull(FieldDc))
+}
+
+// DcNotNil applies the NotNil predicate on the "dc" field.
+func DcNotNil() predicate.TelegramAccount {
+	return predicate.TelegramAccount(sql.FieldNotNull(FieldDc))
+}
+
+// AppIDEQ applies the EQ predicate on the "app_id" field.
+func AppIDEQ(v int) predicate.TelegramAccount {
+	return predicate.TelegramAccount(sql.FieldEQ(FieldAppID, v))
+}
+
+// AppIDNEQ applies the NEQ predicate on the "app_id" field.
+func AppIDNEQ(v int) predicate.TelegramAccount {
+	return predicate.TelegramAccount(sql.FieldNEQ(FieldAppID, v))
+}
+
+// AppIDIn applies the In predicate on the "app_id" field.
+func AppIDIn(vs ...int) predicate.TelegramAccount {
+	return predicate.TelegramAccount(sql.FieldIn(FieldAppID, vs...))
+}
+
+// AppIDNotIn applies the NotIn predicate on the "app_id" field.
+func AppIDNotIn(vs ...int) predicate.TelegramAccount {
+	return predicate.TelegramAccount(sql.FieldNotIn(FieldAppID, vs...))
+}
+
+// AppIDGT applies the GT predicate on the "app_id" field.
+func AppIDGT(v int) predicate.TelegramAccount {
+	return predicate.TelegramAccount(sql.FieldGT(FieldAppID, v))
+}
+
+// AppIDGTE applies the GTE predicate on the "app_id" field.
+func AppIDGTE(v int) predicate.TelegramAccount {
+	return predicate.TelegramAccount(sql.FieldGTE(FieldAppID, v))
+}
+
+// AppIDLT applies the LT predicate on the "app_id" field.
+func AppIDLT(v int) predicate.TelegramAccount {
+	return predicate.TelegramAccount(sql.FieldLT(FieldAppID, v))
+}
+
+// AppIDLTE applies the LTE predicate on the "app_id" field.
+func AppIDLTE(v int) predicate.TelegramAccount {
+	return predicate.TelegramAccount(sql.FieldLTE(FieldAppID, v))
+}
+
+// AppIDIsNil applies the IsNil predicate on the "app_id" field.
+func AppIDIsNil() predicate.TelegramAccount {
+	return predicate.TelegramAccount(sql.FieldIsNull(FieldAppID))
+}
+
+// AppIDNotNil applies the NotNil predicate on the "app_id" field.
+func AppIDNotNil() predicate.TelegramAccount {
+	return predicate.TelegramAccount(sql.FieldNotNull(FieldAppID))
+}
+
+// AppHashEQ applies the EQ predicate on the "app_hash" field.
+func AppHashEQ(v string) predicate.TelegramAccount {
+	return predicate.TelegramAccount(sql.FieldEQ(FieldAppHash, v))
+}
+
+// AppHashNEQ applies the NEQ predicate on the "app_hash" field.
+func AppHashNEQ(v string) predicate.TelegramAccount {
+	return predicate.TelegramAccount(sql.FieldNEQ(FieldAppHash, v))
+}
+
+// AppHashIn applies the In predicate on the "app_hash" field.
+func AppHashIn(vs ...string) predicate.TelegramAccount {
+	return predicate.TelegramAccount(sql.FieldIn(FieldAppHash, vs...))
+}
+
+// AppHashNotIn applies the NotIn predicate on the "app_hash" field.
+func AppHashNotIn(vs ...string) predicate.TelegramAccount {
+	return predicate.TelegramAccount(sql.FieldNotIn(FieldAppHash, vs...))
+}
+
+// AppHashGT applies the GT predicate on the "app_hash" field.
+func AppHashGT(v string) predicate.TelegramAccount {
+	return predicate.TelegramAccount(sql.FieldGT(FieldAppHash, v))
+}
+
+// AppHashGTE applies the GTE predicate on the "app_hash" field.
+func AppHashGTE(v string) predicate.TelegramAccount {
+	return predicate.TelegramAccount(sql.FieldGTE(FieldAppHash, v))
+}
+
+// AppHashLT applies the LT predicate on the "app_hash" field.
+func AppHashLT(v string) predicate.TelegramAccount {
+	return predicate.TelegramAccount(sql.FieldLT(FieldAppHash, v))
+}
+
+// AppHashLTE applies the LTE predicate on the "app_hash" field.
+func AppHashLTE(v string) predicate.TelegramAccount {
+	return predicate.TelegramAccount(sql.FieldLTE(FieldAppHash, v))
+}
+
+// AppHashContains applies the Contains predicate on the "app_hash" field.
+func AppHashContains(v string) predicate.TelegramAccount {
+	return predicate.TelegramAccount(sql.FieldContains(FieldAppHash, v))
+}
+
+// AppHashHasPrefix applies the HasPrefix predicate on the "app_hash" field.
+func AppHashHasPrefix(v string) predicate.TelegramAccount {
+	return predicate.TelegramAccount(sql.FieldHasPrefix(FieldAppHash, v))
+}
+
+// AppHashHasSuffix applies the HasSuffix predicate on the "app_hash" field.
+func AppHashHasSuffix(v string) predicate.TelegramAccount {
+	return predicate.TelegramAccount(sql.FieldHasSuffix(FieldAppHash, v))
+}
+
+// AppHashIsNil applies the IsNil predicate on the "app_hash" field.
+func AppHashIsNil() predicate.TelegramAccount {
+	return predicate.TelegramAccount(sql.FieldIsNull(FieldAppHash))
+}
+
+// AppHashNotNil applies the NotNil predicate on the "app_hash" field.
+func AppHashNotNil() predicate.TelegramAccount {
+	return predicate.TelegramAccount(sql.FieldNotNull(FieldAppHash))
+}
+
+// AppHashEqualFold applies the EqualFold predicate on the "app_hash" field.
+func AppHashEqualFold(v string) predicate.TelegramAccount {
+	return predicate.TelegramAccount(sql.FieldEqualFold(FieldAppHash, v))
+}
+
+// AppHashContainsFold applies the ContainsFold predicate on the "app_hash" field.
+func AppHashContainsFold(v string) predicate.TelegramAccount {
+	return predicate.TelegramAccount(sql.FieldContainsFold(FieldAppHash, v))
+}
+
+// HasServiceMessages applies the HasEdge predicate on the "service_messages" edge.
+func HasServiceMessages() predicate.TelegramAccount {
+	return predicate.TelegramAccount(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, ServiceMessagesTable, ServiceMessagesColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasServiceMessagesWith applies the HasEdge predicate on the "service_messages" edge with a given conditions (other predicates).
+func HasServiceMessagesWith(preds ...predicate.TelegramServiceMessage) predicate.TelegramAccount {
+	return predicate.TelegramAccount(func(s *sql.Selector) {
+		step := newServiceMessagesStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.TelegramAccount) predicate.TelegramAccount {
 	return predicate.TelegramAccount(sql.AndPredicates(predicates...))
@@ -13,6 +13,7 @@ import (
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
 	"github.com/gotd/bot/internal/ent/telegramaccount"
+	"github.com/gotd/bot/internal/ent/telegramservicemessage"
 )
 
 // TelegramAccountCreate is the builder for creating a TelegramAccount entity.
@@ -77,12 +78,103 @@ func (tac *TelegramAccountCreate) SetSessionData(b []byte) *TelegramAccountCreat
 	return tac
 }
 
+// SetPassword sets the "password" field.
+func (tac *TelegramAccountCreate) SetPassword(b []byte) *TelegramAccountCreate {
+	tac.mutation.SetPassword(b)
+	return tac
+}
+
+// SetDisabled sets the "disabled" field.
+func (tac *TelegramAccountCreate) SetDisabled(b bool) *TelegramAccountCreate {
+	tac.mutation.SetDisabled(b)
+	return tac
+}
+
+// SetNillableDisabled sets the "disabled" field if the given value is not nil.
+func (tac *TelegramAccountCreate) SetNillableDisabled(b *bool) *TelegramAccountCreate {
+	if b != nil {
+		tac.SetDisabled(*b)
+	}
+	return tac
+}
+
+// SetDcList sets the "dc_list" field.
+func (tac *TelegramAccountCreate) SetDcList(tl telegramaccount.DcList) *TelegramAccountCreate {
+	tac.mutation.SetDcList(tl)
+	return tac
+}
+
+// SetNillableDcList sets the "dc_list" field if the given value is not nil.
+func (tac *TelegramAccountCreate) SetNillableDcList(tl *telegramaccount.DcList) *TelegramAccountCreate {
+	if tl != nil {
+		tac.SetDcList(*tl)
+	}
+	return tac
+}
+
+// SetDc sets the "dc" field.
+func (tac *TelegramAccountCreate) SetDc(i int) *TelegramAccountCreate {
+	tac.mutation.SetDc(i)
+	return tac
+}
+
+// SetNillableDc sets the "dc" field if the given value is not nil.
+func (tac *TelegramAccountCreate) SetNillableDc(i *int) *TelegramAccountCreate {
+	if i != nil {
+		tac.SetDc(*i)
+	}
+	return tac
+}
+
+// SetAppID sets the "app_id" field.
+func (tac *TelegramAccountCreate) SetAppID(i int) *TelegramAccountCreate {
+	tac.mutation.SetAppID(i)
+	return tac
+}
+
+// SetNillableAppID sets the "app_id" field if the given value is not nil.
+func (tac *TelegramAccountCreate) SetNillableAppID(i *int) *TelegramAccountCreate {
+	if i != nil {
+		tac.SetAppID(*i)
+	}
+	return tac
+}
+
+// SetAppHash sets the "app_hash" field.
+func (tac *TelegramAccountCreate) SetAppHash(s string) *TelegramAccountCreate {
+	tac.mutation.SetAppHash(s)
+	return tac
+}
+
+// SetNillableAppHash sets the "app_hash" field if the given value is not nil.
+func (tac *TelegramAccountCreate) SetNillableAppHash(s *string) *TelegramAccountCreate {
+	if s != nil {
+		tac.SetAppHash(*s)
+	}
+	return tac
+}
+
 // SetID sets the "id" field.
 func (tac *TelegramAccountCreate) SetID(s string) *TelegramAccountCreate {
 	tac.mutation.SetID(s)
 	return tac
 }
 
+// AddServiceMessageIDs adds the "service_messages" edge to the TelegramServiceMessage entity by IDs.
+func (tac *TelegramAccountCreate) AddServiceMessageIDs(ids ...int) *TelegramAccountCreate {
+	tac.mutation.AddServiceMessageIDs(ids...)
+	return tac
+}
+
+// AddServiceMessages adds the "service_messages" edges to the TelegramServiceMessage entity.
+func (tac *TelegramAccountCreate) AddServiceMessages(t ...*TelegramServiceMessage) *TelegramAccountCreate {
+	ids := make([]int, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return tac.AddServiceMessageIDs(ids...)
+}
+
 // Mutation returns the TelegramAccountMutation object of the builder.
 func (tac *TelegramAccountCreate) Mutation() *TelegramAccountMutation {
 	return tac.mutation
@@ -122,6 +214,14 @@ func (tac *TelegramAccountCreate) defaults() {
 		v := telegramaccount.DefaultState
 		tac.mutation.SetState(v)
 	}
+	if _, ok := tac.mutation.Disabled(); !ok {
+		v := telegramaccount.DefaultDisabled
+		tac.mutation.SetDisabled(v)
+	}
+	if _, ok := tac.mutation.DcList(); !ok {
+		v := telegramaccount.DefaultDcList
+		tac.mutation.SetDcList(v)
+	}
 }
 
 // check runs all checks and user-defined validators on the builder.
@@ -137,6 +237,17 @@ func (tac *TelegramAccountCreate) check() error {
 	if _, ok := tac.mutation.Status(); !ok {
 		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "TelegramAccount.status"`)}
 	}
+	if _, ok := tac.mutation.Disabled(); !ok {
+		return &ValidationError{Name: "disabled", err: errors.New(`ent: missing required field "TelegramAccount.disabled"`)}
+	}
+	if _, ok := tac.mutation.DcList(); !ok {
+		return &ValidationError{Name: "dc_list", err: errors.New(`ent: missing required field "TelegramAccount.dc_list"`)}
+	}
+	if v, ok := tac.mutation.DcList(); ok {
+		if err := telegramaccount.DcListValidator(v); err != nil {
+			return &ValidationError{Name: "dc_list", err: fmt.Errorf(`ent: validator failed for field "TelegramAccount.dc_list": %w`, err)}
+		}
+	}
 	return nil
 }
 
@@ -193,6 +304,46 @@ func (tac *TelegramAccountCreate) createSpec() (*TelegramAccount, *sqlgraph.Crea
 		_spec.SetField(telegramaccount.FieldSessionData, field.TypeBytes, value)
 		_node.SessionData = &value
 	}
+	if value, ok := tac.mutation.Password(); ok {
+		_spec.SetField(telegramaccount.FieldPassword, field.TypeBytes, value)
+		_node.Password = &value
+	}
+	if value, ok := tac.mutation.Disabled(); ok {
+		_spec.SetField(telegramaccount.FieldDisabled, field.TypeBool, value)
+		_node.Disabled = value
+	}
+	if value, ok := tac.mutation.DcList(); ok {
+		_spec.SetField(telegramaccount.FieldDcList, field.TypeEnum, value)
+		_node.DcList = value
+	}
+	if value, ok := tac.mutation.Dc(); ok {
+		_spec.SetField(telegramaccount.FieldDc, field.TypeInt, value)
+		_node.Dc = &value
+	}
+	if value, ok := tac.mutation.AppID(); ok {
+		_spec.SetField(telegramaccount.FieldAppID, field.TypeInt, value)
+		_node.AppID = &value
+	}
+	if value, ok := tac.mutation.AppHash(); ok {
+		_spec.SetField(telegramaccount.FieldAppHash, field.TypeString, value)
+		_node.AppHash = &value
+	}
+	if nodes := tac.mutation.ServiceMessagesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   telegramaccount.ServiceMessagesTable,
+			Columns: []string{telegramaccount.ServiceMessagesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(telegramservicemessage.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
@@ -323,6 +474,114 @@ func (u *TelegramAccountUpsert) ClearSessionData() *TelegramAccountUpsert {
 	return u
 }
 
+// SetPassword sets the "password" field.
+func (u *TelegramAccountUpsert) SetPassword(v []byte) *TelegramAccountUpsert {
+	u.Set(telegramaccount.FieldPassword, v)
+	return u
+}
+
+// UpdatePassword sets the "password" field to the value that was provided on create.
+func (u *TelegramAccountUpsert) UpdatePassword() *TelegramAccountUpsert {
+	u.SetExcluded(telegramaccount.FieldPassword)
+	return u
+}
+
+// ClearPassword clears the value of the "password" field.
+func (u *TelegramAccountUpsert) ClearPassword() *TelegramAccountUpsert {
+	u.SetNull(telegramaccount.FieldPassword)
+	return u
+}
+
+// SetDisabled sets the "disabled" field.
+func (u *TelegramAccountUpsert) SetDisabled(v bool) *TelegramAccountUpsert {
+	u.Set(telegramaccount.FieldDisabled, v)
+	return u
+}
+
+// UpdateDisabled sets the "disabled" field to the value that was provided on create.
+func (u *TelegramAccountUpsert) UpdateDisabled() *TelegramAccountUpsert {
+	u.SetExcluded(telegramaccount.FieldDisabled)
+	return u
+}
+
+// SetDcList sets the "dc_list" field.
+func (u *TelegramAccountUpsert) SetDcList(v telegramaccount.DcList) *TelegramAccountUpsert {
+	u.Set(telegramaccount.FieldDcList, v)
+	return u
+}
+
+// UpdateDcList sets the "dc_list" field to the value that was provided on create.
+func (u *TelegramAccountUpsert) UpdateDcList() *TelegramAccountUpsert {
+	u.SetExcluded(telegramaccount.FieldDcList)
+	return u
+}
+
+// SetDc sets the "dc" field.
+func (u *TelegramAccountUpsert) SetDc(v int) *TelegramAccountUpsert {
+	u.Set(telegramaccount.FieldDc, v)
+	return u
+}
+
+// UpdateDc sets the "dc" field to the value that was provided on create.
+func (u *TelegramAccountUpsert) UpdateDc() *TelegramAccountUpsert {
+	u.SetExcluded(telegramaccount.FieldDc)
+	return u
+}
+
+// AddDc adds v to the "dc" field.
+func (u *TelegramAccountUpsert) AddDc(v int) *TelegramAccountUpsert {
+	u.Add(telegramaccount.FieldDc, v)
+	return u
+}
+
+// ClearDc clears the value of the "dc" field.
+func (u *TelegramAccountUpsert) ClearDc() *TelegramAccountUpsert {
+	u.SetNull(telegramaccount.FieldDc)
+	return u
+}
+
+// SetAppID sets the "app_id" field.
+func (u *TelegramAccountUpsert) SetAppID(v int) *TelegramAccountUpsert {
+	u.Set(telegramaccount.FieldAppID, v)
+	return u
+}
+
+// UpdateAppID sets the "app_id" field to the value that was provided on create.
+func (u *TelegramAccountUpsert) UpdateAppID() *TelegramAccountUpsert {
+	u.SetExcluded(telegramaccount.FieldAppID)
+	return u
+}
+
+// AddAppID adds v to the "app_id" field.
+func (u *TelegramAccountUpsert) AddAppID(v int) *TelegramAccountUpsert {
+	u.Add(telegramaccount.FieldAppID, v)
+	return u
+}
+
+// ClearAppID clears the value of the "app_id" field.
+func (u *TelegramAccountUpsert) ClearAppID() *TelegramAccountUpsert {
+	u.SetNull(telegramaccount.FieldAppID)
+	return u
+}
+
+// SetAppHash sets the "app_hash" field.
+func (u *TelegramAccountUpsert) SetAppHash(v string) *TelegramAccountUpsert {
+	u.Set(telegramaccount.FieldAppHash, v)
+	return u
+}
+
+// UpdateAppHash sets the "app_hash" field to the value that was provided on create.
+func (u *TelegramAccountUpsert) UpdateAppHash() *TelegramAccountUpsert {
+	u.SetExcluded(telegramaccount.FieldAppHash)
+	return u
+}
+
+// ClearAppHash clears the value of the "app_hash" field.
+func (u *TelegramAccountUpsert) ClearAppHash() *TelegramAccountUpsert {
+	u.SetNull(telegramaccount.FieldAppHash)
+	return u
+}
+
 // UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
 // Using this option is equivalent to using:
 //
@@ -462,6 +721,132 @@ func (u *TelegramAccountUpsertOne) ClearSessionData() *TelegramAccountUpsertOne
 	})
 }
 
+// SetPassword sets the "password" field.
+func (u *TelegramAccountUpsertOne) SetPassword(v []byte) *TelegramAccountUpsertOne {
+	return u.Update(func(s *TelegramAccountUpsert) {
+		s.SetPassword(v)
+	})
+}
+
+// UpdatePassword sets the "password" field to the value that was provided on create.
+func (u *TelegramAccountUpsertOne) UpdatePassword() *TelegramAccountUpsertOne {
+	return u.Update(func(s *TelegramAccountUpsert) {
+		s.UpdatePassword()
+	})
+}
+
+// ClearPassword clears the value of the "password" field.
+func (u *TelegramAccountUpsertOne) ClearPassword() *TelegramAccountUpsertOne {
+	return u.Update(func(s *TelegramAccountUpsert) {
+		s.ClearPassword()
+	})
+}
+
+// SetDisabled sets the "disabled" field.
+func (u *TelegramAccountUpsertOne) SetDisabled(v bool) *TelegramAccountUpsertOne {
+	return u.Update(func(s *TelegramAccountUpsert) {
+		s.SetDisabled(v)
+	})
+}
+
+// UpdateDisabled sets the "disabled" field to the value that was provided on create.
+func (u *TelegramAccountUpsertOne) UpdateDisabled() *TelegramAccountUpsertOne {
+	return u.Update(func(s *TelegramAccountUpsert) {
+		s.UpdateDisabled()
+	})
+}
+
+// SetDcList sets the "dc_list" field.
+func (u *TelegramAccountUpsertOne) SetDcList(v telegramaccount.DcList) *TelegramAccountUpsertOne {
+	return u.Update(func(s *TelegramAccountUpsert) {
+		s.SetDcList(v)
+	})
+}
+
+// UpdateDcList sets the "dc_list" field to the value that was provided on create.
+func (u *TelegramAccountUpsertOne) UpdateDcList() *TelegramAccountUpsertOne {
+	return u.Update(func(s *TelegramAccountUpsert) {
+		s.UpdateDcList()
+	})
+}
+
+// SetDc sets the "dc" field.
+func (u *TelegramAccountUpsertOne) SetDc(v int) *TelegramAccountUpsertOne {
+	return u.Update(func(s *TelegramAccountUpsert) {
+		s.SetDc(v)
+	})
+}
+
+// AddDc adds v to the "dc" field.
+func (u *TelegramAccountUpsertOne) AddDc(v int) *TelegramAccountUpsertOne {
+	return u.Update(func(s *TelegramAccountUpsert) {
+		s.AddDc(v)
+	})
+}
+
+// UpdateDc sets the "dc" field to the value that was provided on create.
+func (u *TelegramAccountUpsertOne) UpdateDc() *TelegramAccountUpsertOne {
+	return u.Update(func(s *TelegramAccountUpsert) {
+		s.UpdateDc()
+	})
+}
+
+// ClearDc clears the value of the "dc" field.
+func (u *TelegramAccountUpsertOne) ClearDc() *TelegramAccountUpsertOne {
+	return u.Update(func(s *TelegramAccountUpsert) {
+		s.ClearDc()
+	})
+}
+
+// SetAppID sets the "app_id" field.
+func (u *TelegramAccountUpsertOne) SetAppID(v int) *TelegramAccountUpsertOne {
+	return u.Update(func(s *TelegramAccountUpsert) {
+		s.SetAppID(v)
+	})
+}
+
+// AddAppID adds v to the "app_id" field.
+func (u *TelegramAccountUpsertOne) AddAppID(v int) *TelegramAccountUpsertOne {
+	return u.Update(func(s *TelegramAccountUpsert) {
+		s.AddAppID(v)
+	})
+}
+
+// UpdateAppID sets the "app_id" field to the value that was provided on create.
+func (u *TelegramAccountUpsertOne) UpdateAppID() *TelegramAccountUpsertOne {
+	return u.Update(func(s *TelegramAccountUpsert) {
+		s.UpdateAppID()
+	})
+}
+
+// ClearAppID clears the value of the "app_id" field.
+func (u *TelegramAccountUpsertOne) ClearAppID() *TelegramAccountUpsertOne {
+	return u.Update(func(s *TelegramAccountUpsert) {
+		s.ClearAppID()
+	})
+}
+
+// SetAppHash sets the "app_hash" field.
+func (u *TelegramAccountUpsertOne) SetAppHash(v string) *TelegramAccountUpsertOne {
+	return u.Update(func(s *TelegramAccountUpsert) {
+		s.SetAppHash(v)
+	})
+}
+
+// UpdateAppHash sets the "app_hash" field to the value that was provided on create.
+func (u *TelegramAccountUpsertOne) UpdateAppHash() *TelegramAccountUpsertOne {
+	return u.Update(func(s *TelegramAccountUpsert) {
+		s.UpdateAppHash()
+	})
+}
+
+// ClearAppHash clears the value of the "app_hash" field.
+func (u *TelegramAccountUpsertOne) ClearAppHash() *TelegramAccountUpsertOne {
+	return u.Update(func(s *TelegramAccountUpsert) {
+		s.ClearAppHash()
+	})
+}
+
 // Exec executes the query.
 func (u *TelegramAccountUpsertOne) Exec(ctx context.Context) error {
 	if len(u.create.conflict) == 0 {
@@ -768,6 +1153,132 @@ func (u *TelegramAccountUpsertBulk) ClearSessionData() *TelegramAccountUpsertBul
 	})
 }
 
+// SetPassword sets the "password" field.
+func (u *TelegramAccountUpsertBulk) SetPassword(v []byte) *TelegramAccountUpsertBulk {
+	return u.Update(func(s *TelegramAccountUpsert) {
+		s.SetPassword(v)
+	})
+}
+
+// UpdatePassword sets the "password" field to the value that was provided on create.
+func (u *TelegramAccountUpsertBulk) UpdatePassword() *TelegramAccountUpsertBulk {
+	return u.Update(func(s *TelegramAccountUpsert) {
+		s.UpdatePassword()
+	})
+}
+
+// ClearPassword clears the value of the "password" field.
+func (u *TelegramAccountUpsertBulk) ClearPassword() *TelegramAccountUpsertBulk {
+	return u.Update(func(s *TelegramAccountUpsert) {
+		s.ClearPassword()
+	})
+}
+
+// SetDisabled sets the "disabled" field.
+func (u *TelegramAccountUpsertBulk) SetDisabled(v bool) *TelegramAccountUpsertBulk {
+	return u.Update(func(s *TelegramAccountUpsert) {
+		s.SetDisabled(v)
+	})
+}
+
+// UpdateDisabled sets the "disabled" field to the value that was provided on create.
+func (u *TelegramAccountUpsertBulk) UpdateDisabled() *TelegramAccountUpsertBulk {
+	return u.Update(func(s *TelegramAccountUpsert) {
+		s.UpdateDisabled()
+	})
+}
+
+// SetDcList sets the "dc_list" field.
+func (u *TelegramAccountUpsertBulk) SetDcList(v telegramaccount.DcList) *TelegramAccountUpsertBulk {
+	return u.Update(func(s *TelegramAccountUpsert) {
+		s.SetDcList(v)
+	})
+}
+
+// UpdateDcList sets the "dc_list" field to the value that was provided on create.
+func (u *TelegramAccountUpsertBulk) UpdateDcList() *TelegramAccountUpsertBulk {
+	return u.Update(func(s *TelegramAccountUpsert) {
+		s.UpdateDcList()
+	})
+}
+
+// SetDc sets the "dc" field.
+func (u *TelegramAccountUpsertBulk) SetDc(v int) *TelegramAccountUpsertBulk {
+	return u.Update(func(s *TelegramAccountUpsert) {
+		s.SetDc(v)
+	})
+}
+
+// AddDc adds v to the "dc" field.
+func (u *TelegramAccountUpsertBulk) AddDc(v int) *TelegramAccountUpsertBulk {
+	return u.Update(func(s *TelegramAccountUpsert) {
+		s.AddDc(v)
+	})
+}
+
+// UpdateDc sets the "dc" field to the value that was provided on create.
+func (u *TelegramAccountUpsertBulk) UpdateDc() *TelegramAccountUpsertBulk {
+	return u.Update(func(s *TelegramAccountUpsert) {
+		s.UpdateDc()
+	})
+}
+
+// ClearDc clears the value of the "dc" field.
+func (u *TelegramAccountUpsertBulk) ClearDc() *TelegramAccountUpsertBulk {
+	return u.Update(func(s *TelegramAccountUpsert) {
+		s.ClearDc()
+	})
+}
+
+// SetAppID sets the "app_id" field.
+func (u *TelegramAccountUpsertBulk) SetAppID(v int) *TelegramAccountUpsertBulk {
+	return u.Update(func(s *TelegramAccountUpsert) {
+		s.SetAppID(v)
+	})
+}
+
+// AddAppID adds v to the "app_id" field.
+func (u *TelegramAccountUpsertBulk) AddAppID(v int) *TelegramAccountUpsertBulk {
+	return u.Update(func(s *TelegramAccountUpsert) {
+		s.AddAppID(v)
+	})
+}
+
+// UpdateAppID sets the "app_id" field to the value that was provided on create.
+func (u *TelegramAccountUpsertBulk) UpdateAppID() *TelegramAccountUpsertBulk {
+	return u.Update(func(s *TelegramAccountUpsert) {
+		s.UpdateAppID()
+	})
+}
+
+// ClearAppID clears the value of the "app_id" field.
+func (u *TelegramAccountUpsertBulk) ClearAppID() *TelegramAccountUpsertBulk {
+	return u.Update(func(s *TelegramAccountUpsert) {
+		s.ClearAppID()
+	})
+}
+
+// SetAppHash sets the "app_hash" field.
+func (u *TelegramAccountUpsertBulk) SetAppHash(v string) *TelegramAccountUpsertBulk {
+	return u.Update(func(s *TelegramAccountUpsert) {
+		s.SetAppHash(v)
+	})
+}
+
+// UpdateAppHash sets the "app_hash" field to the value that was provided on create.
+func (u *TelegramAccountUpsertBulk) UpdateAppHash() *TelegramAccountUpsertBulk {
+	return u.Update(func(s *TelegramAccountUpsert) {
+		s.UpdateAppHash()
+	})
+}
+
+// ClearAppHash clears the value of the "app_hash" field.
+func (u *TelegramAccountUpsertBulk) ClearAppHash() *TelegramAccountUpsertBulk {
+	return u.Update(func(s *TelegramAccountUpsert) {
+		s.ClearAppHash()
+	})
+}
+
 // Exec executes the query.
 func (u *TelegramAccountUpsertBulk) Exec(ctx context.Context) error {
 	if u.create.err != nil {
@@ -4,6 +4,7 @@ package ent
 
 import (
 	"context"
+	"database/sql/driver"
 	"fmt"
 	"math"
 
@@ -13,15 +14,18 @@ import (
 	"entgo.io/ent/schema/field"
 	"github.com/gotd/bot/internal/ent/predicate"
 	"github.com/gotd/bot/internal/ent/telegramaccount"
+	"github.com/gotd/bot/internal/ent/telegramservicemessage"
 )
 
 // TelegramAccountQuery is the builder for querying TelegramAccount entities.
 type TelegramAccountQuery struct {
 	config
-	ctx        *QueryContext
-	order      []telegramaccount.OrderOption
-	inters     []Interceptor
-	predicates []predicate.TelegramAccount
+	ctx                      *QueryContext
+	order                    []telegramaccount.OrderOption
+	inters                   []Interceptor
+	predicates               []predicate.TelegramAccount
+	withServiceMessages      *TelegramServiceMessageQuery
+	withNamedServiceMessages map[string]*TelegramServiceMessageQuery
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -58,6 +62,28 @@ func (taq *TelegramAccountQuery) Order(o ...telegramaccount.OrderOption) *Telegr
 	return taq
 }
 
+// QueryServiceMessages chains the current query on the "service_messages" edge.
+func (taq *TelegramAccountQuery) QueryServiceMessages() *TelegramServiceMessageQuery {
+	query := (&TelegramServiceMessageClient{config: taq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := taq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := taq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(telegramaccount.Table, telegramaccount.FieldID, selector),
+			sqlgraph.To(telegramservicemessage.Table, telegramservicemessage.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, telegramaccount.ServiceMessagesTable, telegramaccount.ServiceMessagesColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(taq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // First returns the first TelegramAccount entity from the query.
 // Returns a *NotFoundError when no TelegramAccount was found.
 func (taq *TelegramAccountQuery) First(ctx context.Context) (*TelegramAccount, error) {
@@ -245,17 +271,29 @@ func (taq *TelegramAccountQuery) Clone() *TelegramAccountQuery {
 		return nil
 	}
 	return &TelegramAccountQuery{
-		config:     taq.config,
-		ctx:        taq.ctx.Clone(),
-		order:      append([]telegramaccount.OrderOption{}, taq.order...),
-		inters:     append([]Interceptor{}, taq.inters...),
-		predicates: append([]predicate.TelegramAccount{}, taq.predicates...),
+		config:              taq.config,
+		ctx:                 taq.ctx.Clone(),
+		order:               append([]telegramaccount.OrderOption{}, taq.order...),
+		inters:              append([]Interceptor{}, taq.inters...),
+		predicates:          append([]predicate.TelegramAccount{}, taq.predicates...),
+		withServiceMessages: taq.withServiceMessages.Clone(),
 		// clone intermediate query.
 		sql:  taq.sql.Clone(),
 		path: taq.path,
 	}
 }
 
+// WithServiceMessages tells the query-builder to eager-load the nodes that are connected to
+// the "service_messages" edge. The optional arguments are used to configure the query builder of the edge.
+func (taq *TelegramAccountQuery) WithServiceMessages(opts ...func(*TelegramServiceMessageQuery)) *TelegramAccountQuery {
+	query := (&TelegramServiceMessageClient{config: taq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	taq.withServiceMessages = query
+	return taq
+}
+
 // GroupBy is used to group vertices by one or more fields/columns.
 // It is often used with aggregate functions, like: count, max, mean, min, sum.
 //
@@ -332,8 +370,11 @@ func (taq *TelegramAccountQuery) prepareQuery(ctx context.Context) error {
 
 func (taq *TelegramAccountQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*TelegramAccount, error) {
 	var (
-		nodes = []*TelegramAccount{}
-		_spec = taq.querySpec()
+		nodes       = []*TelegramAccount{}
+		_spec       = taq.querySpec()
+		loadedTypes = [1]bool{
+			taq.withServiceMessages != nil,
+		}
 	)
 	_spec.ScanValues = func(columns []string) ([]any, error) {
 		return (*TelegramAccount).scanValues(nil, columns)
@@ -341,6 +382,7 @@ func (taq *TelegramAccountQuery) sqlAll(ctx context.Context, hooks ...queryHook)
 	_spec.Assign = func(columns []string, values []any) error {
 		node := &TelegramAccount{config: taq.config}
 		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
 		return node.assignValues(columns, values)
 	}
 	for i := range hooks {
@@ -352,9 +394,56 @@ func (taq *TelegramAccountQuery) sqlAll(ctx context.Context, hooks ...queryHook)
 	if len(nodes) == 0 {
 		return nodes, nil
 	}
+	if query := taq.withServiceMessages; query != nil {
+		if err := taq.loadServiceMessages(ctx, query, nodes,
+			func(n *TelegramAccount) { n.Edges.ServiceMessages = []*TelegramServiceMessage{} },
+			func(n *TelegramAccount, e *TelegramServiceMessage) {
+				n.Edges.ServiceMessages = append(n.Edges.ServiceMessages, e)
+			}); err != nil {
+			return nil, err
+		}
+	}
+	for name, query := range taq.withNamedServiceMessages {
+		if err := taq.loadServiceMessages(ctx, query, nodes,
+			func(n *TelegramAccount) { n.appendNamedServiceMessages(name) },
+			func(n *TelegramAccount, e *TelegramServiceMessage) { n.appendNamedServiceMessages(name, e) }); err != nil {
+			return nil, err
+		}
+	}
 	return nodes, nil
 }
 
+func (taq *TelegramAccountQuery) loadServiceMessages(ctx context.Context, query *TelegramServiceMessageQuery, nodes []*TelegramAccount, init func(*TelegramAccount), assign func(*TelegramAccount, *TelegramServiceMessage)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[string]*TelegramAccount)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(telegramservicemessage.FieldAccountID)
+	}
+	query.Where(predicate.TelegramServiceMessage(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(telegramaccount.ServiceMessagesColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.AccountID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "account_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+
 func (taq *TelegramAccountQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := taq.querySpec()
 	_spec.Node.Columns = taq.ctx.Fields
@@ -436,6 +525,20 @@ func (taq *TelegramAccountQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	return selector
 }
 
+// WithNamedServiceMessages tells the query-builder to eager-load the nodes that are connected to the "service_messages"
+// edge with the given name. The optional arguments are used to configure the query builder of the edge.
+func (taq *TelegramAccountQuery) WithNamedServiceMessages(name string, opts ...func(*TelegramServiceMessageQuery)) *TelegramAccountQuery {
+	query := (&TelegramServiceMessageClient{config: taq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	if taq.withNamedServiceMessages == nil {
+		taq.withNamedServiceMessages = make(map[string]*TelegramServiceMessageQuery)
+	}
+	taq.withNamedServiceMessages[name] = query
+	return taq
+}
+
 // TelegramAccountGroupBy is the group-by builder for TelegramAccount entities.
 type TelegramAccountGroupBy struct {
 	selector
@@ -13,6 +13,7 @@ import (
 	"entgo.io/ent/schema/field"
 	"github.com/gotd/bot/internal/ent/predicate"
 	"github.com/gotd/bot/internal/ent/telegramaccount"
+	"github.com/gotd/bot/internal/ent/telegramservicemessage"
 )
 
 // TelegramAccountUpdate is the builder for updating TelegramAccount entities.
@@ -108,11 +109,161 @@ func (tau *TelegramAccountUpdate) ClearSessionData() *TelegramAccountUpdate {
 	return tau
 }
 
+// SetPassword sets the "password" field.
+func (tau *TelegramAccountUpdate) SetPassword(b []byte) *TelegramAccountUpdate {
+	tau.mutation.SetPassword(b)
+	return tau
+}
+
+// ClearPassword clears the value of the "password" field.
+func (tau *TelegramAccountUpdate) ClearPassword() *TelegramAccountUpdate {
+	tau.mutation.ClearPassword()
+	return tau
+}
+
+// SetDisabled sets the "disabled" field.
+func (tau *TelegramAccountUpdate) SetDisabled(b bool) *TelegramAccountUpdate {
+	tau.mutation.SetDisabled(b)
+	return tau
+}
+
+// SetNillableDisabled sets the "disabled" field if the given value is not nil.
+func (tau *TelegramAccountUpdate) SetNillableDisabled(b *bool) *TelegramAccountUpdate {
+	if b != nil {
+		tau.SetDisabled(*b)
+	}
+	return tau
+}
+
+// SetDcList sets the "dc_list" field.
+func (tau *TelegramAccountUpdate) SetDcList(tl telegramaccount.DcList) *TelegramAccountUpdate {
+	tau.mutation.SetDcList(tl)
+	return tau
+}
+
+// SetNillableDcList sets the "dc_list" field if the given value is not nil.
+func (tau *TelegramAccountUpdate) SetNillableDcList(tl *telegramaccount.DcList) *TelegramAccountUpdate {
+	if tl != nil {
+		tau.SetDcList(*tl)
+	}
+	return tau
+}
+
+// SetDc sets the "dc" field.
+func (tau *TelegramAccountUpdate) SetDc(i int) *TelegramAccountUpdate {
+	tau.mutation.ResetDc()
+	tau.mutation.SetDc(i)
+	return tau
+}
+
+// SetNillableDc sets the "dc" field if the given value is not nil.
+func (tau *TelegramAccountUpdate) SetNillableDc(i *int) *TelegramAccountUpdate {
+	if i != nil {
+		tau.SetDc(*i)
+	}
+	return tau
+}
+
+// AddDc adds i to the "dc" field.
+func (tau *TelegramAccountUpdate) AddDc(i int) *TelegramAccountUpdate {
+	tau.mutation.AddDc(i)
+	return tau
+}
+
+// ClearDc clears the value of the "dc" field.
+func (tau *TelegramAccountUpdate) ClearDc() *TelegramAccountUpdate {
+	tau.mutation.ClearDc()
+	return tau
+}
+
+// SetAppID sets the "app_id" field.
+func (tau *TelegramAccountUpdate) SetAppID(i int) *TelegramAccountUpdate {
+	tau.mutation.ResetAppID()
+	tau.mutation.SetAppID(i)
+	return tau
+}
+
+// SetNillableAppID sets the "app_id" field if the given value is not nil.
+func (tau *TelegramAccountUpdate) SetNillableAppID(i *int) *TelegramAccountUpdate {
+	if i != nil {
+		tau.SetAppID(*i)
+	}
+	return tau
+}
+
+// AddAppID adds i to the "app_id" field.
+func (tau *TelegramAccountUpdate) AddAppID(i int) *TelegramAccountUpdate {
+	tau.mutation.AddAppID(i)
+	return tau
+}
+
+// ClearAppID clears the value of the "app_id" field.
+func (tau *TelegramAccountUpdate) ClearAppID() *TelegramAccountUpdate {
+	tau.mutation.ClearAppID()
+	return tau
+}
+
+// SetAppHash sets the "app_hash" field.
+func (tau *TelegramAccountUpdate) SetAppHash(s string) *TelegramAccountUpdate {
+	tau.mutation.SetAppHash(s)
+	return tau
+}
+
+// SetNillableAppHash sets the "app_hash" field if the given value is not nil.
+func (tau *TelegramAccountUpdate) SetNillableAppHash(s *string) *TelegramAccountUpdate {
+	if s != nil {
+		tau.SetAppHash(*s)
+	}
+	return tau
+}
+
+// ClearAppHash clears the value of the "app_hash" field.
+func (tau *TelegramAccountUpdate) ClearAppHash() *TelegramAccountUpdate {
+	tau.mutation.ClearAppHash()
+	return tau
+}
+
+// AddServiceMessageIDs adds the "service_messages" edge to the TelegramServiceMessage entity by IDs.
+func (tau *TelegramAccountUpdate) AddServiceMessageIDs(ids ...int) *TelegramAccountUpdate {
+	tau.mutation.AddServiceMessageIDs(ids...)
+	return tau
+}
+
+// AddServiceMessages adds the "service_messages" edges to the TelegramServiceMessage entity.
+func (tau *TelegramAccountUpdate) AddServiceMessages(t ...*TelegramServiceMessage) *TelegramAccountUpdate {
+	ids := make([]int, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return tau.AddServiceMessageIDs(ids...)
+}
+
 // Mutation returns the TelegramAccountMutation object of the builder.
 func (tau *TelegramAccountUpdate) Mutation() *TelegramAccountMutation {
 	return tau.mutation
 }
 
+// ClearServiceMessages clears all "service_messages" edges to the TelegramServiceMessage entity.
+func (tau *TelegramAccountUpdate) ClearServiceMessages() *TelegramAccountUpdate {
+	tau.mutation.ClearServiceMessages()
+	return tau
+}
+
+// RemoveServiceMessageIDs removes the "service_messages" edge to TelegramServiceMessage entities by IDs.
+func (tau *TelegramAccountUpdate) RemoveServiceMessageIDs(ids ...int) *TelegramAccountUpdate {
+	tau.mutation.RemoveServiceMessageIDs(ids...)
+	return tau
+}
+
+// RemoveServiceMessages removes "service_messages" edges to TelegramServiceMessage entities.
+func (tau *TelegramAccountUpdate) RemoveServiceMessages(t ...*TelegramServiceMessage) *TelegramAccountUpdate {
+	ids := make([]int, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return tau.RemoveServiceMessageIDs(ids...)
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (tau *TelegramAccountUpdate) Save(ctx context.Context) (int, error) {
 	return withHooks(ctx, tau.sqlSave, tau.mutation, tau.hooks)
@@ -147,6 +298,11 @@ func (tau *TelegramAccountUpdate) check() error {
 			return &ValidationError{Name: "state", err: fmt.Errorf(`ent: validator failed for field "TelegramAccount.state": %w`, err)}
 		}
 	}
+	if v, ok := tau.mutation.DcList(); ok {
+		if err := telegramaccount.DcListValidator(v); err != nil {
+			return &ValidationError{Name: "dc_list", err: fmt.Errorf(`ent: validator failed for field "TelegramAccount.dc_list": %w`, err)}
+		}
+	}
 	return nil
 }
 
@@ -186,6 +342,87 @@ func (tau *TelegramAccountUpdate) sqlSave(ctx context.Context) (n int, err error
 	if tau.mutation.SessionDataCleared() {
 		_spec.ClearField(telegramaccount.FieldSessionData, field.TypeBytes)
 	}
+	if value, ok := tau.mutation.Password(); ok {
+		_spec.SetField(telegramaccount.FieldPassword, field.TypeBytes, value)
+	}
+	if tau.mutation.PasswordCleared() {
+		_spec.ClearField(telegramaccount.FieldPassword, field.TypeBytes)
+	}
+	if value, ok := tau.mutation.Disabled(); ok {
+		_spec.SetField(telegramaccount.FieldDisabled, field.TypeBool, value)
+	}
+	if value, ok := tau.mutation.DcList(); ok {
+		_spec.SetField(telegramaccount.FieldDcList, field.TypeEnum, value)
+	}
+	if value, ok := tau.mutation.Dc(); ok {
+		_spec.SetField(telegramaccount.FieldDc, field.TypeInt, value)
+	}
+	if value, ok := tau.mutation.AddedDc(); ok {
+		_spec.AddField(telegramaccount.FieldDc, field.TypeInt, value)
+	}
+	if tau.mutation.DcCleared() {
+		_spec.ClearField(telegramaccount.FieldDc, field.TypeInt)
+	}
+	if value, ok := tau.mutation.AppID(); ok {
+		_spec.SetField(telegramaccount.FieldAppID, field.TypeInt, value)
+	}
+	if value, ok := tau.mutation.AddedAppID(); ok {
+		_spec.AddField(telegramaccount.FieldAppID, field.TypeInt, value)
+	}
+	if tau.mutation.AppIDCleared() {
+		_spec.ClearField(telegramaccount.FieldAppID, field.TypeInt)
+	}
+	if value, ok := tau.mutation.AppHash(); ok {
+		_spec.SetField(telegramaccount.FieldAppHash, field.TypeString, value)
+	}
+	if tau.mutation.AppHashCleared() {
+		_spec.ClearField(telegramaccount.FieldAppHash, field.TypeString)
+	}
+	if tau.mutation.ServiceMessagesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   telegramaccount.ServiceMessagesTable,
+			Columns: []string{telegramaccount.ServiceMessagesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(telegramservicemessage.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tau.mutation.RemovedServiceMessagesIDs(); len(nodes) > 0 && !tau.mutation.ServiceMessagesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   telegramaccount.ServiceMessagesTable,
+			Columns: []string{telegramaccount.ServiceMessagesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(telegramservicemessage.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tau.mutation.ServiceMessagesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   telegramaccount.ServiceMessagesTable,
+			Columns: []string{telegramaccount.ServiceMessagesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(telegramservicemessage.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if n, err = sqlgraph.UpdateNodes(ctx, tau.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{telegramaccount.Label}
@@ -286,11 +523,161 @@ func (tauo *TelegramAccountUpdateOne) ClearSessionData() *TelegramAccountUpdateO
 	return tauo
 }
 
+// SetPassword sets the "password" field.
+func (tauo *TelegramAccountUpdateOne) SetPassword(b []byte) *TelegramAccountUpdateOne {
+	tauo.mutation.SetPassword(b)
+	return tauo
+}
+
+// ClearPassword clears the value of the "password" field.
+func (tauo *TelegramAccountUpdateOne) ClearPassword() *TelegramAccountUpdateOne {
+	tauo.mutation.ClearPassword()
+	return tauo
+}
+
+// SetDisabled sets the "disabled" field.
+func (tauo *TelegramAccountUpdateOne) SetDisabled(b bool) *TelegramAccountUpdateOne {
+	tauo.mutation.SetDisabled(b)
+	return tauo
+}
+
+// SetNillableDisabled sets the "disabled" field if the given value is not nil.
+func (tauo *TelegramAccountUpdateOne) SetNillableDisabled(b *bool) *TelegramAccountUpdateOne {
+	if b != nil {
+		tauo.SetDisabled(*b)
+	}
+	return tauo
+}
+
+// SetDcList sets the "dc_list" field.
+func (tauo *TelegramAccountUpdateOne) SetDcList(tl telegramaccount.DcList) *TelegramAccountUpdateOne {
+	tauo.mutation.SetDcList(tl)
+	return tauo
+}
+
+// SetNillableDcList sets the "dc_list" field if the given value is not nil.
+func (tauo *TelegramAccountUpdateOne) SetNillableDcList(tl *telegramaccount.DcList) *TelegramAccountUpdateOne {
+	if tl != nil {
+		tauo.SetDcList(*tl)
+	}
+	return tauo
+}
+
+// SetDc sets the "dc" field.
+func (tauo *TelegramAccountUpdateOne) SetDc(i int) *TelegramAccountUpdateOne {
+	tauo.mutation.ResetDc()
+	tauo.mutation.SetDc(i)
+	return tauo
+}
+
+// SetNillableDc sets the "dc" field if the given value is not nil.
+func (tauo *TelegramAccountUpdateOne) SetNillableDc(i *int) *TelegramAccountUpdateOne {
+	if i != nil {
+		tauo.SetDc(*i)
+	}
+	return tauo
+}
+
+// AddDc adds i to the "dc" field.
+func (tauo *TelegramAccountUpdateOne) AddDc(i int) *TelegramAccountUpdateOne {
+	tauo.mutation.AddDc(i)
+	return tauo
+}
+
+// ClearDc clears the value of the "dc" field.
+func (tauo *TelegramAccountUpdateOne) ClearDc() *TelegramAccountUpdateOne {
+	tauo.mutation.ClearDc()
+	return tauo
+}
+
+// SetAppID sets the "app_id" field.
+func (tauo *TelegramAccountUpdateOne) SetAppID(i int) *TelegramAccountUpdateOne {
+	tauo.mutation.ResetAppID()
+	tauo.mutation.SetAppID(i)
+	return tauo
+}
+
+// SetNillableAppID sets the "app_id" field if the given value is not nil.
+func (tauo *TelegramAccountUpdateOne) SetNillableAppID(i *int) *TelegramAccountUpdateOne {
+	if i != nil {
+		tauo.SetAppID(*i)
+	}
+	return tauo
+}
+
+// AddAppID adds i to the "app_id" field.
+func (tauo *TelegramAccountUpdateOne) AddAppID(i int) *TelegramAccountUpdateOne {
+	tauo.mutation.AddAppID(i)
+	return tauo
+}
+
+// ClearAppID clears the value of the "app_id" field.
+func (tauo *TelegramAccountUpdateOne) ClearAppID() *TelegramAccountUpdateOne {
+	tauo.mutation.ClearAppID()
+	return tauo
+}
+
+// SetAppHash sets the "app_hash" field.
+func (tauo *TelegramAccountUpdateOne) SetAppHash(s string) *TelegramAccountUpdateOne {
+	tauo.mutation.SetAppHash(s)
+	return tauo
+}
+
+// SetNillableAppHash sets the "app_hash" field if the given value is not nil.
+func (tauo *TelegramAccountUpdateOne) SetNillableAppHash(s *string) *TelegramAccountUpdateOne {
+	if s != nil {
+		tauo.SetAppHash(*s)
+	}
+	return tauo
+}
+
+// ClearAppHash clears the value of the "app_hash" field.
+func (tauo *TelegramAccountUpdateOne) ClearAppHash() *TelegramAccountUpdateOne {
+	tauo.mutation.ClearAppHash()
+	return tauo
+}
+
+// AddServiceMessageIDs adds the "service_messages" edge to the TelegramServiceMessage entity by IDs.
+func (tauo *TelegramAccountUpdateOne) AddServiceMessageIDs(ids ...int) *TelegramAccountUpdateOne {
+	tauo.mutation.AddServiceMessageIDs(ids...)
+	return tauo
+}
+
+// AddServiceMessages adds the "service_messages" edges to the TelegramServiceMessage entity.
+func (tauo *TelegramAccountUpdateOne) AddServiceMessages(t ...*TelegramServiceMessage) *TelegramAccountUpdateOne {
+	ids := make([]int, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return tauo.AddServiceMessageIDs(ids...)
+}
+
 // Mutation returns the TelegramAccountMutation object of the builder.
 func (tauo *TelegramAccountUpdateOne) Mutation() *TelegramAccountMutation {
 	return tauo.mutation
 }
 
+// ClearServiceMessages clears all "service_messages" edges to the TelegramServiceMessage entity.
+func (tauo *TelegramAccountUpdateOne) ClearServiceMessages() *TelegramAccountUpdateOne {
+	tauo.mutation.ClearServiceMessages()
+	return tauo
+}
+
+// RemoveServiceMessageIDs removes the "service_messages" edge to TelegramServiceMessage entities by IDs.
+func (tauo *TelegramAccountUpdateOne) RemoveServiceMessageIDs(ids ...int) *TelegramAccountUpdateOne {
+	tauo.mutation.RemoveServiceMessageIDs(ids...)
+	return tauo
+}
+
+// RemoveServiceMessages removes "service_messages" edges to TelegramServiceMessage entities.
+func (tauo *TelegramAccountUpdateOne) RemoveServiceMessages(t ...*TelegramServiceMessage) *TelegramAccountUpdateOne {
+	ids := make([]int, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return tauo.RemoveServiceMessageIDs(ids...)
+}
+
 // Where appends a list predicates to the TelegramAccountUpdate builder.
 func (tauo *TelegramAccountUpdateOne) Where(ps ...predicate.TelegramAccount) *TelegramAccountUpdateOne {
 	tauo.mutation.Where(ps...)
@@ -338,6 +725,11 @@ func (tauo *TelegramAccountUpdateOne) check() error {
 			return &ValidationError{Name: "state", err: fmt.Errorf(`ent: validator failed for field "TelegramAccount.state": %w`, err)}
 		}
 	}
+	if v, ok := tauo.mutation.DcList(); ok {
+		if err := telegramaccount.DcListValidator(v); err != nil {
+			return &ValidationError{Name: "dc_list", err: fmt.Errorf(`ent: validator failed for field "TelegramAccount.dc_list": %w`, err)}
+		}
+	}
 	return nil
 }
 
@@ -394,6 +786,87 @@ func (tauo *TelegramAccountUpdateOne) sqlSave(ctx context.Context) (_node *Teleg
 	if tauo.mutation.SessionDataCleared() {
 		_spec.ClearField(telegramaccount.FieldSessionData, field.TypeBytes)
 	}
+	if value, ok := tauo.mutation.Password(); ok {
+		_spec.SetField(telegramaccount.FieldPassword, field.TypeBytes, value)
+	}
+	if tauo.mutation.PasswordCleared() {
+		_spec.ClearField(telegramaccount.FieldPassword, field.TypeBytes)
+	}
+	if value, ok := tauo.mutation.Disabled(); ok {
+		_spec.SetField(telegramaccount.FieldDisabled, field.TypeBool, value)
+	}
+	if value, ok := tauo.mutation.DcList(); ok {
+		_spec.SetField(telegramaccount.FieldDcList, field.TypeEnum, value)
+	}
+	if value, ok := tauo.mutation.Dc(); ok {
+		_spec.SetField(telegramaccount.FieldDc, field.TypeInt, value)
+	}
+	if value, ok := tauo.mutation.AddedDc(); ok {
+		_spec.AddField(telegramaccount.FieldDc, field.TypeInt, value)
+	}
+	if tauo.mutation.DcCleared() {
+		_spec.ClearField(telegramaccount.FieldDc, field.TypeInt)
+	}
+	if value, ok := tauo.mutation.AppID(); ok {
+		_spec.SetField(telegramaccount.FieldAppID, field.TypeInt, value)
+	}
+	if value, ok := tauo.mutation.AddedAppID(); ok {
+		_spec.AddField(telegramaccount.FieldAppID, field.TypeInt, value)
+	}
+	if tauo.mutation.AppIDCleared() {
+		_spec.ClearField(telegramaccount.FieldAppID, field.TypeInt)
+	}
+	if value, ok := tauo.mutation.AppHash(); ok {
+		_spec.SetField(telegramaccount.FieldAppHash, field.TypeString, value)
+	}
+	if tauo.mutation.AppHashCleared() {
+		_spec.ClearField(telegramaccount.FieldAppHash, field.TypeString)
+	}
+	if tauo.mutation.ServiceMessagesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   telegramaccount.ServiceMessagesTable,
+			Columns: []string{telegramaccount.ServiceMessagesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(telegramservicemessage.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tauo.mutation.RemovedServiceMessagesIDs(); len(nodes) > 0 && !tauo.mutation.ServiceMessagesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   telegramaccount.ServiceMessagesTable,
+			Columns: []string{telegramaccount.ServiceMessagesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(telegramservicemessage.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tauo.mutation.ServiceMessagesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   telegramaccount.ServiceMessagesTable,
+			Columns: []string{telegramaccount.ServiceMessagesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(telegramservicemessage.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_node = &TelegramAccount{config: tauo.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
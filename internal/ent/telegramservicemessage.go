@@ -0,0 +1,179 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/gotd/bot/internal/ent/telegramaccount"
+	"github.com/gotd/bot/internal/ent/telegramservicemessage"
+)
+
+// TelegramServiceMessage is the model entity for the TelegramServiceMessage schema.
+type TelegramServiceMessage struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// Phone number of account without +
+	AccountID string `json:"account_id,omitempty"`
+	// Telegram message ID
+	MessageID int `json:"message_id,omitempty"`
+	// Parsed message type
+	Type telegramservicemessage.Type `json:"type,omitempty"`
+	// Text holds the value of the "text" field.
+	Text string `json:"text,omitempty"`
+	// Message date
+	Date time.Time `json:"date,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the TelegramServiceMessageQuery when eager-loading is set.
+	Edges        TelegramServiceMessageEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// TelegramServiceMessageEdges holds the relations/edges for other nodes in the graph.
+type TelegramServiceMessageEdges struct {
+	// Account holds the value of the account edge.
+	Account *TelegramAccount `json:"account,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// AccountOrErr returns the Account value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e TelegramServiceMessageEdges) AccountOrErr() (*TelegramAccount, error) {
+	if e.Account != nil {
+		return e.Account, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: telegramaccount.Label}
+	}
+	return nil, &NotLoadedError{edge: "account"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*TelegramServiceMessage) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case telegramservicemessage.FieldID, telegramservicemessage.FieldMessageID:
+			values[i] = new(sql.NullInt64)
+		case telegramservicemessage.FieldAccountID, telegramservicemessage.FieldType, telegramservicemessage.FieldText:
+			values[i] = new(sql.NullString)
+		case telegramservicemessage.FieldDate:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the TelegramServiceMessage fields.
+func (tsm *TelegramServiceMessage) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case telegramservicemessage.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			tsm.ID = int(value.Int64)
+		case telegramservicemessage.FieldAccountID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field account_id", values[i])
+			} else if value.Valid {
+				tsm.AccountID = value.String
+			}
+		case telegramservicemessage.FieldMessageID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field message_id", values[i])
+			} else if value.Valid {
+				tsm.MessageID = int(value.Int64)
+			}
+		case telegramservicemessage.FieldType:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field type", values[i])
+			} else if value.Valid {
+				tsm.Type = telegramservicemessage.Type(value.String)
+			}
+		case telegramservicemessage.FieldText:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field text", values[i])
+			} else if value.Valid {
+				tsm.Text = value.String
+			}
+		case telegramservicemessage.FieldDate:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field date", values[i])
+			} else if value.Valid {
+				tsm.Date = value.Time
+			}
+		default:
+			tsm.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the TelegramServiceMessage.
+// This includes values selected through modifiers, order, etc.
+func (tsm *TelegramServiceMessage) Value(name string) (ent.Value, error) {
+	return tsm.selectValues.Get(name)
+}
+
+// QueryAccount queries the "account" edge of the TelegramServiceMessage entity.
+func (tsm *TelegramServiceMessage) QueryAccount() *TelegramAccountQuery {
+	return NewTelegramServiceMessageClient(tsm.config).QueryAccount(tsm)
+}
+
+// Update returns a builder for updating this TelegramServiceMessage.
+// Note that you need to call TelegramServiceMessage.Unwrap() before calling this method if this TelegramServiceMessage
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (tsm *TelegramServiceMessage) Update() *TelegramServiceMessageUpdateOne {
+	return NewTelegramServiceMessageClient(tsm.config).UpdateOne(tsm)
+}
+
+// Unwrap unwraps the TelegramServiceMessage entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (tsm *TelegramServiceMessage) Unwrap() *TelegramServiceMessage {
+	_tx, ok := tsm.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: TelegramServiceMessage is not a transactional entity")
+	}
+	tsm.config.driver = _tx.drv
+	return tsm
+}
+
+// String implements the fmt.Stringer.
+func (tsm *TelegramServiceMessage) String() string {
+	var builder strings.Builder
+	builder.WriteString("TelegramServiceMessage(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", tsm.ID))
+	builder.WriteString("account_id=")
+	builder.WriteString(tsm.AccountID)
+	builder.WriteString(", ")
+	builder.WriteString("message_id=")
+	builder.WriteString(fmt.Sprintf("%v", tsm.MessageID))
+	builder.WriteString(", ")
+	builder.WriteString("type=")
+	builder.WriteString(fmt.Sprintf("%v", tsm.Type))
+	builder.WriteString(", ")
+	builder.WriteString("text=")
+	builder.WriteString(tsm.Text)
+	builder.WriteString(", ")
+	builder.WriteString("date=")
+	builder.WriteString(tsm.Date.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// TelegramServiceMessages is a parsable slice of TelegramServiceMessage.
+type TelegramServiceMessages []*TelegramServiceMessage
@@ -0,0 +1,131 @@
+// Code generated by ent, DO NOT EDIT.
+
+package telegramservicemessage
+
+import (
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+const (
+	// Label holds the string label denoting the telegramservicemessage type in the database.
+	Label = "telegram_service_message"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldAccountID holds the string denoting the account_id field in the database.
+	FieldAccountID = "account_id"
+	// FieldMessageID holds the string denoting the message_id field in the database.
+	FieldMessageID = "message_id"
+	// FieldType holds the string denoting the type field in the database.
+	FieldType = "type"
+	// FieldText holds the string denoting the text field in the database.
+	FieldText = "text"
+	// FieldDate holds the string denoting the date field in the database.
+	FieldDate = "date"
+	// EdgeAccount holds the string denoting the account edge name in mutations.
+	EdgeAccount = "account"
+	// Table holds the table name of the telegramservicemessage in the database.
+	Table = "telegram_service_messages"
+	// AccountTable is the table that holds the account relation/edge.
+	AccountTable = "telegram_service_messages"
+	// AccountInverseTable is the table name for the TelegramAccount entity.
+	// It exists in this package in order to avoid circular dependency with the "telegramaccount" package.
+	AccountInverseTable = "telegram_accounts"
+	// AccountColumn is the table column denoting the account relation/edge.
+	AccountColumn = "account_id"
+)
+
+// Columns holds all SQL columns for telegramservicemessage fields.
+var Columns = []string{
+	FieldID,
+	FieldAccountID,
+	FieldMessageID,
+	FieldType,
+	FieldText,
+	FieldDate,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+// Type defines the type for the "type" enum field.
+type Type string
+
+// Type values.
+const (
+	TypeCode              Type = "Code"
+	TypeNewLogin          Type = "NewLogin"
+	TypeEmailVerification Type = "EmailVerification"
+	TypeFrozen            Type = "Frozen"
+	TypeOther             Type = "Other"
+)
+
+func (_type Type) String() string {
+	return string(_type)
+}
+
+// TypeValidator is a validator for the "type" field enum values. It is called by the builders before save.
+func TypeValidator(_type Type) error {
+	switch _type {
+	case TypeCode, TypeNewLogin, TypeEmailVerification, TypeFrozen, TypeOther:
+		return nil
+	default:
+		return fmt.Errorf("telegramservicemessage: invalid enum value for type field: %q", _type)
+	}
+}
+
+// OrderOption defines the ordering options for the TelegramServiceMessage queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByAccountID orders the results by the account_id field.
+func ByAccountID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAccountID, opts...).ToFunc()
+}
+
+// ByMessageID orders the results by the message_id field.
+func ByMessageID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMessageID, opts...).ToFunc()
+}
+
+// ByType orders the results by the type field.
+func ByType(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldType, opts...).ToFunc()
+}
+
+// ByText orders the results by the text field.
+func ByText(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldText, opts...).ToFunc()
+}
+
+// ByDate orders the results by the date field.
+func ByDate(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDate, opts...).ToFunc()
+}
+
+// ByAccountField orders the results by account field.
+func ByAccountField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newAccountStep(), sql.OrderByField(field, opts...))
+	}
+}
+func newAccountStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(AccountInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, AccountTable, AccountColumn),
+	)
+}
@@ -0,0 +1,344 @@
+// Code generated by ent, DO NOT EDIT.
+
+package telegramservicemessage
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/gotd/bot/internal/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.TelegramServiceMessage {
+	return predicate.TelegramServiceMessage(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.TelegramServiceMessage {
+	return predicate.TelegramServiceMessage(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.TelegramServiceMessage {
+	return predicate.TelegramServiceMessage(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.TelegramServiceMessage {
+	return predicate.TelegramServiceMessage(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.TelegramServiceMessage {
+	return predicate.TelegramServiceMessage(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.TelegramServiceMessage {
+	return predicate.TelegramServiceMessage(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.TelegramServiceMessage {
+	return predicate.TelegramServiceMessage(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.TelegramServiceMessage {
+	return predicate.TelegramServiceMessage(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.TelegramServiceMessage {
+	return predicate.TelegramServiceMessage(sql.FieldLTE(FieldID, id))
+}
+
+// AccountID applies equality check predicate on the "account_id" field. It's identical to AccountIDEQ.
+func AccountID(v string) predicate.TelegramServiceMessage {
+	return predicate.TelegramServiceMessage(sql.FieldEQ(FieldAccountID, v))
+}
+
+// MessageID applies equality check predicate on the "message_id" field. It's identical to MessageIDEQ.
+func MessageID(v int) predicate.TelegramServiceMessage {
+	return predicate.TelegramServiceMessage(sql.FieldEQ(FieldMessageID, v))
+}
+
+// Text applies equality check predicate on the "text" field. It's identical to TextEQ.
+func Text(v string) predicate.TelegramServiceMessage {
+	return predicate.TelegramServiceMessage(sql.FieldEQ(FieldText, v))
+}
+
+// Date applies equality check predicate on the "date" field. It's identical to DateEQ.
+func Date(v time.Time) predicate.TelegramServiceMessage {
+	return predicate.TelegramServiceMessage(sql.FieldEQ(FieldDate, v))
+}
+
+// AccountIDEQ applies the EQ predicate on the "account_id" field.
+func AccountIDEQ(v string) predicate.TelegramServiceMessage {
+	return predicate.TelegramServiceMessage(sql.FieldEQ(FieldAccountID, v))
+}
+
+// AccountIDNEQ applies the NEQ predicate on the "account_id" field.
+func AccountIDNEQ(v string) predicate.TelegramServiceMessage {
+	return predicate.TelegramServiceMessage(sql.FieldNEQ(FieldAccountID, v))
+}
+
+// AccountIDIn applies the In predicate on the "account_id" field.
+func AccountIDIn(vs ...string) predicate.TelegramServiceMessage {
+	return predicate.TelegramServiceMessage(sql.FieldIn(FieldAccountID, vs...))
+}
+
+// AccountIDNotIn applies the NotIn predicate on the "account_id" field.
+func AccountIDNotIn(vs ...string) predicate.TelegramServiceMessage {
+	return predicate.TelegramServiceMessage(sql.FieldNotIn(FieldAccountID, vs...))
+}
+
+// AccountIDGT applies the GT predicate on the "account_id" field.
+func AccountIDGT(v string) predicate.TelegramServiceMessage {
+	return predicate.TelegramServiceMessage(sql.FieldGT(FieldAccountID, v))
+}
+
+// AccountIDGTE applies the GTE predicate on the "account_id" field.
+func AccountIDGTE(v string) predicate.TelegramServiceMessage {
+	return predicate.TelegramServiceMessage(sql.FieldGTE(FieldAccountID, v))
+}
+
+// AccountIDLT applies the LT predicate on the "account_id" field.
+func AccountIDLT(v string) predicate.TelegramServiceMessage {
+	return predicate.TelegramServiceMessage(sql.FieldLT(FieldAccountID, v))
+}
+
+// AccountIDLTE applies the LTE predicate on the "account_id" field.
+func AccountIDLTE(v string) predicate.TelegramServiceMessage {
+	return predicate.TelegramServiceMessage(sql.FieldLTE(FieldAccountID, v))
+}
+
+// AccountIDContains applies the Contains predicate on the "account_id" field.
+func AccountIDContains(v string) predicate.TelegramServiceMessage {
+	return predicate.TelegramServiceMessage(sql.FieldContains(FieldAccountID, v))
+}
+
+// AccountIDHasPrefix applies the HasPrefix predicate on the "account_id" field.
+func AccountIDHasPrefix(v string) predicate.TelegramServiceMessage {
+	return predicate.TelegramServiceMessage(sql.FieldHasPrefix(FieldAccountID, v))
+}
+
+// AccountIDHasSuffix applies the HasSuffix predicate on the "account_id" field.
+func AccountIDHasSuffix(v string) predicate.TelegramServiceMessage {
+	return predicate.TelegramServiceMessage(sql.FieldHasSuffix(FieldAccountID, v))
+}
+
+// AccountIDEqualFold applies the EqualFold predicate on the "account_id" field.
+func AccountIDEqualFold(v string) predicate.TelegramServiceMessage {
+	return predicate.TelegramServiceMessage(sql.FieldEqualFold(FieldAccountID, v))
+}
+
+// AccountIDContainsFold applies the ContainsFold predicate on the "account_id" field.
+func AccountIDContainsFold(v string) predicate.TelegramServiceMessage {
+	return predicate.TelegramServiceMessage(sql.FieldContainsFold(FieldAccountID, v))
+}
+
+// MessageIDEQ applies the EQ predicate on the "message_id" field.
+func MessageIDEQ(v int) predicate.TelegramServiceMessage {
+	return predicate.TelegramServiceMessage(sql.FieldEQ(FieldMessageID, v))
+}
+
+// MessageIDNEQ applies the NEQ predicate on the "message_id" field.
+func MessageIDNEQ(v int) predicate.TelegramServiceMessage {
+	return predicate.TelegramServiceMessage(sql.FieldNEQ(FieldMessageID, v))
+}
+
+// MessageIDIn applies the In predicate on the "message_id" field.
+func MessageIDIn(vs ...int) predicate.TelegramServiceMessage {
+	return predicate.TelegramServiceMessage(sql.FieldIn(FieldMessageID, vs...))
+}
+
+// MessageIDNotIn applies the NotIn predicate on the "message_id" field.
+func MessageIDNotIn(vs ...int) predicate.TelegramServiceMessage {
+	return predicate.TelegramServiceMessage(sql.FieldNotIn(FieldMessageID, vs...))
+}
+
+// MessageIDGT applies the GT predicate on the "message_id" field.
+func MessageIDGT(v int) predicate.TelegramServiceMessage {
+	return predicate.TelegramServiceMessage(sql.FieldGT(FieldMessageID, v))
+}
+
+// MessageIDGTE applies the GTE predicate on the "message_id" field.
+func MessageIDGTE(v int) predicate.TelegramServiceMessage {
+	return predicate.TelegramServiceMessage(sql.FieldGTE(FieldMessageID, v))
+}
+
+// MessageIDLT applies the LT predicate on the "message_id" field.
+func MessageIDLT(v int) predicate.TelegramServiceMessage {
+	return predicate.TelegramServiceMessage(sql.FieldLT(FieldMessageID, v))
+}
+
+// MessageIDLTE applies the LTE predicate on the "message_id" field.
+func MessageIDLTE(v int) predicate.TelegramServiceMessage {
+	return predicate.TelegramServiceMessage(sql.FieldLTE(FieldMessageID, v))
+}
+
+// TypeEQ applies the EQ predicate on the "type" field.
+func TypeEQ(v Type) predicate.TelegramServiceMessage {
+	return predicate.TelegramServiceMessage(sql.FieldEQ(FieldType, v))
+}
+
+// TypeNEQ applies the NEQ predicate on the "type" field.
+func TypeNEQ(v Type) predicate.TelegramServiceMessage {
+	return predicate.TelegramServiceMessage(sql.FieldNEQ(FieldType, v))
+}
+
+// TypeIn applies the In predicate on the "type" field.
+func TypeIn(vs ...Type) predicate.TelegramServiceMessage {
+	return predicate.TelegramServiceMessage(sql.FieldIn(FieldType, vs...))
+}
+
+// TypeNotIn applies the NotIn predicate on the "type" field.
+func TypeNotIn(vs ...Type) predicate.TelegramServiceMessage {
+	return predicate.TelegramServiceMessage(sql.FieldNotIn(FieldType, vs...))
+}
+
+// TextEQ applies the EQ predicate on the "text" field.
+func TextEQ(v string) predicate.TelegramServiceMessage {
+	return predicate.TelegramServiceMessage(sql.FieldEQ(FieldText, v))
+}
+
+// TextNEQ applies the NEQ predicate on the "text" field.
+func TextNEQ(v string) predicate.TelegramServiceMessage {
+	return predicate.TelegramServiceMessage(sql.FieldNEQ(FieldText, v))
+}
+
+// TextIn applies the In predicate on the "text" field.
+func TextIn(vs ...string) predicate.TelegramServiceMessage {
+	return predicate.TelegramServiceMessage(sql.FieldIn(FieldText, vs...))
+}
+
+// TextNotIn applies the NotIn predicate on the "text" field.
+func TextNotIn(vs ...string) predicate.TelegramServiceMessage {
+	return predicate.TelegramServiceMessage(sql.FieldNotIn(FieldText, vs...))
+}
+
+// TextGT applies the GT predicate on the "text" field.
+func TextGT(v string) predicate.TelegramServiceMessage {
+	return predicate.TelegramServiceMessage(sql.FieldGT(FieldText, v))
+}
+
+// TextGTE applies the GTE predicate on the "text" field.
+func TextGTE(v string) predicate.TelegramServiceMessage {
+	return predicate.TelegramServiceMessage(sql.FieldGTE(FieldText, v))
+}
+
+// TextLT applies the LT predicate on the "text" field.
+func TextLT(v string) predicate.TelegramServiceMessage {
+	return predicate.TelegramServiceMessage(sql.FieldLT(FieldText, v))
+}
+
+// TextLTE applies the LTE predicate on the "text" field.
+func TextLTE(v string) predicate.TelegramServiceMessage {
+	return predicate.TelegramServiceMessage(sql.FieldLTE(FieldText, v))
+}
+
+// TextContains applies the Contains predicate on the "text" field.
+func TextContains(v string) predicate.TelegramServiceMessage {
+	return predicate.TelegramServiceMessage(sql.FieldContains(FieldText, v))
+}
+
+// TextHasPrefix applies the HasPrefix predicate on the "text" field.
+func TextHasPrefix(v string) predicate.TelegramServiceMessage {
+	return predicate.TelegramServiceMessage(sql.FieldHasPrefix(FieldText, v))
+}
+
+// TextHasSuffix applies the HasSuffix predicate on the "text" field.
+func TextHasSuffix(v string) predicate.TelegramServiceMessage {
+	return predicate.TelegramServiceMessage(sql.FieldHasSuffix(FieldText, v))
+}
+
+// TextEqualFold applies the EqualFold predicate on the "text" field.
+func TextEqualFold(v string) predicate.TelegramServiceMessage {
+	return predicate.TelegramServiceMessage(sql.FieldEqualFold(FieldText, v))
+}
+
+// TextContainsFold applies the ContainsFold predicate on the "text" field.
+func TextContainsFold(v string) predicate.TelegramServiceMessage {
+	return predicate.TelegramServiceMessage(sql.FieldContainsFold(FieldText, v))
+}
+
+// DateEQ applies the EQ predicate on the "date" field.
+func DateEQ(v time.Time) predicate.TelegramServiceMessage {
+	return predicate.TelegramServiceMessage(sql.FieldEQ(FieldDate, v))
+}
+
+// DateNEQ applies the NEQ predicate on the "date" field.
+func DateNEQ(v time.Time) predicate.TelegramServiceMessage {
+	return predicate.TelegramServiceMessage(sql.FieldNEQ(FieldDate, v))
+}
+
+// DateIn applies the In predicate on the "date" field.
+func DateIn(vs ...time.Time) predicate.TelegramServiceMessage {
+	return predicate.TelegramServiceMessage(sql.FieldIn(FieldDate, vs...))
+}
+
+// DateNotIn applies the NotIn predicate on the "date" field.
+func DateNotIn(vs ...time.Time) predicate.TelegramServiceMessage {
+	return predicate.TelegramServiceMessage(sql.FieldNotIn(FieldDate, vs...))
+}
+
+// DateGT applies the GT predicate on the "date" field.
+func DateGT(v time.Time) predicate.TelegramServiceMessage {
+	return predicate.TelegramServiceMessage(sql.FieldGT(FieldDate, v))
+}
+
+// DateGTE applies the GTE predicate on the "date" field.
+func DateGTE(v time.Time) predicate.TelegramServiceMessage {
+	return predicate.TelegramServiceMessage(sql.FieldGTE(FieldDate, v))
+}
+
+// DateLT applies the LT predicate on the "date" field.
+func DateLT(v time.Time) predicate.TelegramServiceMessage {
+	return predicate.TelegramServiceMessage(sql.FieldLT(FieldDate, v))
+}
+
+// DateLTE applies the LTE predicate on the "date" field.
+func DateLTE(v time.Time) predicate.TelegramServiceMessage {
+	return predicate.TelegramServiceMessage(sql.FieldLTE(FieldDate, v))
+}
+
+// HasAccount applies the HasEdge predicate on the "account" edge.
+func HasAccount() predicate.TelegramServiceMessage {
+	return predicate.TelegramServiceMessage(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, AccountTable, AccountColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasAccountWith applies the HasEdge predicate on the "account" edge with a given conditions (other predicates).
+func HasAccountWith(preds ...predicate.TelegramAccount) predicate.TelegramServiceMessage {
+	return predicate.TelegramServiceMessage(func(s *sql.Selector) {
+		step := newAccountStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.TelegramServiceMessage) predicate.TelegramServiceMessage {
+	return predicate.TelegramServiceMessage(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.TelegramServiceMessage) predicate.TelegramServiceMessage {
+	return predicate.TelegramServiceMessage(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.TelegramServiceMessage) predicate.TelegramServiceMessage {
+	return predicate.TelegramServiceMessage(sql.NotPredicates(p))
+}
@@ -0,0 +1,714 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/gotd/bot/internal/ent/telegramaccount"
+	"github.com/gotd/bot/internal/ent/telegramservicemessage"
+)
+
+// TelegramServiceMessageCreate is the builder for creating a TelegramServiceMessage entity.
+type TelegramServiceMessageCreate struct {
+	config
+	mutation *TelegramServiceMessageMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetAccountID sets the "account_id" field.
+func (tsmc *TelegramServiceMessageCreate) SetAccountID(s string) *TelegramServiceMessageCreate {
+	tsmc.mutation.SetAccountID(s)
+	return tsmc
+}
+
+// SetMessageID sets the "message_id" field.
+func (tsmc *TelegramServiceMessageCreate) SetMessageID(i int) *TelegramServiceMessageCreate {
+	tsmc.mutation.SetMessageID(i)
+	return tsmc
+}
+
+// SetType sets the "type" field.
+func (tsmc *TelegramServiceMessageCreate) SetType(t telegramservicemessage.Type) *TelegramServiceMessageCreate {
+	tsmc.mutation.SetType(t)
+	return tsmc
+}
+
+// SetText sets the "text" field.
+func (tsmc *TelegramServiceMessageCreate) SetText(s string) *TelegramServiceMessageCreate {
+	tsmc.mutation.SetText(s)
+	return tsmc
+}
+
+// SetDate sets the "date" field.
+func (tsmc *TelegramServiceMessageCreate) SetDate(t time.Time) *TelegramServiceMessageCreate {
+	tsmc.mutation.SetDate(t)
+	return tsmc
+}
+
+// SetAccount sets the "account" edge to the TelegramAccount entity.
+func (tsmc *TelegramServiceMessageCreate) SetAccount(t *TelegramAccount) *TelegramServiceMessageCreate {
+	return tsmc.SetAccountID(t.ID)
+}
+
+// Mutation returns the TelegramServiceMessageMutation object of the builder.
+func (tsmc *TelegramServiceMessageCreate) Mutation() *TelegramServiceMessageMutation {
+	return tsmc.mutation
+}
+
+// Save creates the TelegramServiceMessage in the database.
+func (tsmc *TelegramServiceMessageCreate) Save(ctx context.Context) (*TelegramServiceMessage, error) {
+	return withHooks(ctx, tsmc.sqlSave, tsmc.mutation, tsmc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (tsmc *TelegramServiceMessageCreate) SaveX(ctx context.Context) *TelegramServiceMessage {
+	v, err := tsmc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (tsmc *TelegramServiceMessageCreate) Exec(ctx context.Context) error {
+	_, err := tsmc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (tsmc *TelegramServiceMessageCreate) ExecX(ctx context.Context) {
+	if err := tsmc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (tsmc *TelegramServiceMessageCreate) check() error {
+	if _, ok := tsmc.mutation.AccountID(); !ok {
+		return &ValidationError{Name: "account_id", err: errors.New(`ent: missing required field "TelegramServiceMessage.account_id"`)}
+	}
+	if _, ok := tsmc.mutation.MessageID(); !ok {
+		return &ValidationError{Name: "message_id", err: errors.New(`ent: missing required field "TelegramServiceMessage.message_id"`)}
+	}
+	if _, ok := tsmc.mutation.GetType(); !ok {
+		return &ValidationError{Name: "type", err: errors.New(`ent: missing required field "TelegramServiceMessage.type"`)}
+	}
+	if v, ok := tsmc.mutation.GetType(); ok {
+		if err := telegramservicemessage.TypeValidator(v); err != nil {
+			return &ValidationError{Name: "type", err: fmt.Errorf(`ent: validator failed for field "TelegramServiceMessage.type": %w`, err)}
+		}
+	}
+	if _, ok := tsmc.mutation.Text(); !ok {
+		return &ValidationError{Name: "text", err: errors.New(`ent: missing required field "TelegramServiceMessage.text"`)}
+	}
+	if _, ok := tsmc.mutation.Date(); !ok {
+		return &ValidationError{Name: "date", err: errors.New(`ent: missing required field "TelegramServiceMessage.date"`)}
+	}
+	if len(tsmc.mutation.AccountIDs()) == 0 {
+		return &ValidationError{Name: "account", err: errors.New(`ent: missing required edge "TelegramServiceMessage.account"`)}
+	}
+	return nil
+}
+
+func (tsmc *TelegramServiceMessageCreate) sqlSave(ctx context.Context) (*TelegramServiceMessage, error) {
+	if err := tsmc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := tsmc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, tsmc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	tsmc.mutation.id = &_node.ID
+	tsmc.mutation.done = true
+	return _node, nil
+}
+
+func (tsmc *TelegramServiceMessageCreate) createSpec() (*TelegramServiceMessage, *sqlgraph.CreateSpec) {
+	var (
+		_node = &TelegramServiceMessage{config: tsmc.config}
+		_spec = sqlgraph.NewCreateSpec(telegramservicemessage.Table, sqlgraph.NewFieldSpec(telegramservicemessage.FieldID, field.TypeInt))
+	)
+	_spec.OnConflict = tsmc.conflict
+	if value, ok := tsmc.mutation.MessageID(); ok {
+		_spec.SetField(telegramservicemessage.FieldMessageID, field.TypeInt, value)
+		_node.MessageID = value
+	}
+	if value, ok := tsmc.mutation.GetType(); ok {
+		_spec.SetField(telegramservicemessage.FieldType, field.TypeEnum, value)
+		_node.Type = value
+	}
+	if value, ok := tsmc.mutation.Text(); ok {
+		_spec.SetField(telegramservicemessage.FieldText, field.TypeString, value)
+		_node.Text = value
+	}
+	if value, ok := tsmc.mutation.Date(); ok {
+		_spec.SetField(telegramservicemessage.FieldDate, field.TypeTime, value)
+		_node.Date = value
+	}
+	if nodes := tsmc.mutation.AccountIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   telegramservicemessage.AccountTable,
+			Columns: []string{telegramservicemessage.AccountColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(telegramaccount.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.AccountID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.TelegramServiceMessage.Create().
+//		SetAccountID(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.TelegramServiceMessageUpsert) {
+//			SetAccountID(v+v).
+//		}).
+//		Exec(ctx)
+func (tsmc *TelegramServiceMessageCreate) OnConflict(opts ...sql.ConflictOption) *TelegramServiceMessageUpsertOne {
+	tsmc.conflict = opts
+	return &TelegramServiceMessageUpsertOne{
+		create: tsmc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.TelegramServiceMessage.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (tsmc *TelegramServiceMessageCreate) OnConflictColumns(columns ...string) *TelegramServiceMessageUpsertOne {
+	tsmc.conflict = append(tsmc.conflict, sql.ConflictColumns(columns...))
+	return &TelegramServiceMessageUpsertOne{
+		create: tsmc,
+	}
+}
+
+type (
+	// TelegramServiceMessageUpsertOne is the builder for "upsert"-ing
+	//  one TelegramServiceMessage node.
+	TelegramServiceMessageUpsertOne struct {
+		create *TelegramServiceMessageCreate
+	}
+
+	// TelegramServiceMessageUpsert is the "OnConflict" setter.
+	TelegramServiceMessageUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetAccountID sets the "account_id" field.
+func (u *TelegramServiceMessageUpsert) SetAccountID(v string) *TelegramServiceMessageUpsert {
+	u.Set(telegramservicemessage.FieldAccountID, v)
+	return u
+}
+
+// UpdateAccountID sets the "account_id" field to the value that was provided on create.
+func (u *TelegramServiceMessageUpsert) UpdateAccountID() *TelegramServiceMessageUpsert {
+	u.SetExcluded(telegramservicemessage.FieldAccountID)
+	return u
+}
+
+// SetMessageID sets the "message_id" field.
+func (u *TelegramServiceMessageUpsert) SetMessageID(v int) *TelegramServiceMessageUpsert {
+	u.Set(telegramservicemessage.FieldMessageID, v)
+	return u
+}
+
+// UpdateMessageID sets the "message_id" field to the value that was provided on create.
+func (u *TelegramServiceMessageUpsert) UpdateMessageID() *TelegramServiceMessageUpsert {
+	u.SetExcluded(telegramservicemessage.FieldMessageID)
+	return u
+}
+
+// AddMessageID adds v to the "message_id" field.
+func (u *TelegramServiceMessageUpsert) AddMessageID(v int) *TelegramServiceMessageUpsert {
+	u.Add(telegramservicemessage.FieldMessageID, v)
+	return u
+}
+
+// SetType sets the "type" field.
+func (u *TelegramServiceMessageUpsert) SetType(v telegramservicemessage.Type) *TelegramServiceMessageUpsert {
+	u.Set(telegramservicemessage.FieldType, v)
+	return u
+}
+
+// UpdateType sets the "type" field to the value that was provided on create.
+func (u *TelegramServiceMessageUpsert) UpdateType() *TelegramServiceMessageUpsert {
+	u.SetExcluded(telegramservicemessage.FieldType)
+	return u
+}
+
+// SetText sets the "text" field.
+func (u *TelegramServiceMessageUpsert) SetText(v string) *TelegramServiceMessageUpsert {
+	u.Set(telegramservicemessage.FieldText, v)
+	return u
+}
+
+// UpdateText sets the "text" field to the value that was provided on create.
+func (u *TelegramServiceMessageUpsert) UpdateText() *TelegramServiceMessageUpsert {
+	u.SetExcluded(telegramservicemessage.FieldText)
+	return u
+}
+
+// SetDate sets the "date" field.
+func (u *TelegramServiceMessageUpsert) SetDate(v time.Time) *TelegramServiceMessageUpsert {
+	u.Set(telegramservicemessage.FieldDate, v)
+	return u
+}
+
+// UpdateDate sets the "date" field to the value that was provided on create.
+func (u *TelegramServiceMessageUpsert) UpdateDate() *TelegramServiceMessageUpsert {
+	u.SetExcluded(telegramservicemessage.FieldDate)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create.
+// Using this option is equivalent to using:
+//
+//	client.TelegramServiceMessage.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *TelegramServiceMessageUpsertOne) UpdateNewValues() *TelegramServiceMessageUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.TelegramServiceMessage.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *TelegramServiceMessageUpsertOne) Ignore() *TelegramServiceMessageUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *TelegramServiceMessageUpsertOne) DoNothing() *TelegramServiceMessageUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the TelegramServiceMessageCreate.OnConflict
+// documentation for more info.
+func (u *TelegramServiceMessageUpsertOne) Update(set func(*TelegramServiceMessageUpsert)) *TelegramServiceMessageUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&TelegramServiceMessageUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetAccountID sets the "account_id" field.
+func (u *TelegramServiceMessageUpsertOne) SetAccountID(v string) *TelegramServiceMessageUpsertOne {
+	return u.Update(func(s *TelegramServiceMessageUpsert) {
+		s.SetAccountID(v)
+	})
+}
+
+// UpdateAccountID sets the "account_id" field to the value that was provided on create.
+func (u *TelegramServiceMessageUpsertOne) UpdateAccountID() *TelegramServiceMessageUpsertOne {
+	return u.Update(func(s *TelegramServiceMessageUpsert) {
+		s.UpdateAccountID()
+	})
+}
+
+// SetMessageID sets the "message_id" field.
+func (u *TelegramServiceMessageUpsertOne) SetMessageID(v int) *TelegramServiceMessageUpsertOne {
+	return u.Update(func(s *TelegramServiceMessageUpsert) {
+		s.SetMessageID(v)
+	})
+}
+
+// AddMessageID adds v to the "message_id" field.
+func (u *TelegramServiceMessageUpsertOne) AddMessageID(v int) *TelegramServiceMessageUpsertOne {
+	return u.Update(func(s *TelegramServiceMessageUpsert) {
+		s.AddMessageID(v)
+	})
+}
+
+// UpdateMessageID sets the "message_id" field to the value that was provided on create.
+func (u *TelegramServiceMessageUpsertOne) UpdateMessageID() *TelegramServiceMessageUpsertOne {
+	return u.Update(func(s *TelegramServiceMessageUpsert) {
+		s.UpdateMessageID()
+	})
+}
+
+// SetType sets the "type" field.
+func (u *TelegramServiceMessageUpsertOne) SetType(v telegramservicemessage.Type) *TelegramServiceMessageUpsertOne {
+	return u.Update(func(s *TelegramServiceMessageUpsert) {
+		s.SetType(v)
+	})
+}
+
+// UpdateType sets the "type" field to the value that was provided on create.
+func (u *TelegramServiceMessageUpsertOne) UpdateType() *TelegramServiceMessageUpsertOne {
+	return u.Update(func(s *TelegramServiceMessageUpsert) {
+		s.UpdateType()
+	})
+}
+
+// SetText sets the "text" field.
+func (u *TelegramServiceMessageUpsertOne) SetText(v string) *TelegramServiceMessageUpsertOne {
+	return u.Update(func(s *TelegramServiceMessageUpsert) {
+		s.SetText(v)
+	})
+}
+
+// UpdateText sets the "text" field to the value that was provided on create.
+func (u *TelegramServiceMessageUpsertOne) UpdateText() *TelegramServiceMessageUpsertOne {
+	return u.Update(func(s *TelegramServiceMessageUpsert) {
+		s.UpdateText()
+	})
+}
+
+// SetDate sets the "date" field.
+func (u *TelegramServiceMessageUpsertOne) SetDate(v time.Time) *TelegramServiceMessageUpsertOne {
+	return u.Update(func(s *TelegramServiceMessageUpsert) {
+		s.SetDate(v)
+	})
+}
+
+// UpdateDate sets the "date" field to the value that was provided on create.
+func (u *TelegramServiceMessageUpsertOne) UpdateDate() *TelegramServiceMessageUpsertOne {
+	return u.Update(func(s *TelegramServiceMessageUpsert) {
+		s.UpdateDate()
+	})
+}
+
+// Exec executes the query.
+func (u *TelegramServiceMessageUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for TelegramServiceMessageCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *TelegramServiceMessageUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *TelegramServiceMessageUpsertOne) ID(ctx context.Context) (id int, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *TelegramServiceMessageUpsertOne) IDX(ctx context.Context) int {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// TelegramServiceMessageCreateBulk is the builder for creating many TelegramServiceMessage entities in bulk.
+type TelegramServiceMessageCreateBulk struct {
+	config
+	err      error
+	builders []*TelegramServiceMessageCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the TelegramServiceMessage entities in the database.
+func (tsmcb *TelegramServiceMessageCreateBulk) Save(ctx context.Context) ([]*TelegramServiceMessage, error) {
+	if tsmcb.err != nil {
+		return nil, tsmcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(tsmcb.builders))
+	nodes := make([]*TelegramServiceMessage, len(tsmcb.builders))
+	mutators := make([]Mutator, len(tsmcb.builders))
+	for i := range tsmcb.builders {
+		func(i int, root context.Context) {
+			builder := tsmcb.builders[i]
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*TelegramServiceMessageMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, tsmcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = tsmcb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, tsmcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, tsmcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (tsmcb *TelegramServiceMessageCreateBulk) SaveX(ctx context.Context) []*TelegramServiceMessage {
+	v, err := tsmcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (tsmcb *TelegramServiceMessageCreateBulk) Exec(ctx context.Context) error {
+	_, err := tsmcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (tsmcb *TelegramServiceMessageCreateBulk) ExecX(ctx context.Context) {
+	if err := tsmcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.TelegramServiceMessage.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.TelegramServiceMessageUpsert) {
+//			SetAccountID(v+v).
+//		}).
+//		Exec(ctx)
+func (tsmcb *TelegramServiceMessageCreateBulk) OnConflict(opts ...sql.ConflictOption) *TelegramServiceMessageUpsertBulk {
+	tsmcb.conflict = opts
+	return &TelegramServiceMessageUpsertBulk{
+		create: tsmcb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.TelegramServiceMessage.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (tsmcb *TelegramServiceMessageCreateBulk) OnConflictColumns(columns ...string) *TelegramServiceMessageUpsertBulk {
+	tsmcb.conflict = append(tsmcb.conflict, sql.ConflictColumns(columns...))
+	return &TelegramServiceMessageUpsertBulk{
+		create: tsmcb,
+	}
+}
+
+// TelegramServiceMessageUpsertBulk is the builder for "upsert"-ing
+// a bulk of TelegramServiceMessage nodes.
+type TelegramServiceMessageUpsertBulk struct {
+	create *TelegramServiceMessageCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.TelegramServiceMessage.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *TelegramServiceMessageUpsertBulk) UpdateNewValues() *TelegramServiceMessageUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.TelegramServiceMessage.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *TelegramServiceMessageUpsertBulk) Ignore() *TelegramServiceMessageUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *TelegramServiceMessageUpsertBulk) DoNothing() *TelegramServiceMessageUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the TelegramServiceMessageCreateBulk.OnConflict
+// documentation for more info.
+func (u *TelegramServiceMessageUpsertBulk) Update(set func(*TelegramServiceMessageUpsert)) *TelegramServiceMessageUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&TelegramServiceMessageUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetAccountID sets the "account_id" field.
+func (u *TelegramServiceMessageUpsertBulk) SetAccountID(v string) *TelegramServiceMessageUpsertBulk {
+	return u.Update(func(s *TelegramServiceMessageUpsert) {
+		s.SetAccountID(v)
+	})
+}
+
+// UpdateAccountID sets the "account_id" field to the value that was provided on create.
+func (u *TelegramServiceMessageUpsertBulk) UpdateAccountID() *TelegramServiceMessageUpsertBulk {
+	return u.Update(func(s *TelegramServiceMessageUpsert) {
+		s.UpdateAccountID()
+	})
+}
+
+// SetMessageID sets the "message_id" field.
+func (u *TelegramServiceMessageUpsertBulk) SetMessageID(v int) *TelegramServiceMessageUpsertBulk {
+	return u.Update(func(s *TelegramServiceMessageUpsert) {
+		s.SetMessageID(v)
+	})
+}
+
+// AddMessageID adds v to the "message_id" field.
+func (u *TelegramServiceMessageUpsertBulk) AddMessageID(v int) *TelegramServiceMessageUpsertBulk {
+	return u.Update(func(s *TelegramServiceMessageUpsert) {
+		s.AddMessageID(v)
+	})
+}
+
+// UpdateMessageID sets the "message_id" field to the value that was provided on create.
+func (u *TelegramServiceMessageUpsertBulk) UpdateMessageID() *TelegramServiceMessageUpsertBulk {
+	return u.Update(func(s *TelegramServiceMessageUpsert) {
+		s.UpdateMessageID()
+	})
+}
+
+// SetType sets the "type" field.
+func (u *TelegramServiceMessageUpsertBulk) SetType(v telegramservicemessage.Type) *TelegramServiceMessageUpsertBulk {
+	return u.Update(func(s *TelegramServiceMessageUpsert) {
+		s.SetType(v)
+	})
+}
+
+// UpdateType sets the "type" field to the value that was provided on create.
+func (u *TelegramServiceMessageUpsertBulk) UpdateType() *TelegramServiceMessageUpsertBulk {
+	return u.Update(func(s *TelegramServiceMessageUpsert) {
+		s.UpdateType()
+	})
+}
+
+// SetText sets the "text" field.
+func (u *TelegramServiceMessageUpsertBulk) SetText(v string) *TelegramServiceMessageUpsertBulk {
+	return u.Update(func(s *TelegramServiceMessageUpsert) {
+		s.SetText(v)
+	})
+}
+
+// UpdateText sets the "text" field to the value that was provided on create.
+func (u *TelegramServiceMessageUpsertBulk) UpdateText() *TelegramServiceMessageUpsertBulk {
+	return u.Update(func(s *TelegramServiceMessageUpsert) {
+		s.UpdateText()
+	})
+}
+
+// SetDate sets the "date" field.
+func (u *TelegramServiceMessageUpsertBulk) SetDate(v time.Time) *TelegramServiceMessageUpsertBulk {
+	return u.Update(func(s *TelegramServiceMessageUpsert) {
+		s.SetDate(v)
+	})
+}
+
+// UpdateDate sets the "date" field to the value that was provided on create.
+func (u *TelegramServiceMessageUpsertBulk) UpdateDate() *TelegramServiceMessageUpsertBulk {
+	return u.Update(func(s *TelegramServiceMessageUpsert) {
+		s.UpdateDate()
+	})
+}
+
+// Exec executes the query.
+func (u *TelegramServiceMessageUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the TelegramServiceMessageCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for TelegramServiceMessageCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *TelegramServiceMessageUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
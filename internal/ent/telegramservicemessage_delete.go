@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/gotd/bot/internal/ent/predicate"
+	"github.com/gotd/bot/internal/ent/telegramservicemessage"
+)
+
+// TelegramServiceMessageDelete is the builder for deleting a TelegramServiceMessage entity.
+type TelegramServiceMessageDelete struct {
+	config
+	hooks    []Hook
+	mutation *TelegramServiceMessageMutation
+}
+
+// Where appends a list predicates to the TelegramServiceMessageDelete builder.
+func (tsmd *TelegramServiceMessageDelete) Where(ps ...predicate.TelegramServiceMessage) *TelegramServiceMessageDelete {
+	tsmd.mutation.Where(ps...)
+	return tsmd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (tsmd *TelegramServiceMessageDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, tsmd.sqlExec, tsmd.mutation, tsmd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (tsmd *TelegramServiceMessageDelete) ExecX(ctx context.Context) int {
+	n, err := tsmd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (tsmd *TelegramServiceMessageDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(telegramservicemessage.Table, sqlgraph.NewFieldSpec(telegramservicemessage.FieldID, field.TypeInt))
+	if ps := tsmd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, tsmd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	tsmd.mutation.done = true
+	return affected, err
+}
+
+// TelegramServiceMessageDeleteOne is the builder for deleting a single TelegramServiceMessage entity.
+type TelegramServiceMessageDeleteOne struct {
+	tsmd *TelegramServiceMessageDelete
+}
+
+// Where appends a list predicates to the TelegramServiceMessageDelete builder.
+func (tsmdo *TelegramServiceMessageDeleteOne) Where(ps ...predicate.TelegramServiceMessage) *TelegramServiceMessageDeleteOne {
+	tsmdo.tsmd.mutation.Where(ps...)
+	return tsmdo
+}
+
+// Exec executes the deletion query.
+func (tsmdo *TelegramServiceMessageDeleteOne) Exec(ctx context.Context) error {
+	n, err := tsmdo.tsmd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{telegramservicemessage.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (tsmdo *TelegramServiceMessageDeleteOne) ExecX(ctx context.Context) {
+	if err := tsmdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,606 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/gotd/bot/internal/ent/predicate"
+	"github.com/gotd/bot/internal/ent/telegramaccount"
+	"github.com/gotd/bot/internal/ent/telegramservicemessage"
+)
+
+// TelegramServiceMessageQuery is the builder for querying TelegramServiceMessage entities.
+type TelegramServiceMessageQuery struct {
+	config
+	ctx         *QueryContext
+	order       []telegramservicemessage.OrderOption
+	inters      []Interceptor
+	predicates  []predicate.TelegramServiceMessage
+	withAccount *TelegramAccountQuery
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the TelegramServiceMessageQuery builder.
+func (tsmq *TelegramServiceMessageQuery) Where(ps ...predicate.TelegramServiceMessage) *TelegramServiceMessageQuery {
+	tsmq.predicates = append(tsmq.predicates, ps...)
+	return tsmq
+}
+
+// Limit the number of records to be returned by this query.
+func (tsmq *TelegramServiceMessageQuery) Limit(limit int) *TelegramServiceMessageQuery {
+	tsmq.ctx.Limit = &limit
+	return tsmq
+}
+
+// Offset to start from.
+func (tsmq *TelegramServiceMessageQuery) Offset(offset int) *TelegramServiceMessageQuery {
+	tsmq.ctx.Offset = &offset
+	return tsmq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (tsmq *TelegramServiceMessageQuery) Unique(unique bool) *TelegramServiceMessageQuery {
+	tsmq.ctx.Unique = &unique
+	return tsmq
+}
+
+// Order specifies how the records should be ordered.
+func (tsmq *TelegramServiceMessageQuery) Order(o ...telegramservicemessage.OrderOption) *TelegramServiceMessageQuery {
+	tsmq.order = append(tsmq.order, o...)
+	return tsmq
+}
+
+// QueryAccount chains the current query on the "account" edge.
+func (tsmq *TelegramServiceMessageQuery) QueryAccount() *TelegramAccountQuery {
+	query := (&TelegramAccountClient{config: tsmq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := tsmq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := tsmq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(telegramservicemessage.Table, telegramservicemessage.FieldID, selector),
+			sqlgraph.To(telegramaccount.Table, telegramaccount.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, telegramservicemessage.AccountTable, telegramservicemessage.AccountColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(tsmq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first TelegramServiceMessage entity from the query.
+// Returns a *NotFoundError when no TelegramServiceMessage was found.
+func (tsmq *TelegramServiceMessageQuery) First(ctx context.Context) (*TelegramServiceMessage, error) {
+	nodes, err := tsmq.Limit(1).All(setContextOp(ctx, tsmq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{telegramservicemessage.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (tsmq *TelegramServiceMessageQuery) FirstX(ctx context.Context) *TelegramServiceMessage {
+	node, err := tsmq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first TelegramServiceMessage ID from the query.
+// Returns a *NotFoundError when no TelegramServiceMessage ID was found.
+func (tsmq *TelegramServiceMessageQuery) FirstID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = tsmq.Limit(1).IDs(setContextOp(ctx, tsmq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{telegramservicemessage.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (tsmq *TelegramServiceMessageQuery) FirstIDX(ctx context.Context) int {
+	id, err := tsmq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single TelegramServiceMessage entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one TelegramServiceMessage entity is found.
+// Returns a *NotFoundError when no TelegramServiceMessage entities are found.
+func (tsmq *TelegramServiceMessageQuery) Only(ctx context.Context) (*TelegramServiceMessage, error) {
+	nodes, err := tsmq.Limit(2).All(setContextOp(ctx, tsmq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{telegramservicemessage.Label}
+	default:
+		return nil, &NotSingularError{telegramservicemessage.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (tsmq *TelegramServiceMessageQuery) OnlyX(ctx context.Context) *TelegramServiceMessage {
+	node, err := tsmq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only TelegramServiceMessage ID in the query.
+// Returns a *NotSingularError when more than one TelegramServiceMessage ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (tsmq *TelegramServiceMessageQuery) OnlyID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = tsmq.Limit(2).IDs(setContextOp(ctx, tsmq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{telegramservicemessage.Label}
+	default:
+		err = &NotSingularError{telegramservicemessage.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (tsmq *TelegramServiceMessageQuery) OnlyIDX(ctx context.Context) int {
+	id, err := tsmq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of TelegramServiceMessages.
+func (tsmq *TelegramServiceMessageQuery) All(ctx context.Context) ([]*TelegramServiceMessage, error) {
+	ctx = setContextOp(ctx, tsmq.ctx, ent.OpQueryAll)
+	if err := tsmq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*TelegramServiceMessage, *TelegramServiceMessageQuery]()
+	return withInterceptors[[]*TelegramServiceMessage](ctx, tsmq, qr, tsmq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (tsmq *TelegramServiceMessageQuery) AllX(ctx context.Context) []*TelegramServiceMessage {
+	nodes, err := tsmq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of TelegramServiceMessage IDs.
+func (tsmq *TelegramServiceMessageQuery) IDs(ctx context.Context) (ids []int, err error) {
+	if tsmq.ctx.Unique == nil && tsmq.path != nil {
+		tsmq.Unique(true)
+	}
+	ctx = setContextOp(ctx, tsmq.ctx, ent.OpQueryIDs)
+	if err = tsmq.Select(telegramservicemessage.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (tsmq *TelegramServiceMessageQuery) IDsX(ctx context.Context) []int {
+	ids, err := tsmq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (tsmq *TelegramServiceMessageQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, tsmq.ctx, ent.OpQueryCount)
+	if err := tsmq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, tsmq, querierCount[*TelegramServiceMessageQuery](), tsmq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (tsmq *TelegramServiceMessageQuery) CountX(ctx context.Context) int {
+	count, err := tsmq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (tsmq *TelegramServiceMessageQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, tsmq.ctx, ent.OpQueryExist)
+	switch _, err := tsmq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (tsmq *TelegramServiceMessageQuery) ExistX(ctx context.Context) bool {
+	exist, err := tsmq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the TelegramServiceMessageQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (tsmq *TelegramServiceMessageQuery) Clone() *TelegramServiceMessageQuery {
+	if tsmq == nil {
+		return nil
+	}
+	return &TelegramServiceMessageQuery{
+		config:      tsmq.config,
+		ctx:         tsmq.ctx.Clone(),
+		order:       append([]telegramservicemessage.OrderOption{}, tsmq.order...),
+		inters:      append([]Interceptor{}, tsmq.inters...),
+		predicates:  append([]predicate.TelegramServiceMessage{}, tsmq.predicates...),
+		withAccount: tsmq.withAccount.Clone(),
+		// clone intermediate query.
+		sql:  tsmq.sql.Clone(),
+		path: tsmq.path,
+	}
+}
+
+// WithAccount tells the query-builder to eager-load the nodes that are connected to
+// the "account" edge. The optional arguments are used to configure the query builder of the edge.
+func (tsmq *TelegramServiceMessageQuery) WithAccount(opts ...func(*TelegramAccountQuery)) *TelegramServiceMessageQuery {
+	query := (&TelegramAccountClient{config: tsmq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	tsmq.withAccount = query
+	return tsmq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		AccountID string `json:"account_id,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.TelegramServiceMessage.Query().
+//		GroupBy(telegramservicemessage.FieldAccountID).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (tsmq *TelegramServiceMessageQuery) GroupBy(field string, fields ...string) *TelegramServiceMessageGroupBy {
+	tsmq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &TelegramServiceMessageGroupBy{build: tsmq}
+	grbuild.flds = &tsmq.ctx.Fields
+	grbuild.label = telegramservicemessage.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		AccountID string `json:"account_id,omitempty"`
+//	}
+//
+//	client.TelegramServiceMessage.Query().
+//		Select(telegramservicemessage.FieldAccountID).
+//		Scan(ctx, &v)
+func (tsmq *TelegramServiceMessageQuery) Select(fields ...string) *TelegramServiceMessageSelect {
+	tsmq.ctx.Fields = append(tsmq.ctx.Fields, fields...)
+	sbuild := &TelegramServiceMessageSelect{TelegramServiceMessageQuery: tsmq}
+	sbuild.label = telegramservicemessage.Label
+	sbuild.flds, sbuild.scan = &tsmq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a TelegramServiceMessageSelect configured with the given aggregations.
+func (tsmq *TelegramServiceMessageQuery) Aggregate(fns ...AggregateFunc) *TelegramServiceMessageSelect {
+	return tsmq.Select().Aggregate(fns...)
+}
+
+func (tsmq *TelegramServiceMessageQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range tsmq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, tsmq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range tsmq.ctx.Fields {
+		if !telegramservicemessage.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if tsmq.path != nil {
+		prev, err := tsmq.path(ctx)
+		if err != nil {
+			return err
+		}
+		tsmq.sql = prev
+	}
+	return nil
+}
+
+func (tsmq *TelegramServiceMessageQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*TelegramServiceMessage, error) {
+	var (
+		nodes       = []*TelegramServiceMessage{}
+		_spec       = tsmq.querySpec()
+		loadedTypes = [1]bool{
+			tsmq.withAccount != nil,
+		}
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*TelegramServiceMessage).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &TelegramServiceMessage{config: tsmq.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, tsmq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := tsmq.withAccount; query != nil {
+		if err := tsmq.loadAccount(ctx, query, nodes, nil,
+			func(n *TelegramServiceMessage, e *TelegramAccount) { n.Edges.Account = e }); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (tsmq *TelegramServiceMessageQuery) loadAccount(ctx context.Context, query *TelegramAccountQuery, nodes []*TelegramServiceMessage, init func(*TelegramServiceMessage), assign func(*TelegramServiceMessage, *TelegramAccount)) error {
+	ids := make([]string, 0, len(nodes))
+	nodeids := make(map[string][]*TelegramServiceMessage)
+	for i := range nodes {
+		fk := nodes[i].AccountID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(telegramaccount.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "account_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+
+func (tsmq *TelegramServiceMessageQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := tsmq.querySpec()
+	_spec.Node.Columns = tsmq.ctx.Fields
+	if len(tsmq.ctx.Fields) > 0 {
+		_spec.Unique = tsmq.ctx.Unique != nil && *tsmq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, tsmq.driver, _spec)
+}
+
+func (tsmq *TelegramServiceMessageQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(telegramservicemessage.Table, telegramservicemessage.Columns, sqlgraph.NewFieldSpec(telegramservicemessage.FieldID, field.TypeInt))
+	_spec.From = tsmq.sql
+	if unique := tsmq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if tsmq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := tsmq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, telegramservicemessage.FieldID)
+		for i := range fields {
+			if fields[i] != telegramservicemessage.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+		if tsmq.withAccount != nil {
+			_spec.Node.AddColumnOnce(telegramservicemessage.FieldAccountID)
+		}
+	}
+	if ps := tsmq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := tsmq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := tsmq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := tsmq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (tsmq *TelegramServiceMessageQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(tsmq.driver.Dialect())
+	t1 := builder.Table(telegramservicemessage.Table)
+	columns := tsmq.ctx.Fields
+	if len(columns) == 0 {
+		columns = telegramservicemessage.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if tsmq.sql != nil {
+		selector = tsmq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if tsmq.ctx.Unique != nil && *tsmq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, p := range tsmq.predicates {
+		p(selector)
+	}
+	for _, p := range tsmq.order {
+		p(selector)
+	}
+	if offset := tsmq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := tsmq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// TelegramServiceMessageGroupBy is the group-by builder for TelegramServiceMessage entities.
+type TelegramServiceMessageGroupBy struct {
+	selector
+	build *TelegramServiceMessageQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (tsmgb *TelegramServiceMessageGroupBy) Aggregate(fns ...AggregateFunc) *TelegramServiceMessageGroupBy {
+	tsmgb.fns = append(tsmgb.fns, fns...)
+	return tsmgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (tsmgb *TelegramServiceMessageGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, tsmgb.build.ctx, ent.OpQueryGroupBy)
+	if err := tsmgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*TelegramServiceMessageQuery, *TelegramServiceMessageGroupBy](ctx, tsmgb.build, tsmgb, tsmgb.build.inters, v)
+}
+
+func (tsmgb *TelegramServiceMessageGroupBy) sqlScan(ctx context.Context, root *TelegramServiceMessageQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(tsmgb.fns))
+	for _, fn := range tsmgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*tsmgb.flds)+len(tsmgb.fns))
+		for _, f := range *tsmgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*tsmgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := tsmgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// TelegramServiceMessageSelect is the builder for selecting fields of TelegramServiceMessage entities.
+type TelegramServiceMessageSelect struct {
+	*TelegramServiceMessageQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (tsms *TelegramServiceMessageSelect) Aggregate(fns ...AggregateFunc) *TelegramServiceMessageSelect {
+	tsms.fns = append(tsms.fns, fns...)
+	return tsms
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (tsms *TelegramServiceMessageSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, tsms.ctx, ent.OpQuerySelect)
+	if err := tsms.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*TelegramServiceMessageQuery, *TelegramServiceMessageSelect](ctx, tsms.TelegramServiceMessageQuery, tsms, tsms.inters, v)
+}
+
+func (tsms *TelegramServiceMessageSelect) sqlScan(ctx context.Context, root *TelegramServiceMessageQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(tsms.fns))
+	for _, fn := range tsms.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*tsms.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := tsms.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
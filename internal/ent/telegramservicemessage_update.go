@@ -0,0 +1,473 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/gotd/bot/internal/ent/predicate"
+	"github.com/gotd/bot/internal/ent/telegramaccount"
+	"github.com/gotd/bot/internal/ent/telegramservicemessage"
+)
+
+// TelegramServiceMessageUpdate is the builder for updating TelegramServiceMessage entities.
+type TelegramServiceMessageUpdate struct {
+	config
+	hooks    []Hook
+	mutation *TelegramServiceMessageMutation
+}
+
+// Where appends a list predicates to the TelegramServiceMessageUpdate builder.
+func (tsmu *TelegramServiceMessageUpdate) Where(ps ...predicate.TelegramServiceMessage) *TelegramServiceMessageUpdate {
+	tsmu.mutation.Where(ps...)
+	return tsmu
+}
+
+// SetAccountID sets the "account_id" field.
+func (tsmu *TelegramServiceMessageUpdate) SetAccountID(s string) *TelegramServiceMessageUpdate {
+	tsmu.mutation.SetAccountID(s)
+	return tsmu
+}
+
+// SetNillableAccountID sets the "account_id" field if the given value is not nil.
+func (tsmu *TelegramServiceMessageUpdate) SetNillableAccountID(s *string) *TelegramServiceMessageUpdate {
+	if s != nil {
+		tsmu.SetAccountID(*s)
+	}
+	return tsmu
+}
+
+// SetMessageID sets the "message_id" field.
+func (tsmu *TelegramServiceMessageUpdate) SetMessageID(i int) *TelegramServiceMessageUpdate {
+	tsmu.mutation.ResetMessageID()
+	tsmu.mutation.SetMessageID(i)
+	return tsmu
+}
+
+// SetNillableMessageID sets the "message_id" field if the given value is not nil.
+func (tsmu *TelegramServiceMessageUpdate) SetNillableMessageID(i *int) *TelegramServiceMessageUpdate {
+	if i != nil {
+		tsmu.SetMessageID(*i)
+	}
+	return tsmu
+}
+
+// AddMessageID adds i to the "message_id" field.
+func (tsmu *TelegramServiceMessageUpdate) AddMessageID(i int) *TelegramServiceMessageUpdate {
+	tsmu.mutation.AddMessageID(i)
+	return tsmu
+}
+
+// SetType sets the "type" field.
+func (tsmu *TelegramServiceMessageUpdate) SetType(t telegramservicemessage.Type) *TelegramServiceMessageUpdate {
+	tsmu.mutation.SetType(t)
+	return tsmu
+}
+
+// SetNillableType sets the "type" field if the given value is not nil.
+func (tsmu *TelegramServiceMessageUpdate) SetNillableType(t *telegramservicemessage.Type) *TelegramServiceMessageUpdate {
+	if t != nil {
+		tsmu.SetType(*t)
+	}
+	return tsmu
+}
+
+// SetText sets the "text" field.
+func (tsmu *TelegramServiceMessageUpdate) SetText(s string) *TelegramServiceMessageUpdate {
+	tsmu.mutation.SetText(s)
+	return tsmu
+}
+
+// SetNillableText sets the "text" field if the given value is not nil.
+func (tsmu *TelegramServiceMessageUpdate) SetNillableText(s *string) *TelegramServiceMessageUpdate {
+	if s != nil {
+		tsmu.SetText(*s)
+	}
+	return tsmu
+}
+
+// SetDate sets the "date" field.
+func (tsmu *TelegramServiceMessageUpdate) SetDate(t time.Time) *TelegramServiceMessageUpdate {
+	tsmu.mutation.SetDate(t)
+	return tsmu
+}
+
+// SetNillableDate sets the "date" field if the given value is not nil.
+func (tsmu *TelegramServiceMessageUpdate) SetNillableDate(t *time.Time) *TelegramServiceMessageUpdate {
+	if t != nil {
+		tsmu.SetDate(*t)
+	}
+	return tsmu
+}
+
+// SetAccount sets the "account" edge to the TelegramAccount entity.
+func (tsmu *TelegramServiceMessageUpdate) SetAccount(t *TelegramAccount) *TelegramServiceMessageUpdate {
+	return tsmu.SetAccountID(t.ID)
+}
+
+// Mutation returns the TelegramServiceMessageMutation object of the builder.
+func (tsmu *TelegramServiceMessageUpdate) Mutation() *TelegramServiceMessageMutation {
+	return tsmu.mutation
+}
+
+// ClearAccount clears the "account" edge to the TelegramAccount entity.
+func (tsmu *TelegramServiceMessageUpdate) ClearAccount() *TelegramServiceMessageUpdate {
+	tsmu.mutation.ClearAccount()
+	return tsmu
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (tsmu *TelegramServiceMessageUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, tsmu.sqlSave, tsmu.mutation, tsmu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (tsmu *TelegramServiceMessageUpdate) SaveX(ctx context.Context) int {
+	affected, err := tsmu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (tsmu *TelegramServiceMessageUpdate) Exec(ctx context.Context) error {
+	_, err := tsmu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (tsmu *TelegramServiceMessageUpdate) ExecX(ctx context.Context) {
+	if err := tsmu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (tsmu *TelegramServiceMessageUpdate) check() error {
+	if v, ok := tsmu.mutation.GetType(); ok {
+		if err := telegramservicemessage.TypeValidator(v); err != nil {
+			return &ValidationError{Name: "type", err: fmt.Errorf(`ent: validator failed for field "TelegramServiceMessage.type": %w`, err)}
+		}
+	}
+	if tsmu.mutation.AccountCleared() && len(tsmu.mutation.AccountIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "TelegramServiceMessage.account"`)
+	}
+	return nil
+}
+
+func (tsmu *TelegramServiceMessageUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := tsmu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(telegramservicemessage.Table, telegramservicemessage.Columns, sqlgraph.NewFieldSpec(telegramservicemessage.FieldID, field.TypeInt))
+	if ps := tsmu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := tsmu.mutation.MessageID(); ok {
+		_spec.SetField(telegramservicemessage.FieldMessageID, field.TypeInt, value)
+	}
+	if value, ok := tsmu.mutation.AddedMessageID(); ok {
+		_spec.AddField(telegramservicemessage.FieldMessageID, field.TypeInt, value)
+	}
+	if value, ok := tsmu.mutation.GetType(); ok {
+		_spec.SetField(telegramservicemessage.FieldType, field.TypeEnum, value)
+	}
+	if value, ok := tsmu.mutation.Text(); ok {
+		_spec.SetField(telegramservicemessage.FieldText, field.TypeString, value)
+	}
+	if value, ok := tsmu.mutation.Date(); ok {
+		_spec.SetField(telegramservicemessage.FieldDate, field.TypeTime, value)
+	}
+	if tsmu.mutation.AccountCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   telegramservicemessage.AccountTable,
+			Columns: []string{telegramservicemessage.AccountColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(telegramaccount.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tsmu.mutation.AccountIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   telegramservicemessage.AccountTable,
+			Columns: []string{telegramservicemessage.AccountColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(telegramaccount.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, tsmu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{telegramservicemessage.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	tsmu.mutation.done = true
+	return n, nil
+}
+
+// TelegramServiceMessageUpdateOne is the builder for updating a single TelegramServiceMessage entity.
+type TelegramServiceMessageUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *TelegramServiceMessageMutation
+}
+
+// SetAccountID sets the "account_id" field.
+func (tsmuo *TelegramServiceMessageUpdateOne) SetAccountID(s string) *TelegramServiceMessageUpdateOne {
+	tsmuo.mutation.SetAccountID(s)
+	return tsmuo
+}
+
+// SetNillableAccountID sets the "account_id" field if the given value is not nil.
+func (tsmuo *TelegramServiceMessageUpdateOne) SetNillableAccountID(s *string) *TelegramServiceMessageUpdateOne {
+	if s != nil {
+		tsmuo.SetAccountID(*s)
+	}
+	return tsmuo
+}
+
+// SetMessageID sets the "message_id" field.
+func (tsmuo *TelegramServiceMessageUpdateOne) SetMessageID(i int) *TelegramServiceMessageUpdateOne {
+	tsmuo.mutation.ResetMessageID()
+	tsmuo.mutation.SetMessageID(i)
+	return tsmuo
+}
+
+// SetNillableMessageID sets the "message_id" field if the given value is not nil.
+func (tsmuo *TelegramServiceMessageUpdateOne) SetNillableMessageID(i *int) *TelegramServiceMessageUpdateOne {
+	if i != nil {
+		tsmuo.SetMessageID(*i)
+	}
+	return tsmuo
+}
+
+// AddMessageID adds i to the "message_id" field.
+func (tsmuo *TelegramServiceMessageUpdateOne) AddMessageID(i int) *TelegramServiceMessageUpdateOne {
+	tsmuo.mutation.AddMessageID(i)
+	return tsmuo
+}
+
+// SetType sets the "type" field.
+func (tsmuo *TelegramServiceMessageUpdateOne) SetType(t telegramservicemessage.Type) *TelegramServiceMessageUpdateOne {
+	tsmuo.mutation.SetType(t)
+	return tsmuo
+}
+
+// SetNillableType sets the "type" field if the given value is not nil.
+func (tsmuo *TelegramServiceMessageUpdateOne) SetNillableType(t *telegramservicemessage.Type) *TelegramServiceMessageUpdateOne {
+	if t != nil {
+		tsmuo.SetType(*t)
+	}
+	return tsmuo
+}
+
+// SetText sets the "text" field.
+func (tsmuo *TelegramServiceMessageUpdateOne) SetText(s string) *TelegramServiceMessageUpdateOne {
+	tsmuo.mutation.SetText(s)
+	return tsmuo
+}
+
+// SetNillableText sets the "text" field if the given value is not nil.
+func (tsmuo *TelegramServiceMessageUpdateOne) SetNillableText(s *string) *TelegramServiceMessageUpdateOne {
+	if s != nil {
+		tsmuo.SetText(*s)
+	}
+	return tsmuo
+}
+
+// SetDate sets the "date" field.
+func (tsmuo *TelegramServiceMessageUpdateOne) SetDate(t time.Time) *TelegramServiceMessageUpdateOne {
+	tsmuo.mutation.SetDate(t)
+	return tsmuo
+}
+
+// SetNillableDate sets the "date" field if the given value is not nil.
+func (tsmuo *TelegramServiceMessageUpdateOne) SetNillableDate(t *time.Time) *TelegramServiceMessageUpdateOne {
+	if t != nil {
+		tsmuo.SetDate(*t)
+	}
+	return tsmuo
+}
+
+// SetAccount sets the "account" edge to the TelegramAccount entity.
+func (tsmuo *TelegramServiceMessageUpdateOne) SetAccount(t *TelegramAccount) *TelegramServiceMessageUpdateOne {
+	return tsmuo.SetAccountID(t.ID)
+}
+
+// Mutation returns the TelegramServiceMessageMutation object of the builder.
+func (tsmuo *TelegramServiceMessageUpdateOne) Mutation() *TelegramServiceMessageMutation {
+	return tsmuo.mutation
+}
+
+// ClearAccount clears the "account" edge to the TelegramAccount entity.
+func (tsmuo *TelegramServiceMessageUpdateOne) ClearAccount() *TelegramServiceMessageUpdateOne {
+	tsmuo.mutation.ClearAccount()
+	return tsmuo
+}
+
+// Where appends a list predicates to the TelegramServiceMessageUpdate builder.
+func (tsmuo *TelegramServiceMessageUpdateOne) Where(ps ...predicate.TelegramServiceMessage) *TelegramServiceMessageUpdateOne {
+	tsmuo.mutation.Where(ps...)
+	return tsmuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (tsmuo *TelegramServiceMessageUpdateOne) Select(field string, fields ...string) *TelegramServiceMessageUpdateOne {
+	tsmuo.fields = append([]string{field}, fields...)
+	return tsmuo
+}
+
+// Save executes the query and returns the updated TelegramServiceMessage entity.
+func (tsmuo *TelegramServiceMessageUpdateOne) Save(ctx context.Context) (*TelegramServiceMessage, error) {
+	return withHooks(ctx, tsmuo.sqlSave, tsmuo.mutation, tsmuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (tsmuo *TelegramServiceMessageUpdateOne) SaveX(ctx context.Context) *TelegramServiceMessage {
+	node, err := tsmuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (tsmuo *TelegramServiceMessageUpdateOne) Exec(ctx context.Context) error {
+	_, err := tsmuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (tsmuo *TelegramServiceMessageUpdateOne) ExecX(ctx context.Context) {
+	if err := tsmuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (tsmuo *TelegramServiceMessageUpdateOne) check() error {
+	if v, ok := tsmuo.mutation.GetType(); ok {
+		if err := telegramservicemessage.TypeValidator(v); err != nil {
+			return &ValidationError{Name: "type", err: fmt.Errorf(`ent: validator failed for field "TelegramServiceMessage.type": %w`, err)}
+		}
+	}
+	if tsmuo.mutation.AccountCleared() && len(tsmuo.mutation.AccountIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "TelegramServiceMessage.account"`)
+	}
+	return nil
+}
+
+func (tsmuo *TelegramServiceMessageUpdateOne) sqlSave(ctx context.Context) (_node *TelegramServiceMessage, err error) {
+	if err := tsmuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(telegramservicemessage.Table, telegramservicemessage.Columns, sqlgraph.NewFieldSpec(telegramservicemessage.FieldID, field.TypeInt))
+	id, ok := tsmuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "TelegramServiceMessage.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := tsmuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, telegramservicemessage.FieldID)
+		for _, f := range fields {
+			if !telegramservicemessage.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != telegramservicemessage.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := tsmuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := tsmuo.mutation.MessageID(); ok {
+		_spec.SetField(telegramservicemessage.FieldMessageID, field.TypeInt, value)
+	}
+	if value, ok := tsmuo.mutation.AddedMessageID(); ok {
+		_spec.AddField(telegramservicemessage.FieldMessageID, field.TypeInt, value)
+	}
+	if value, ok := tsmuo.mutation.GetType(); ok {
+		_spec.SetField(telegramservicemessage.FieldType, field.TypeEnum, value)
+	}
+	if value, ok := tsmuo.mutation.Text(); ok {
+		_spec.SetField(telegramservicemessage.FieldText, field.TypeString, value)
+	}
+	if value, ok := tsmuo.mutation.Date(); ok {
+		_spec.SetField(telegramservicemessage.FieldDate, field.TypeTime, value)
+	}
+	if tsmuo.mutation.AccountCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   telegramservicemessage.AccountTable,
+			Columns: []string{telegramservicemessage.AccountColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(telegramaccount.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tsmuo.mutation.AccountIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   telegramservicemessage.AccountTable,
+			Columns: []string{telegramservicemessage.AccountColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(telegramaccount.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &TelegramServiceMessage{config: tsmuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, tsmuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{telegramservicemessage.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	tsmuo.mutation.done = true
+	return _node, nil
+}
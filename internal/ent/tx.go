@@ -12,6 +12,8 @@ import (
 // Tx is a transactional client that is created by calling Client.Tx().
 type Tx struct {
 	config
+	// FileIDCheck is the client for interacting with the FileIDCheck builders.
+	FileIDCheck *FileIDCheckClient
 	// LastChannelMessage is the client for interacting with the LastChannelMessage builders.
 	LastChannelMessage *LastChannelMessageClient
 	// PRNotification is the client for interacting with the PRNotification builders.
@@ -20,6 +22,8 @@ type Tx struct {
 	TelegramAccount *TelegramAccountClient
 	// TelegramChannelState is the client for interacting with the TelegramChannelState builders.
 	TelegramChannelState *TelegramChannelStateClient
+	// TelegramServiceMessage is the client for interacting with the TelegramServiceMessage builders.
+	TelegramServiceMessage *TelegramServiceMessageClient
 	// TelegramSession is the client for interacting with the TelegramSession builders.
 	TelegramSession *TelegramSessionClient
 	// TelegramUserState is the client for interacting with the TelegramUserState builders.
@@ -155,10 +159,12 @@ func (tx *Tx) Client() *Client {
 }
 
 func (tx *Tx) init() {
+	tx.FileIDCheck = NewFileIDCheckClient(tx.config)
 	tx.LastChannelMessage = NewLastChannelMessageClient(tx.config)
 	tx.PRNotification = NewPRNotificationClient(tx.config)
 	tx.TelegramAccount = NewTelegramAccountClient(tx.config)
 	tx.TelegramChannelState = NewTelegramChannelStateClient(tx.config)
+	tx.TelegramServiceMessage = NewTelegramServiceMessageClient(tx.config)
 	tx.TelegramSession = NewTelegramSessionClient(tx.config)
 	tx.TelegramUserState = NewTelegramUserStateClient(tx.config)
 }
@@ -170,7 +176,7 @@ func (tx *Tx) init() {
 // of them in order to commit or rollback the transaction.
 //
 // If a closed transaction is embedded in one of the generated entities, and the entity
-// applies a query, for example: LastChannelMessage.QueryXXX(), the query will be executed
+// applies a query, for example: FileIDCheck.QueryXXX(), the query will be executed
 // through the driver which created this transaction.
 //
 // Note that txDriver is not goroutine safe.
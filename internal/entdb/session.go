@@ -0,0 +1,53 @@
+package entdb
+
+import (
+	"context"
+
+	"github.com/go-faster/errors"
+	"github.com/google/uuid"
+	"github.com/gotd/td/session"
+
+	"github.com/gotd/bot/internal/ent"
+	"github.com/gotd/bot/internal/ent/telegramsession"
+)
+
+var _ session.Storage = SessionStorage{}
+
+// SessionStorage is MTProto session storage backed by TelegramSession entity.
+type SessionStorage struct {
+	db *ent.Client
+	id uuid.UUID
+}
+
+// NewSessionStorage creates new SessionStorage for session with given ID.
+func NewSessionStorage(db *ent.Client, id uuid.UUID) SessionStorage {
+	return SessionStorage{db: db, id: id}
+}
+
+// LoadSession implements session.Storage.
+func (s SessionStorage) LoadSession(ctx context.Context) ([]byte, error) {
+	sess, err := s.db.TelegramSession.Get(ctx, s.id)
+	if ent.IsNotFound(err) {
+		return nil, session.ErrNotFound
+	}
+	if err != nil {
+		return nil, errors.Wrap(err, "get session")
+	}
+	if len(sess.Data) == 0 {
+		return nil, session.ErrNotFound
+	}
+	return sess.Data, nil
+}
+
+// StoreSession implements session.Storage.
+func (s SessionStorage) StoreSession(ctx context.Context, data []byte) error {
+	if err := s.db.TelegramSession.Create().
+		SetID(s.id).
+		SetData(data).
+		OnConflictColumns(telegramsession.FieldID).
+		UpdateNewValues().
+		Exec(ctx); err != nil {
+		return errors.Wrap(err, "upsert session")
+	}
+	return nil
+}
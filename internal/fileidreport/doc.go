@@ -0,0 +1,3 @@
+// Package fileidreport stores file_id check results, summarizes them and
+// alerts about regressions after gotd/td updates.
+package fileidreport
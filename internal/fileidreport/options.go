@@ -0,0 +1,42 @@
+package fileidreport
+
+import (
+	"context"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/gotd/bot/internal/app"
+)
+
+// Options is Report options.
+type Options struct {
+	// Version is gotd/td version, defaults to app.GetVersion.
+	Version string
+	// Alert sends regression alert, optional.
+	Alert func(ctx context.Context, text string) error
+	// Retention is how long check results are kept, defaults to 90 days.
+	Retention time.Duration
+	// PruneInterval is interval between removals of expired results,
+	// defaults to 1 hour.
+	PruneInterval time.Duration
+	Logger        *zap.Logger
+}
+
+func (o *Options) setDefaults() {
+	if o.Version == "" {
+		o.Version = app.GetVersion()
+	}
+	if o.Version == "" {
+		o.Version = "unknown"
+	}
+	if o.Retention <= 0 {
+		o.Retention = 90 * 24 * time.Hour
+	}
+	if o.PruneInterval <= 0 {
+		o.PruneInterval = time.Hour
+	}
+	if o.Logger == nil {
+		o.Logger = zap.NewNop()
+	}
+}
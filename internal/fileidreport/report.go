@@ -0,0 +1,183 @@
+package fileidreport
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/go-faster/errors"
+	"go.uber.org/zap"
+
+	"github.com/gotd/bot/internal/app"
+	"github.com/gotd/bot/internal/ent"
+	"github.com/gotd/bot/internal/ent/fileidcheck"
+)
+
+var _ app.FileIDReporter = (*Report)(nil)
+
+// Report stores file_id check results in database.
+type Report struct {
+	db            *ent.Client
+	version       string
+	alert         func(ctx context.Context, text string) error
+	retention     time.Duration
+	pruneInterval time.Duration
+	logger        *zap.Logger
+
+	// mux serializes saving of results and alert decision, so concurrent
+	// failures of the same type alert exactly once.
+	mux sync.Mutex
+}
+
+// New creates new Report.
+func New(db *ent.Client, opts Options) *Report {
+	opts.setDefaults()
+	return &Report{
+		db:            db,
+		version:       opts.Version,
+		alert:         opts.Alert,
+		retention:     opts.Retention,
+		pruneInterval: opts.PruneInterval,
+		logger:        opts.Logger,
+	}
+}
+
+func convertResult(result string) (fileidcheck.Result, error) {
+	switch result {
+	case app.FileIDOK:
+		return fileidcheck.ResultOK, nil
+	case app.FileIDEncode:
+		return fileidcheck.ResultEncodeError, nil
+	case app.FileIDRejected:
+		return fileidcheck.ResultRejected, nil
+	case app.FileIDMismatch:
+		return fileidcheck.ResultMismatch, nil
+	default:
+		return "", errors.Errorf("unknown result %q", result)
+	}
+}
+
+// Report implements app.FileIDReporter.
+func (r *Report) Report(ctx context.Context, res app.FileIDResult) error {
+	result, err := convertResult(res.Result)
+	if err != nil {
+		return err
+	}
+
+	q := r.db.FileIDCheck.Create().
+		SetType(res.Type.String()).
+		SetDc(res.DC).
+		SetFileID(res.FileID).
+		SetBotAPIFileID(res.BotAPI).
+		SetResult(result).
+		SetTdVersion(r.version)
+	if res.Err != nil {
+		q.SetError(res.Err.Error())
+	}
+
+	previous, ok, err := r.save(ctx, q, result, res.Type.String())
+	if err != nil || !ok {
+		return err
+	}
+
+	r.logger.Warn("FileID regression",
+		zap.Stringer("type", res.Type),
+		zap.String("version", r.version),
+		zap.String("previous_version", previous),
+	)
+	text := fmt.Sprintf("⚠️ file_id of %s fails on gotd/td %s, passed on %s: %s",
+		res.Type, r.version, previous, res.Result,
+	)
+	if res.Err != nil {
+		text += fmt.Sprintf(" (%s)", res.Err)
+	}
+	if err := r.alert(ctx, text); err != nil {
+		return errors.Wrap(err, "alert")
+	}
+	return nil
+}
+
+// save saves result and reports whether it is a regression to alert about.
+func (r *Report) save(ctx context.Context, q *ent.FileIDCheckCreate, result fileidcheck.Result, typ string) (string, bool, error) {
+	r.mux.Lock()
+	defer r.mux.Unlock()
+
+	if err := q.Exec(ctx); err != nil {
+		return "", false, errors.Wrap(err, "save")
+	}
+	if result == fileidcheck.ResultOK || r.alert == nil {
+		return "", false, nil
+	}
+	previous, ok, err := r.regression(ctx, typ)
+	if err != nil {
+		return "", false, errors.Wrap(err, "check regression")
+	}
+	return previous, ok, nil
+}
+
+// regression reports whether just saved failure is the first failure of
+// given type on current version while the last check on previous version passed.
+func (r *Report) regression(ctx context.Context, typ string) (string, bool, error) {
+	failures, err := r.db.FileIDCheck.Query().
+		Where(
+			fileidcheck.Type(typ),
+			fileidcheck.TdVersion(r.version),
+			fileidcheck.ResultNEQ(fileidcheck.ResultOK),
+		).
+		Count(ctx)
+	if err != nil {
+		return "", false, errors.Wrap(err, "count failures")
+	}
+	if failures != 1 {
+		// Already alerted.
+		return "", false, nil
+	}
+
+	last, err := r.db.FileIDCheck.Query().
+		Where(
+			fileidcheck.Type(typ),
+			fileidcheck.TdVersionNEQ(r.version),
+		).
+		Order(ent.Desc(fileidcheck.FieldCreatedAt)).
+		First(ctx)
+	if ent.IsNotFound(err) {
+		return "", false, nil
+	}
+	if err != nil {
+		return "", false, errors.Wrap(err, "query previous")
+	}
+
+	return last.TdVersion, last.Result == fileidcheck.ResultOK, nil
+}
+
+// Prune removes results older than retention period.
+func (r *Report) Prune(ctx context.Context) (int, error) {
+	n, err := r.db.FileIDCheck.Delete().
+		Where(fileidcheck.CreatedAtLT(time.Now().Add(-r.retention))).
+		Exec(ctx)
+	if err != nil {
+		return 0, errors.Wrap(err, "delete")
+	}
+	return n, nil
+}
+
+// Run prunes expired results until ctx is done.
+func (r *Report) Run(ctx context.Context) error {
+	ticker := time.NewTicker(r.pruneInterval)
+	defer ticker.Stop()
+	for {
+		n, err := r.Prune(ctx)
+		if err != nil && ctx.Err() == nil {
+			r.logger.Error("Prune file_id checks", zap.Error(err))
+		} else if n > 0 {
+			r.logger.Info("Pruned file_id checks", zap.Int("count", n))
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
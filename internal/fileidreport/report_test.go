@@ -0,0 +1,147 @@
+package fileidreport
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/go-faster/errors"
+	"github.com/gotd/td/fileid"
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/stretchr/testify/require"
+
+	"github.com/gotd/bot/internal/app"
+	"github.com/gotd/bot/internal/ent"
+	"github.com/gotd/bot/internal/ent/enttest"
+	"github.com/gotd/bot/internal/ent/hook"
+)
+
+func newTestDB(t *testing.T) *ent.Client {
+	t.Helper()
+
+	dsn := fmt.Sprintf("file:%s?mode=memory&cache=shared&_fk=1", t.Name())
+	db := enttest.Open(t, "sqlite3", dsn)
+	t.Cleanup(func() {
+		_ = db.Close()
+	})
+	return db
+}
+
+// alerts records sent alerts.
+type alerts struct {
+	mux  sync.Mutex
+	sent []string
+}
+
+func (a *alerts) Alert(ctx context.Context, text string) error {
+	a.mux.Lock()
+	defer a.mux.Unlock()
+	a.sent = append(a.sent, text)
+	return nil
+}
+
+func (a *alerts) Sent() []string {
+	a.mux.Lock()
+	defer a.mux.Unlock()
+	return append([]string(nil), a.sent...)
+}
+
+func newTestReport(db *ent.Client, version string, alerts *alerts) *Report {
+	return New(db, Options{
+		Version: version,
+		Alert:   alerts.Alert,
+	})
+}
+
+func result(typ fileid.Type, r string) app.FileIDResult {
+	res := app.FileIDResult{Type: typ, DC: 2, Result: r}
+	if r != app.FileIDOK {
+		res.Err = errors.New("failed")
+	}
+	return res
+}
+
+func TestReport_Regression(t *testing.T) {
+	a := require.New(t)
+	ctx := context.Background()
+	db := newTestDB(t)
+	sent := &alerts{}
+
+	previous := newTestReport(db, "v0.119.0", sent)
+	a.NoError(previous.Report(ctx, result(fileid.Sticker, app.FileIDOK)))
+	a.NoError(previous.Report(ctx, result(fileid.Photo, app.FileIDMismatch)))
+
+	current := newTestReport(db, "v0.120.0", sent)
+	// First failure after passing check on previous version.
+	a.NoError(current.Report(ctx, result(fileid.Sticker, app.FileIDMismatch)))
+	a.Len(sent.Sent(), 1)
+	a.Contains(sent.Sent()[0], "Sticker fails on gotd/td v0.120.0, passed on v0.119.0")
+
+	// Already alerted.
+	a.NoError(current.Report(ctx, result(fileid.Sticker, app.FileIDRejected)))
+	a.NoError(current.Report(ctx, result(fileid.Sticker, app.FileIDOK)))
+	a.NoError(current.Report(ctx, result(fileid.Sticker, app.FileIDMismatch)))
+	// Failed on previous version too.
+	a.NoError(current.Report(ctx, result(fileid.Photo, app.FileIDMismatch)))
+	// No checks on previous version.
+	a.NoError(current.Report(ctx, result(fileid.Video, app.FileIDMismatch)))
+	a.Len(sent.Sent(), 1)
+}
+
+func TestReport_RegressionConcurrent(t *testing.T) {
+	a := require.New(t)
+	ctx := context.Background()
+	db := newTestDB(t)
+	sent := &alerts{}
+
+	a.NoError(newTestReport(db, "v0.119.0", sent).Report(ctx, result(fileid.Sticker, app.FileIDOK)))
+
+	// Widen window between saving result and counting failures.
+	db.FileIDCheck.Use(func(next ent.Mutator) ent.Mutator {
+		return hook.FileIDCheckFunc(func(ctx context.Context, m *ent.FileIDCheckMutation) (ent.Value, error) {
+			v, err := next.Mutate(ctx, m)
+			time.Sleep(time.Millisecond * 10)
+			return v, err
+		})
+	})
+
+	current := newTestReport(db, "v0.120.0", sent)
+	var wg sync.WaitGroup
+	for range 8 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			a.NoError(current.Report(ctx, result(fileid.Sticker, app.FileIDMismatch)))
+		}()
+	}
+	wg.Wait()
+
+	a.Len(sent.Sent(), 1)
+}
+
+func TestReport_Prune(t *testing.T) {
+	a := require.New(t)
+	ctx := context.Background()
+	db := newTestDB(t)
+
+	r := New(db, Options{Version: "v0.120.0", Retention: time.Hour})
+	a.NoError(r.Report(ctx, result(fileid.Sticker, app.FileIDOK)))
+	a.NoError(db.FileIDCheck.Create().
+		SetType(fileid.Sticker.String()).
+		SetDc(2).
+		SetResult("OK").
+		SetTdVersion("v0.119.0").
+		SetCreatedAt(time.Now().Add(-2 * time.Hour)).
+		Exec(ctx))
+
+	n, err := r.Prune(ctx)
+	a.NoError(err)
+	a.Equal(1, n)
+
+	left, err := db.FileIDCheck.Query().All(ctx)
+	a.NoError(err)
+	a.Len(left, 1)
+	a.Equal("v0.120.0", left[0].TdVersion)
+}
@@ -0,0 +1,122 @@
+package fileidreport
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/go-faster/errors"
+
+	"github.com/gotd/bot/internal/dispatch"
+	"github.com/gotd/bot/internal/ent"
+	"github.com/gotd/bot/internal/ent/fileidcheck"
+)
+
+// Stat is pass rate of file type.
+type Stat struct {
+	Type   string
+	Passed int
+	Total  int
+}
+
+// Window is stats of all file types over time window.
+type Window struct {
+	Name  string
+	Stats []Stat
+}
+
+// Stats returns pass rate per file type since given time.
+func (r *Report) Stats(ctx context.Context, since time.Time) ([]Stat, error) {
+	var rows []struct {
+		Type   string             `json:"type"`
+		Result fileidcheck.Result `json:"result"`
+		Count  int                `json:"count"`
+	}
+	if err := r.db.FileIDCheck.Query().
+		Where(fileidcheck.CreatedAtGTE(since)).
+		GroupBy(fileidcheck.FieldType, fileidcheck.FieldResult).
+		Aggregate(ent.Count()).
+		Scan(ctx, &rows); err != nil {
+		return nil, errors.Wrap(err, "query")
+	}
+
+	stats := map[string]*Stat{}
+	for _, row := range rows {
+		s, ok := stats[row.Type]
+		if !ok {
+			s = &Stat{Type: row.Type}
+			stats[row.Type] = s
+		}
+		s.Total += row.Count
+		if row.Result == fileidcheck.ResultOK {
+			s.Passed += row.Count
+		}
+	}
+
+	result := make([]Stat, 0, len(stats))
+	for _, s := range stats {
+		result = append(result, *s)
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].Type < result[j].Type
+	})
+	return result, nil
+}
+
+// formatStats writes pass rate per file type for every window.
+func formatStats(w io.Writer, version string, windows []Window) {
+	fmt.Fprintf(w, "file_id checks (gotd/td %s):\n", version)
+
+	var types []string
+	rates := map[string][]string{}
+	for _, window := range windows {
+		for _, s := range window.Stats {
+			if _, ok := rates[s.Type]; !ok {
+				types = append(types, s.Type)
+			}
+			rates[s.Type] = append(rates[s.Type], fmt.Sprintf("%s %d%% (%d/%d)",
+				window.Name, s.Passed*100/s.Total, s.Passed, s.Total,
+			))
+		}
+	}
+	if len(types) == 0 {
+		fmt.Fprintln(w, "\nNo checks yet")
+		return
+	}
+
+	sort.Strings(types)
+	fmt.Fprintln(w)
+	for _, typ := range types {
+		fmt.Fprintf(w, "%s: %s\n", typ, strings.Join(rates[typ], ", "))
+	}
+}
+
+// OnMessage implements dispatch.MessageHandler.
+func (r *Report) OnMessage(ctx context.Context, e dispatch.MessageEvent) error {
+	now := time.Now()
+	windows := []struct {
+		Name string
+		Age  time.Duration
+	}{
+		{"24h", 24 * time.Hour},
+		{"7d", 7 * 24 * time.Hour},
+		{"30d", 30 * 24 * time.Hour},
+	}
+
+	var result []Window
+	for _, window := range windows {
+		stats, err := r.Stats(ctx, now.Add(-window.Age))
+		if err != nil {
+			return errors.Wrapf(err, "stats for %s", window.Name)
+		}
+		result = append(result, Window{Name: window.Name, Stats: stats})
+	}
+
+	var w strings.Builder
+	formatStats(&w, r.version, result)
+	_, err := e.Reply().Text(ctx, w.String())
+	return err
+}
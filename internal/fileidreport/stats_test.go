@@ -0,0 +1,30 @@
+package fileidreport
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestFormatStats(t *testing.T) {
+	a := require.New(t)
+
+	var w strings.Builder
+	formatStats(&w, "v0.120.0", []Window{
+		{Name: "24h", Stats: []Stat{
+			{Type: "Sticker", Passed: 1, Total: 2},
+		}},
+		{Name: "7d", Stats: []Stat{
+			{Type: "Photo", Passed: 3, Total: 3},
+			{Type: "Sticker", Passed: 9, Total: 10},
+		}},
+	})
+	a.Equal("file_id checks (gotd/td v0.120.0):\n\n"+
+		"Photo: 7d 100% (3/3)\n"+
+		"Sticker: 24h 50% (1/2), 7d 90% (9/10)\n", w.String())
+
+	w.Reset()
+	formatStats(&w, "v0.120.0", nil)
+	a.Equal("file_id checks (gotd/td v0.120.0):\n\nNo checks yet\n", w.String())
+}
@@ -0,0 +1,77 @@
+// Package health implements dependency health checks.
+package health
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/go-faster/errors"
+)
+
+// Check is named dependency check.
+type Check struct {
+	Name  string
+	Check func(ctx context.Context) error
+}
+
+// Result of check.
+type Result struct {
+	Name     string
+	Err      error
+	Duration time.Duration
+}
+
+// Checker runs dependency checks.
+type Checker struct {
+	checks  []Check
+	timeout time.Duration
+}
+
+// defaultTimeout limits single check.
+const defaultTimeout = time.Second * 5
+
+// New creates new Checker.
+func New(checks ...Check) *Checker {
+	return &Checker{
+		checks:  checks,
+		timeout: defaultTimeout,
+	}
+}
+
+// Run runs all checks concurrently and reports whether all of them passed.
+//
+// Results are in order of checks.
+func (c *Checker) Run(ctx context.Context) ([]Result, bool) {
+	results := make([]Result, len(c.checks))
+	var wg sync.WaitGroup
+	for i, check := range c.checks {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			ctx, cancel := context.WithTimeout(ctx, c.timeout)
+			defer cancel()
+
+			start := time.Now()
+			err := check.Check(ctx)
+			if err == nil && ctx.Err() != nil {
+				err = errors.Wrap(ctx.Err(), "check")
+			}
+			results[i] = Result{
+				Name:     check.Name,
+				Err:      err,
+				Duration: time.Since(start),
+			}
+		}()
+	}
+	wg.Wait()
+
+	ok := true
+	for _, r := range results {
+		if r.Err != nil {
+			ok = false
+		}
+	}
+	return results, ok
+}
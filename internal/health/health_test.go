@@ -0,0 +1,32 @@
+package health
+
+import (
+	"context"
+	"testing"
+
+	"github.com/go-faster/errors"
+	"github.com/stretchr/testify/require"
+)
+
+func TestChecker(t *testing.T) {
+	a := require.New(t)
+	ctx := context.Background()
+
+	ok := Check{Name: "ok", Check: func(ctx context.Context) error { return nil }}
+	fail := Check{Name: "fail", Check: func(ctx context.Context) error { return errors.New("down") }}
+
+	results, healthy := New(ok).Run(ctx)
+	a.True(healthy)
+	a.Len(results, 1)
+	a.Equal("ok", results[0].Name)
+	a.NoError(results[0].Err)
+
+	results, healthy = New(ok, fail).Run(ctx)
+	a.False(healthy)
+	a.Len(results, 2)
+	a.Equal("fail", results[1].Name)
+	a.EqualError(results[1].Err, "down")
+
+	_, healthy = New().Run(ctx)
+	a.True(healthy)
+}
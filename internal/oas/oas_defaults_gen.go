@@ -0,0 +1,31 @@
+// Code generated by ogen, DO NOT EDIT.
+
+package oas
+
+// setDefaults set default value of fields.
+func (s *AcquireTelegramAccountReq) setDefaults() {
+	{
+		val := TelegramDCList("Test")
+		s.DCList.SetTo(val)
+	}
+	{
+		val := bool(false)
+		s.Session.SetTo(val)
+	}
+}
+
+// setDefaults set default value of fields.
+func (s *AddTelegramAccountReq) setDefaults() {
+	{
+		val := TelegramDCList("Test")
+		s.DCList.SetTo(val)
+	}
+}
+
+// setDefaults set default value of fields.
+func (s *TelegramAccount) setDefaults() {
+	{
+		val := TelegramDCList("Test")
+		s.DCList = val
+	}
+}
@@ -20,6 +20,11 @@ func (s *AcquireTelegramAccountOK) SetFake() {
 			s.Token = uuid.New()
 		}
 	}
+	{
+		{
+			s.Session.SetFake()
+		}
+	}
 }
 
 // SetFake set fake values.
@@ -49,6 +54,50 @@ func (s *AcquireTelegramAccountReq) SetFake() {
 			s.RunAttempt = int(0)
 		}
 	}
+	{
+		{
+			s.DCList.SetFake()
+		}
+	}
+	{
+		{
+			s.Session.SetFake()
+		}
+	}
+}
+
+// SetFake set fake values.
+func (s *AddTelegramAccountReq) SetFake() {
+	{
+		{
+			s.ID.SetFake()
+		}
+	}
+	{
+		{
+			s.Password.SetFake()
+		}
+	}
+	{
+		{
+			s.DCList.SetFake()
+		}
+	}
+	{
+		{
+			s.DC.SetFake()
+		}
+	}
+	{
+		{
+			s.AppID.SetFake()
+		}
+	}
+	{
+		{
+			s.AppHash.SetFake()
+		}
+	}
 }
 
 // SetFake set fake values.
@@ -92,6 +141,78 @@ func (s *Health) SetFake() {
 			s.BuildDate = time.Now()
 		}
 	}
+	{
+		{
+			s.Checks = nil
+			for i := 0; i < 0; i++ {
+				var elem HealthCheck
+				{
+					elem.SetFake()
+				}
+				s.Checks = append(s.Checks, elem)
+			}
+		}
+	}
+}
+
+// SetFake set fake values.
+func (s *HealthCheck) SetFake() {
+	{
+		{
+			s.Name = "string"
+		}
+	}
+	{
+		{
+			s.Ok = true
+		}
+	}
+	{
+		{
+			s.Error.SetFake()
+		}
+	}
+	{
+		{
+			s.Duration = float64(0)
+		}
+	}
+}
+
+// SetFake set fake values.
+func (s *OptBool) SetFake() {
+	var elem bool
+	{
+		elem = true
+	}
+	s.SetTo(elem)
+}
+
+// SetFake set fake values.
+func (s *OptDateTime) SetFake() {
+	var elem time.Time
+	{
+		elem = time.Now()
+	}
+	s.SetTo(elem)
+}
+
+// SetFake set fake values.
+func (s *OptFloat64) SetFake() {
+	var elem float64
+	{
+		elem = float64(0)
+	}
+	s.SetTo(elem)
+}
+
+// SetFake set fake values.
+func (s *OptInt) SetFake() {
+	var elem int
+	{
+		elem = int(0)
+	}
+	s.SetTo(elem)
 }
 
 // SetFake set fake values.
@@ -112,6 +233,24 @@ func (s *OptString) SetFake() {
 	s.SetTo(elem)
 }
 
+// SetFake set fake values.
+func (s *OptTelegramDCList) SetFake() {
+	var elem TelegramDCList
+	{
+		elem.SetFake()
+	}
+	s.SetTo(elem)
+}
+
+// SetFake set fake values.
+func (s *OptTelegramSession) SetFake() {
+	var elem TelegramSession
+	{
+		elem.SetFake()
+	}
+	s.SetTo(elem)
+}
+
 // SetFake set fake values.
 func (s *OptTraceID) SetFake() {
 	var elem TraceID
@@ -139,6 +278,95 @@ func (s *SpanID) SetFake() {
 	*s = SpanID(unwrapped)
 }
 
+// SetFake set fake values.
+func (s *TelegramAccount) SetFake() {
+	{
+		{
+			s.ID.SetFake()
+		}
+	}
+	{
+		{
+			s.DCList.SetFake()
+		}
+	}
+	{
+		{
+			s.DC.SetFake()
+		}
+	}
+	{
+		{
+			s.AppID.SetFake()
+		}
+	}
+	{
+		{
+			s.State.SetFake()
+		}
+	}
+	{
+		{
+			s.Status = "string"
+		}
+	}
+	{
+		{
+			s.Disabled = true
+		}
+	}
+	{
+		{
+			s.Running = true
+		}
+	}
+	{
+		{
+			s.Healthy = true
+		}
+	}
+	{
+		{
+			s.Leased = true
+		}
+	}
+	{
+		{
+			s.HasSession = true
+		}
+	}
+	{
+		{
+			s.HasPassword = true
+		}
+	}
+	{
+		{
+			s.CodeAt.SetFake()
+		}
+	}
+	{
+		{
+			s.LastProbeAt.SetFake()
+		}
+	}
+	{
+		{
+			s.LastSuccessAt.SetFake()
+		}
+	}
+	{
+		{
+			s.ProbeLatency.SetFake()
+		}
+	}
+	{
+		{
+			s.ProbeError.SetFake()
+		}
+	}
+}
+
 // SetFake set fake values.
 func (s *TelegramAccountID) SetFake() {
 	var unwrapped string
@@ -148,6 +376,74 @@ func (s *TelegramAccountID) SetFake() {
 	*s = TelegramAccountID(unwrapped)
 }
 
+// SetFake set fake values.
+func (s *TelegramAccountState) SetFake() {
+	*s = TelegramAccountStateNew
+}
+
+// SetFake set fake values.
+func (s *TelegramDCList) SetFake() {
+	*s = TelegramDCListTest
+}
+
+// SetFake set fake values.
+func (s *TelegramServiceMessage) SetFake() {
+	{
+		{
+			s.ID = int(0)
+		}
+	}
+	{
+		{
+			s.Type.SetFake()
+		}
+	}
+	{
+		{
+			s.Text = "string"
+		}
+	}
+	{
+		{
+			s.Date = time.Now()
+		}
+	}
+}
+
+// SetFake set fake values.
+func (s *TelegramServiceMessageType) SetFake() {
+	*s = TelegramServiceMessageTypeCode
+}
+
+// SetFake set fake values.
+func (s *TelegramSession) SetFake() {
+	{
+		{
+			s.DC = int(0)
+		}
+	}
+	{
+		{
+			s.Addr = "string"
+		}
+	}
+	{
+		{
+			s.AuthKey = []byte("[]byte")
+		}
+	}
+	{
+		{
+			s.AuthKeyID = []byte("[]byte")
+		}
+	}
+	{
+		{
+			s.Salt = int64(0)
+		}
+	}
+}
+
 // SetFake set fake values.
 func (s *TraceID) SetFake() {
 	var unwrapped string
@@ -6,8 +6,16 @@ package oas
 type OperationName = string
 
 const (
-	AcquireTelegramAccountOperation   OperationName = "AcquireTelegramAccount"
-	GetHealthOperation                OperationName = "GetHealth"
-	HeartbeatTelegramAccountOperation OperationName = "HeartbeatTelegramAccount"
-	ReceiveTelegramCodeOperation      OperationName = "ReceiveTelegramCode"
+	AcquireTelegramAccountOperation      OperationName = "AcquireTelegramAccount"
+	AddTelegramAccountOperation          OperationName = "AddTelegramAccount"
+	DeleteTelegramAccountOperation       OperationName = "DeleteTelegramAccount"
+	DisableTelegramAccountOperation      OperationName = "DisableTelegramAccount"
+	EnableTelegramAccountOperation       OperationName = "EnableTelegramAccount"
+	GetHealthOperation                   OperationName = "GetHealth"
+	HeartbeatTelegramAccountOperation    OperationName = "HeartbeatTelegramAccount"
+	ListLeaseServiceMessagesOperation    OperationName = "ListLeaseServiceMessages"
+	ListTelegramAccountsOperation        OperationName = "ListTelegramAccounts"
+	ListTelegramServiceMessagesOperation OperationName = "ListTelegramServiceMessages"
+	ReceiveTelegramCodeOperation         OperationName = "ReceiveTelegramCode"
+	ReloginTelegramAccountOperation      OperationName = "ReloginTelegramAccount"
 )
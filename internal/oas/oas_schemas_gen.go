@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/go-faster/errors"
 	"github.com/google/uuid"
 )
 
@@ -16,7 +17,8 @@ func (s *ErrorStatusCode) Error() string {
 type AcquireTelegramAccountOK struct {
 	AccountID TelegramAccountID `json:"account_id"`
 	// Access token.
-	Token uuid.UUID `json:"token"`
+	Token   uuid.UUID          `json:"token"`
+	Session OptTelegramSession `json:"session"`
 }
 
 // GetAccountID returns the value of AccountID.
@@ -29,6 +31,11 @@ func (s *AcquireTelegramAccountOK) GetToken() uuid.UUID {
 	return s.Token
 }
 
+// GetSession returns the value of Session.
+func (s *AcquireTelegramAccountOK) GetSession() OptTelegramSession {
+	return s.Session
+}
+
 // SetAccountID sets the value of AccountID.
 func (s *AcquireTelegramAccountOK) SetAccountID(val TelegramAccountID) {
 	s.AccountID = val
@@ -39,15 +46,24 @@ func (s *AcquireTelegramAccountOK) SetToken(val uuid.UUID) {
 	s.Token = val
 }
 
+// SetSession sets the value of Session.
+func (s *AcquireTelegramAccountOK) SetSession(val OptTelegramSession) {
+	s.Session = val
+}
+
 type AcquireTelegramAccountReq struct {
 	// Repository owner.
 	RepoOwner string `json:"repo_owner"`
 	// Repository name.
 	RepoName string `json:"repo_name"`
 	// Job ID.
-	Job        string `json:"job"`
-	RunID      int64  `json:"run_id"`
-	RunAttempt int    `json:"run_attempt"`
+	Job        string            `json:"job"`
+	RunID      int64             `json:"run_id"`
+	RunAttempt int               `json:"run_attempt"`
+	DCList     OptTelegramDCList `json:"dc_list"`
+	// Return pre-authorized session of account, so login code is not required.
+	// Session is revoked when lease ends.
+	Session OptBool `json:"session"`
 }
 
 // GetRepoOwner returns the value of RepoOwner.
@@ -75,6 +91,16 @@ func (s *AcquireTelegramAccountReq) GetRunAttempt() int {
 	return s.RunAttempt
 }
 
+// GetDCList returns the value of DCList.
+func (s *AcquireTelegramAccountReq) GetDCList() OptTelegramDCList {
+	return s.DCList
+}
+
+// GetSession returns the value of Session.
+func (s *AcquireTelegramAccountReq) GetSession() OptBool {
+	return s.Session
+}
+
 // SetRepoOwner sets the value of RepoOwner.
 func (s *AcquireTelegramAccountReq) SetRepoOwner(val string) {
 	s.RepoOwner = val
@@ -100,6 +126,106 @@ func (s *AcquireTelegramAccountReq) SetRunAttempt(val int) {
 	s.RunAttempt = val
 }
 
+// SetDCList sets the value of DCList.
+func (s *AcquireTelegramAccountReq) SetDCList(val OptTelegramDCList) {
+	s.DCList = val
+}
+
+// SetSession sets the value of Session.
+func (s *AcquireTelegramAccountReq) SetSession(val OptBool) {
+	s.Session = val
+}
+
+type AddTelegramAccountReq struct {
+	ID TelegramAccountID `json:"id"`
+	// Cloud password (2FA).
+	Password OptString         `json:"password"`
+	DCList   OptTelegramDCList `json:"dc_list"`
+	// DC to connect to first, e.g. home DC of account.
+	DC OptInt `json:"dc"`
+	// Telegram app ID, Telegram Desktop is used if not set.
+	AppID OptInt `json:"app_id"`
+	// Telegram app hash, required if app_id is set.
+	AppHash OptString `json:"app_hash"`
+}
+
+// GetID returns the value of ID.
+func (s *AddTelegramAccountReq) GetID() TelegramAccountID {
+	return s.ID
+}
+
+// GetPassword returns the value of Password.
+func (s *AddTelegramAccountReq) GetPassword() OptString {
+	return s.Password
+}
+
+// GetDCList returns the value of DCList.
+func (s *AddTelegramAccountReq) GetDCList() OptTelegramDCList {
+	return s.DCList
+}
+
+// GetDC returns the value of DC.
+func (s *AddTelegramAccountReq) GetDC() OptInt {
+	return s.DC
+}
+
+// GetAppID returns the value of AppID.
+func (s *AddTelegramAccountReq) GetAppID() OptInt {
+	return s.AppID
+}
+
+// GetAppHash returns the value of AppHash.
+func (s *AddTelegramAccountReq) GetAppHash() OptString {
+	return s.AppHash
+}
+
+// SetID sets the value of ID.
+func (s *AddTelegramAccountReq) SetID(val TelegramAccountID) {
+	s.ID = val
+}
+
+// SetPassword sets the value of Password.
+func (s *AddTelegramAccountReq) SetPassword(val OptString) {
+	s.Password = val
+}
+
+// SetDCList sets the value of DCList.
+func (s *AddTelegramAccountReq) SetDCList(val OptTelegramDCList) {
+	s.DCList = val
+}
+
+// SetDC sets the value of DC.
+func (s *AddTelegramAccountReq) SetDC(val OptInt) {
+	s.DC = val
+}
+
+// SetAppID sets the value of AppID.
+func (s *AddTelegramAccountReq) SetAppID(val OptInt) {
+	s.AppID = val
+}
+
+// SetAppHash sets the value of AppHash.
+func (s *AddTelegramAccountReq) SetAppHash(val OptString) {
+	s.AppHash = val
+}
+
+type AdminAuth struct {
+	APIKey string
+}
+
+// GetAPIKey returns the value of APIKey.
+func (s *AdminAuth) GetAPIKey() string {
+	return s.APIKey
+}
+
+// SetAPIKey sets the value of APIKey.
+func (s *AdminAuth) SetAPIKey(val string) {
+	s.APIKey = val
+}
+
+// DeleteTelegramAccountNoContent is response for DeleteTelegramAccount operation.
+type DeleteTelegramAccountNoContent struct{}
+
 // Error occurred while processing request.
 // Ref: #/components/schemas/Error
 type Error struct {
@@ -175,6 +301,8 @@ type Health struct {
 	Commit string `json:"commit"`
 	// Service build date.
 	BuildDate time.Time `json:"build_date"`
+	// Dependency checks.
+	Checks []HealthCheck `json:"checks"`
 }
 
 // GetStatus returns the value of Status.
@@ -197,6 +325,11 @@ func (s *Health) GetBuildDate() time.Time {
 	return s.BuildDate
 }
 
+// GetChecks returns the value of Checks.
+func (s *Health) GetChecks() []HealthCheck {
+	return s.Checks
+}
+
 // SetStatus sets the value of Status.
 func (s *Health) SetStatus(val string) {
 	s.Status = val
@@ -217,6 +350,63 @@ func (s *Health) SetBuildDate(val time.Time) {
 	s.BuildDate = val
 }
 
+// SetChecks sets the value of Checks.
+func (s *Health) SetChecks(val []HealthCheck) {
+	s.Checks = val
+}
+
+// Ref: #/components/schemas/HealthCheck
+type HealthCheck struct {
+	// Dependency name.
+	Name string `json:"name"`
+	// Dependency is available.
+	Ok bool `json:"ok"`
+	// Check error.
+	Error OptString `json:"error"`
+	// Check duration, in seconds.
+	Duration float64 `json:"duration"`
+}
+
+// GetName returns the value of Name.
+func (s *HealthCheck) GetName() string {
+	return s.Name
+}
+
+// GetOk returns the value of Ok.
+func (s *HealthCheck) GetOk() bool {
+	return s.Ok
+}
+
+// GetError returns the value of Error.
+func (s *HealthCheck) GetError() OptString {
+	return s.Error
+}
+
+// GetDuration returns the value of Duration.
+func (s *HealthCheck) GetDuration() float64 {
+	return s.Duration
+}
+
+// SetName sets the value of Name.
+func (s *HealthCheck) SetName(val string) {
+	s.Name = val
+}
+
+// SetOk sets the value of Ok.
+func (s *HealthCheck) SetOk(val bool) {
+	s.Ok = val
+}
+
+// SetError sets the value of Error.
+func (s *HealthCheck) SetError(val OptString) {
+	s.Error = val
+}
+
+// SetDuration sets the value of Duration.
+func (s *HealthCheck) SetDuration(val float64) {
+	s.Duration = val
+}
+
 // HeartbeatTelegramAccountOK is response for HeartbeatTelegramAccount operation.
 type HeartbeatTelegramAccountOK struct{}
 
@@ -266,6 +456,144 @@ func (o OptBool) Or(d bool) bool {
 	return d
 }
 
+// NewOptDateTime returns new OptDateTime with value set to v.
+func NewOptDateTime(v time.Time) OptDateTime {
+	return OptDateTime{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptDateTime is optional time.Time.
+type OptDateTime struct {
+	Value time.Time
+	Set   bool
+}
+
+// IsSet returns true if OptDateTime was set.
+func (o OptDateTime) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptDateTime) Reset() {
+	var v time.Time
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptDateTime) SetTo(v time.Time) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptDateTime) Get() (v time.Time, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptDateTime) Or(d time.Time) time.Time {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptFloat64 returns new OptFloat64 with value set to v.
+func NewOptFloat64(v float64) OptFloat64 {
+	return OptFloat64{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptFloat64 is optional float64.
+type OptFloat64 struct {
+	Value float64
+	Set   bool
+}
+
+// IsSet returns true if OptFloat64 was set.
+func (o OptFloat64) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptFloat64) Reset() {
+	var v float64
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptFloat64) SetTo(v float64) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptFloat64) Get() (v float64, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptFloat64) Or(d float64) float64 {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptInt returns new OptInt with value set to v.
+func NewOptInt(v int) OptInt {
+	return OptInt{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptInt is optional int.
+type OptInt struct {
+	Value int
+	Set   bool
+}
+
+// IsSet returns true if OptInt was set.
+func (o OptInt) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptInt) Reset() {
+	var v int
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptInt) SetTo(v int) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptInt) Get() (v int, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptInt) Or(d int) int {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
 // NewOptSpanID returns new OptSpanID with value set to v.
 func NewOptSpanID(v SpanID) OptSpanID {
 	return OptSpanID{
@@ -358,6 +686,98 @@ func (o OptString) Or(d string) string {
 	return d
 }
 
+// NewOptTelegramDCList returns new OptTelegramDCList with value set to v.
+func NewOptTelegramDCList(v TelegramDCList) OptTelegramDCList {
+	return OptTelegramDCList{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptTelegramDCList is optional TelegramDCList.
+type OptTelegramDCList struct {
+	Value TelegramDCList
+	Set   bool
+}
+
+// IsSet returns true if OptTelegramDCList was set.
+func (o OptTelegramDCList) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptTelegramDCList) Reset() {
+	var v TelegramDCList
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptTelegramDCList) SetTo(v TelegramDCList) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptTelegramDCList) Get() (v TelegramDCList, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptTelegramDCList) Or(d TelegramDCList) TelegramDCList {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptTelegramSession returns new OptTelegramSession with value set to v.
+func NewOptTelegramSession(v TelegramSession) OptTelegramSession {
+	return OptTelegramSession{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptTelegramSession is optional TelegramSession.
+type OptTelegramSession struct {
+	Value TelegramSession
+	Set   bool
+}
+
+// IsSet returns true if OptTelegramSession was set.
+func (o OptTelegramSession) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptTelegramSession) Reset() {
+	var v TelegramSession
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptTelegramSession) SetTo(v TelegramSession) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptTelegramSession) Get() (v TelegramSession, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptTelegramSession) Or(d TelegramSession) TelegramSession {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
 // NewOptTraceID returns new OptTraceID with value set to v.
 func NewOptTraceID(v TraceID) OptTraceID {
 	return OptTraceID{
@@ -421,8 +841,494 @@ func (s *ReceiveTelegramCodeOK) SetCode(val OptString) {
 
 type SpanID string
 
+// Ref: #/components/schemas/TelegramAccount
+type TelegramAccount struct {
+	ID     TelegramAccountID `json:"id"`
+	DCList TelegramDCList    `json:"dc_list"`
+	// DC to connect to first.
+	DC OptInt `json:"dc"`
+	// Telegram app ID, if not default.
+	AppID OptInt `json:"app_id"`
+	// Account state.
+	State TelegramAccountState `json:"state"`
+	// Human-readable account status.
+	Status string `json:"status"`
+	// Account is disabled and is not running.
+	Disabled bool `json:"disabled"`
+	// Account runner is started.
+	Running bool `json:"running"`
+	// Account is authorized and can be leased.
+	Healthy bool `json:"healthy"`
+	// Account is leased.
+	Leased bool `json:"leased"`
+	// Account has stored session.
+	HasSession bool `json:"has_session"`
+	// Account has cloud password (2FA).
+	HasPassword bool `json:"has_password"`
+	// Time of last received code.
+	CodeAt OptDateTime `json:"code_at"`
+	// Time of last health probe.
+	LastProbeAt OptDateTime `json:"last_probe_at"`
+	// Time of last successful health probe.
+	LastSuccessAt OptDateTime `json:"last_success_at"`
+	// Latency of last successful health probe, in seconds.
+	ProbeLatency OptFloat64 `json:"probe_latency"`
+	// Error of last health probe.
+	ProbeError OptString `json:"probe_error"`
+}
+
+// GetID returns the value of ID.
+func (s *TelegramAccount) GetID() TelegramAccountID {
+	return s.ID
+}
+
+// GetDCList returns the value of DCList.
+func (s *TelegramAccount) GetDCList() TelegramDCList {
+	return s.DCList
+}
+
+// GetDC returns the value of DC.
+func (s *TelegramAccount) GetDC() OptInt {
+	return s.DC
+}
+
+// GetAppID returns the value of AppID.
+func (s *TelegramAccount) GetAppID() OptInt {
+	return s.AppID
+}
+
+// GetState returns the value of State.
+func (s *TelegramAccount) GetState() TelegramAccountState {
+	return s.State
+}
+
+// GetStatus returns the value of Status.
+func (s *TelegramAccount) GetStatus() string {
+	return s.Status
+}
+
+// GetDisabled returns the value of Disabled.
+func (s *TelegramAccount) GetDisabled() bool {
+	return s.Disabled
+}
+
+// GetRunning returns the value of Running.
+func (s *TelegramAccount) GetRunning() bool {
+	return s.Running
+}
+
+// GetHealthy returns the value of Healthy.
+func (s *TelegramAccount) GetHealthy() bool {
+	return s.Healthy
+}
+
+// GetLeased returns the value of Leased.
+func (s *TelegramAccount) GetLeased() bool {
+	return s.Leased
+}
+
+// GetHasSession returns the value of HasSession.
+func (s *TelegramAccount) GetHasSession() bool {
+	return s.HasSession
+}
+
+// GetHasPassword returns the value of HasPassword.
+func (s *TelegramAccount) GetHasPassword() bool {
+	return s.HasPassword
+}
+
+// GetCodeAt returns the value of CodeAt.
+func (s *TelegramAccount) GetCodeAt() OptDateTime {
+	return s.CodeAt
+}
+
+// GetLastProbeAt returns the value of LastProbeAt.
+func (s *TelegramAccount) GetLastProbeAt() OptDateTime {
+	return s.LastProbeAt
+}
+
+// GetLastSuccessAt returns the value of LastSuccessAt.
+func (s *TelegramAccount) GetLastSuccessAt() OptDateTime {
+	return s.LastSuccessAt
+}
+
+// GetProbeLatency returns the value of ProbeLatency.
+func (s *TelegramAccount) GetProbeLatency() OptFloat64 {
+	return s.ProbeLatency
+}
+
+// GetProbeError returns the value of ProbeError.
+func (s *TelegramAccount) GetProbeError() OptString {
+	return s.ProbeError
+}
+
+// SetID sets the value of ID.
+func (s *TelegramAccount) SetID(val TelegramAccountID) {
+	s.ID = val
+}
+
+// SetDCList sets the value of DCList.
+func (s *TelegramAccount) SetDCList(val TelegramDCList) {
+	s.DCList = val
+}
+
+// SetDC sets the value of DC.
+func (s *TelegramAccount) SetDC(val OptInt) {
+	s.DC = val
+}
+
+// SetAppID sets the value of AppID.
+func (s *TelegramAccount) SetAppID(val OptInt) {
+	s.AppID = val
+}
+
+// SetState sets the value of State.
+func (s *TelegramAccount) SetState(val TelegramAccountState) {
+	s.State = val
+}
+
+// SetStatus sets the value of Status.
+func (s *TelegramAccount) SetStatus(val string) {
+	s.Status = val
+}
+
+// SetDisabled sets the value of Disabled.
+func (s *TelegramAccount) SetDisabled(val bool) {
+	s.Disabled = val
+}
+
+// SetRunning sets the value of Running.
+func (s *TelegramAccount) SetRunning(val bool) {
+	s.Running = val
+}
+
+// SetHealthy sets the value of Healthy.
+func (s *TelegramAccount) SetHealthy(val bool) {
+	s.Healthy = val
+}
+
+// SetLeased sets the value of Leased.
+func (s *TelegramAccount) SetLeased(val bool) {
+	s.Leased = val
+}
+
+// SetHasSession sets the value of HasSession.
+func (s *TelegramAccount) SetHasSession(val bool) {
+	s.HasSession = val
+}
+
+// SetHasPassword sets the value of HasPassword.
+func (s *TelegramAccount) SetHasPassword(val bool) {
+	s.HasPassword = val
+}
+
+// SetCodeAt sets the value of CodeAt.
+func (s *TelegramAccount) SetCodeAt(val OptDateTime) {
+	s.CodeAt = val
+}
+
+// SetLastProbeAt sets the value of LastProbeAt.
+func (s *TelegramAccount) SetLastProbeAt(val OptDateTime) {
+	s.LastProbeAt = val
+}
+
+// SetLastSuccessAt sets the value of LastSuccessAt.
+func (s *TelegramAccount) SetLastSuccessAt(val OptDateTime) {
+	s.LastSuccessAt = val
+}
+
+// SetProbeLatency sets the value of ProbeLatency.
+func (s *TelegramAccount) SetProbeLatency(val OptFloat64) {
+	s.ProbeLatency = val
+}
+
+// SetProbeError sets the value of ProbeError.
+func (s *TelegramAccount) SetProbeError(val OptString) {
+	s.ProbeError = val
+}
+
 type TelegramAccountID string
 
+// Account state.
+type TelegramAccountState string
+
+const (
+	TelegramAccountStateNew      TelegramAccountState = "New"
+	TelegramAccountStateCodeSent TelegramAccountState = "CodeSent"
+	TelegramAccountStateActive   TelegramAccountState = "Active"
+	TelegramAccountStateError    TelegramAccountState = "Error"
+)
+
+// AllValues returns all TelegramAccountState values.
+func (TelegramAccountState) AllValues() []TelegramAccountState {
+	return []TelegramAccountState{
+		TelegramAccountStateNew,
+		TelegramAccountStateCodeSent,
+		TelegramAccountStateActive,
+		TelegramAccountStateError,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s TelegramAccountState) MarshalText() ([]byte, error) {
+	switch s {
+	case TelegramAccountStateNew:
+		return []byte(s), nil
+	case TelegramAccountStateCodeSent:
+		return []byte(s), nil
+	case TelegramAccountStateActive:
+		return []byte(s), nil
+	case TelegramAccountStateError:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *TelegramAccountState) UnmarshalText(data []byte) error {
+	switch TelegramAccountState(data) {
+	case TelegramAccountStateNew:
+		*s = TelegramAccountStateNew
+		return nil
+	case TelegramAccountStateCodeSent:
+		*s = TelegramAccountStateCodeSent
+		return nil
+	case TelegramAccountStateActive:
+		*s = TelegramAccountStateActive
+		return nil
+	case TelegramAccountStateError:
+		*s = TelegramAccountStateError
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
+
+// DC list of account.
+// Ref: #/components/schemas/TelegramDCList
+type TelegramDCList string
+
+const (
+	TelegramDCListTest       TelegramDCList = "Test"
+	TelegramDCListProduction TelegramDCList = "Production"
+)
+
+// AllValues returns all TelegramDCList values.
+func (TelegramDCList) AllValues() []TelegramDCList {
+	return []TelegramDCList{
+		TelegramDCListTest,
+		TelegramDCListProduction,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s TelegramDCList) MarshalText() ([]byte, error) {
+	switch s {
+	case TelegramDCListTest:
+		return []byte(s), nil
+	case TelegramDCListProduction:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *TelegramDCList) UnmarshalText(data []byte) error {
+	switch TelegramDCList(data) {
+	case TelegramDCListTest:
+		*s = TelegramDCListTest
+		return nil
+	case TelegramDCListProduction:
+		*s = TelegramDCListProduction
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
+
+// Message from Telegram service notifications.
+// Ref: #/components/schemas/TelegramServiceMessage
+type TelegramServiceMessage struct {
+	// Telegram message ID.
+	ID int `json:"id"`
+	// Parsed message type.
+	Type TelegramServiceMessageType `json:"type"`
+	// Message text.
+	Text string `json:"text"`
+	// Message date.
+	Date time.Time `json:"date"`
+}
+
+// GetID returns the value of ID.
+func (s *TelegramServiceMessage) GetID() int {
+	return s.ID
+}
+
+// GetType returns the value of Type.
+func (s *TelegramServiceMessage) GetType() TelegramServiceMessageType {
+	return s.Type
+}
+
+// GetText returns the value of Text.
+func (s *TelegramServiceMessage) GetText() string {
+	return s.Text
+}
+
+// GetDate returns the value of Date.
+func (s *TelegramServiceMessage) GetDate() time.Time {
+	return s.Date
+}
+
+// SetID sets the value of ID.
+func (s *TelegramServiceMessage) SetID(val int) {
+	s.ID = val
+}
+
+// SetType sets the value of Type.
+func (s *TelegramServiceMessage) SetType(val TelegramServiceMessageType) {
+	s.Type = val
+}
+
+// SetText sets the value of Text.
+func (s *TelegramServiceMessage) SetText(val string) {
+	s.Text = val
+}
+
+// SetDate sets the value of Date.
+func (s *TelegramServiceMessage) SetDate(val time.Time) {
+	s.Date = val
+}
+
+// Parsed message type.
+type TelegramServiceMessageType string
+
+const (
+	TelegramServiceMessageTypeCode              TelegramServiceMessageType = "Code"
+	TelegramServiceMessageTypeNewLogin          TelegramServiceMessageType = "NewLogin"
+	TelegramServiceMessageTypeEmailVerification TelegramServiceMessageType = "EmailVerification"
+	TelegramServiceMessageTypeFrozen            TelegramServiceMessageType = "Frozen"
+	TelegramServiceMessageTypeOther             TelegramServiceMessageType = "Other"
+)
+
+// AllValues returns all TelegramServiceMessageType values.
+func (TelegramServiceMessageType) AllValues() []TelegramServiceMessageType {
+	return []TelegramServiceMessageType{
+		TelegramServiceMessageTypeCode,
+		TelegramServiceMessageTypeNewLogin,
+		TelegramServiceMessageTypeEmailVerification,
+		TelegramServiceMessageTypeFrozen,
+		TelegramServiceMessageTypeOther,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s TelegramServiceMessageType) MarshalText() ([]byte, error) {
+	switch s {
+	case TelegramServiceMessageTypeCode:
+		return []byte(s), nil
+	case TelegramServiceMessageTypeNewLogin:
+		return []byte(s), nil
+	case TelegramServiceMessageTypeEmailVerification:
+		return []byte(s), nil
+	case TelegramServiceMessageTypeFrozen:
+		return []byte(s), nil
+	case TelegramServiceMessageTypeOther:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *TelegramServiceMessageType) UnmarshalText(data []byte) error {
+	switch TelegramServiceMessageType(data) {
+	case TelegramServiceMessageTypeCode:
+		*s = TelegramServiceMessageTypeCode
+		return nil
+	case TelegramServiceMessageTypeNewLogin:
+		*s = TelegramServiceMessageTypeNewLogin
+		return nil
+	case TelegramServiceMessageTypeEmailVerification:
+		*s = TelegramServiceMessageTypeEmailVerification
+		return nil
+	case TelegramServiceMessageTypeFrozen:
+		*s = TelegramServiceMessageTypeFrozen
+		return nil
+	case TelegramServiceMessageTypeOther:
+		*s = TelegramServiceMessageTypeOther
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
+
+// Pre-authorized MTProto session of leased account.
+// Ref: #/components/schemas/TelegramSession
+type TelegramSession struct {
+	// ID of DC session is bound to.
+	DC int `json:"dc"`
+	// Address of DC.
+	Addr string `json:"addr"`
+	// Authorization key.
+	AuthKey []byte `json:"auth_key"`
+	// Authorization key ID.
+	AuthKeyID []byte `json:"auth_key_id"`
+	// Server salt.
+	Salt int64 `json:"salt"`
+}
+
+// GetDC returns the value of DC.
+func (s *TelegramSession) GetDC() int {
+	return s.DC
+}
+
+// GetAddr returns the value of Addr.
+func (s *TelegramSession) GetAddr() string {
+	return s.Addr
+}
+
+// GetAuthKey returns the value of AuthKey.
+func (s *TelegramSession) GetAuthKey() []byte {
+	return s.AuthKey
+}
+
+// GetAuthKeyID returns the value of AuthKeyID.
+func (s *TelegramSession) GetAuthKeyID() []byte {
+	return s.AuthKeyID
+}
+
+// GetSalt returns the value of Salt.
+func (s *TelegramSession) GetSalt() int64 {
+	return s.Salt
+}
+
+// SetDC sets the value of DC.
+func (s *TelegramSession) SetDC(val int) {
+	s.DC = val
+}
+
+// SetAddr sets the value of Addr.
+func (s *TelegramSession) SetAddr(val string) {
+	s.Addr = val
+}
+
+// SetAuthKey sets the value of AuthKey.
+func (s *TelegramSession) SetAuthKey(val []byte) {
+	s.AuthKey = val
+}
+
+// SetAuthKeyID sets the value of AuthKeyID.
+func (s *TelegramSession) SetAuthKeyID(val []byte) {
+	s.AuthKeyID = val
+}
+
+// SetSalt sets the value of Salt.
+func (s *TelegramSession) SetSalt(val int64) {
+	s.Salt = val
+}
+
 type TokenAuth struct {
 	APIKey string
 }
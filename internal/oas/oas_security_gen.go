@@ -14,6 +14,8 @@ import (
 
 // SecurityHandler is handler for security parameters.
 type SecurityHandler interface {
+	// HandleAdminAuth handles adminAuth security.
+	HandleAdminAuth(ctx context.Context, operationName OperationName, t AdminAuth) (context.Context, error)
 	// HandleTokenAuth handles tokenAuth security.
 	HandleTokenAuth(ctx context.Context, operationName OperationName, t TokenAuth) (context.Context, error)
 }
@@ -33,6 +35,22 @@ func findAuthorization(h http.Header, prefix string) (string, bool) {
 	return "", false
 }
 
+func (s *Server) securityAdminAuth(ctx context.Context, operationName OperationName, req *http.Request) (context.Context, bool, error) {
+	var t AdminAuth
+	const parameterName = "Admin-Token"
+	value := req.Header.Get(parameterName)
+	if value == "" {
+		return ctx, false, nil
+	}
+	t.APIKey = value
+	rctx, err := s.sec.HandleAdminAuth(ctx, operationName, t)
+	if errors.Is(err, ogenerrors.ErrSkipServerSecurity) {
+		return nil, false, nil
+	} else if err != nil {
+		return nil, false, err
+	}
+	return rctx, true, err
+}
 func (s *Server) securityTokenAuth(ctx context.Context, operationName OperationName, req *http.Request) (context.Context, bool, error) {
 	var t TokenAuth
 	const parameterName = "Token"
@@ -52,10 +70,20 @@ func (s *Server) securityTokenAuth(ctx context.Context, operationName OperationN
 
 // SecuritySource is provider of security values (tokens, passwords, etc.).
 type SecuritySource interface {
+	// AdminAuth provides adminAuth security value.
+	AdminAuth(ctx context.Context, operationName OperationName) (AdminAuth, error)
 	// TokenAuth provides tokenAuth security value.
 	TokenAuth(ctx context.Context, operationName OperationName) (TokenAuth, error)
 }
 
+func (s *Client) securityAdminAuth(ctx context.Context, operationName OperationName, req *http.Request) error {
+	t, err := s.sec.AdminAuth(ctx, operationName)
+	if err != nil {
+		return errors.Wrap(err, "security source \"AdminAuth\"")
+	}
+	req.Header.Set("Admin-Token", t.APIKey)
+	return nil
+}
 func (s *Client) securityTokenAuth(ctx context.Context, operationName OperationName, req *http.Request) error {
 	t, err := s.sec.TokenAuth(ctx, operationName)
 	if err != nil {
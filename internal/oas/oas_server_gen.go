@@ -14,6 +14,30 @@ type Handler interface {
 	//
 	// POST /api/telegram/account/acquire
 	AcquireTelegramAccount(ctx context.Context, req *AcquireTelegramAccountReq) (*AcquireTelegramAccountOK, error)
+	// AddTelegramAccount implements addTelegramAccount operation.
+	//
+	// Add telegram account.
+	//
+	// POST /api/admin/telegram/accounts
+	AddTelegramAccount(ctx context.Context, req *AddTelegramAccountReq) (*TelegramAccount, error)
+	// DeleteTelegramAccount implements deleteTelegramAccount operation.
+	//
+	// Delete telegram account.
+	//
+	// DELETE /api/admin/telegram/accounts/{id}
+	DeleteTelegramAccount(ctx context.Context, params DeleteTelegramAccountParams) error
+	// DisableTelegramAccount implements disableTelegramAccount operation.
+	//
+	// Disable telegram account, stopping its runner.
+	//
+	// POST /api/admin/telegram/accounts/{id}/disable
+	DisableTelegramAccount(ctx context.Context, params DisableTelegramAccountParams) (*TelegramAccount, error)
+	// EnableTelegramAccount implements enableTelegramAccount operation.
+	//
+	// Enable telegram account.
+	//
+	// POST /api/admin/telegram/accounts/{id}/enable
+	EnableTelegramAccount(ctx context.Context, params EnableTelegramAccountParams) (*TelegramAccount, error)
 	// GetHealth implements getHealth operation.
 	//
 	// Get health.
@@ -26,12 +50,36 @@ type Handler interface {
 	//
 	// GET /api/telegram/account/heartbeat/{token}
 	HeartbeatTelegramAccount(ctx context.Context, params HeartbeatTelegramAccountParams) error
+	// ListLeaseServiceMessages implements listLeaseServiceMessages operation.
+	//
+	// List service messages received by leased account since lease start.
+	//
+	// GET /api/telegram/account/messages/{token}
+	ListLeaseServiceMessages(ctx context.Context, params ListLeaseServiceMessagesParams) ([]TelegramServiceMessage, error)
+	// ListTelegramAccounts implements listTelegramAccounts operation.
+	//
+	// List telegram accounts.
+	//
+	// GET /api/admin/telegram/accounts
+	ListTelegramAccounts(ctx context.Context) ([]TelegramAccount, error)
+	// ListTelegramServiceMessages implements listTelegramServiceMessages operation.
+	//
+	// List last service messages of telegram account, newest first.
+	//
+	// GET /api/admin/telegram/accounts/{id}/messages
+	ListTelegramServiceMessages(ctx context.Context, params ListTelegramServiceMessagesParams) ([]TelegramServiceMessage, error)
 	// ReceiveTelegramCode implements receiveTelegramCode operation.
 	//
 	// Receive telegram code.
 	//
 	// GET /api/telegram/code/receive/{token}
 	ReceiveTelegramCode(ctx context.Context, params ReceiveTelegramCodeParams) (*ReceiveTelegramCodeOK, error)
+	// ReloginTelegramAccount implements reloginTelegramAccount operation.
+	//
+	// Drop telegram account session, forcing new login.
+	//
+	// POST /api/admin/telegram/accounts/{id}/relogin
+	ReloginTelegramAccount(ctx context.Context, params ReloginTelegramAccountParams) (*TelegramAccount, error)
 	// NewError creates *ErrorStatusCode from error returned by handler.
 	//
 	// Used for common default response.
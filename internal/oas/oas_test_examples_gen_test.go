@@ -35,6 +35,18 @@ func TestAcquireTelegramAccountReq_EncodeDecode(t *testing.T) {
 	var typ2 AcquireTelegramAccountReq
 	require.NoError(t, typ2.Decode(jx.DecodeBytes(data)))
 }
+func TestAddTelegramAccountReq_EncodeDecode(t *testing.T) {
+	var typ AddTelegramAccountReq
+	typ.SetFake()
+
+	e := jx.Encoder{}
+	typ.Encode(&e)
+	data := e.Bytes()
+	require.True(t, std.Valid(data), "Encoded: %s", data)
+
+	var typ2 AddTelegramAccountReq
+	require.NoError(t, typ2.Decode(jx.DecodeBytes(data)))
+}
 func TestError_EncodeDecode(t *testing.T) {
 	var typ Error
 	typ.SetFake()
@@ -59,6 +71,18 @@ func TestHealth_EncodeDecode(t *testing.T) {
 	var typ2 Health
 	require.NoError(t, typ2.Decode(jx.DecodeBytes(data)))
 }
+func TestHealthCheck_EncodeDecode(t *testing.T) {
+	var typ HealthCheck
+	typ.SetFake()
+
+	e := jx.Encoder{}
+	typ.Encode(&e)
+	data := e.Bytes()
+	require.True(t, std.Valid(data), "Encoded: %s", data)
+
+	var typ2 HealthCheck
+	require.NoError(t, typ2.Decode(jx.DecodeBytes(data)))
+}
 func TestReceiveTelegramCodeOK_EncodeDecode(t *testing.T) {
 	var typ ReceiveTelegramCodeOK
 	typ.SetFake()
@@ -83,6 +107,18 @@ func TestSpanID_EncodeDecode(t *testing.T) {
 	var typ2 SpanID
 	require.NoError(t, typ2.Decode(jx.DecodeBytes(data)))
 }
+func TestTelegramAccount_EncodeDecode(t *testing.T) {
+	var typ TelegramAccount
+	typ.SetFake()
+
+	e := jx.Encoder{}
+	typ.Encode(&e)
+	data := e.Bytes()
+	require.True(t, std.Valid(data), "Encoded: %s", data)
+
+	var typ2 TelegramAccount
+	require.NoError(t, typ2.Decode(jx.DecodeBytes(data)))
+}
 func TestTelegramAccountID_EncodeDecode(t *testing.T) {
 	var typ TelegramAccountID
 	typ.SetFake()
@@ -95,6 +131,66 @@ func TestTelegramAccountID_EncodeDecode(t *testing.T) {
 	var typ2 TelegramAccountID
 	require.NoError(t, typ2.Decode(jx.DecodeBytes(data)))
 }
+func TestTelegramAccountState_EncodeDecode(t *testing.T) {
+	var typ TelegramAccountState
+	typ.SetFake()
+
+	e := jx.Encoder{}
+	typ.Encode(&e)
+	data := e.Bytes()
+	require.True(t, std.Valid(data), "Encoded: %s", data)
+
+	var typ2 TelegramAccountState
+	require.NoError(t, typ2.Decode(jx.DecodeBytes(data)))
+}
+func TestTelegramDCList_EncodeDecode(t *testing.T) {
+	var typ TelegramDCList
+	typ.SetFake()
+
+	e := jx.Encoder{}
+	typ.Encode(&e)
+	data := e.Bytes()
+	require.True(t, std.Valid(data), "Encoded: %s", data)
+
+	var typ2 TelegramDCList
+	require.NoError(t, typ2.Decode(jx.DecodeBytes(data)))
+}
+func TestTelegramServiceMessage_EncodeDecode(t *testing.T) {
+	var typ TelegramServiceMessage
+	typ.SetFake()
+
+	e := jx.Encoder{}
+	typ.Encode(&e)
+	data := e.Bytes()
+	require.True(t, std.Valid(data), "Encoded: %s", data)
+
+	var typ2 TelegramServiceMessage
+	require.NoError(t, typ2.Decode(jx.DecodeBytes(data)))
+}
+func TestTelegramServiceMessageType_EncodeDecode(t *testing.T) {
+	var typ TelegramServiceMessageType
+	typ.SetFake()
+
+	e := jx.Encoder{}
+	typ.Encode(&e)
+	data := e.Bytes()
+	require.True(t, std.Valid(data), "Encoded: %s", data)
+
+	var typ2 TelegramServiceMessageType
+	require.NoError(t, typ2.Decode(jx.DecodeBytes(data)))
+}
+func TestTelegramSession_EncodeDecode(t *testing.T) {
+	var typ TelegramSession
+	typ.SetFake()
+
+	e := jx.Encoder{}
+	typ.Encode(&e)
+	data := e.Bytes()
+	require.True(t, std.Valid(data), "Encoded: %s", data)
+
+	var typ2 TelegramSession
+	require.NoError(t, typ2.Decode(jx.DecodeBytes(data)))
+}
 func TestTraceID_EncodeDecode(t *testing.T) {
 	var typ TraceID
 	typ.SetFake()
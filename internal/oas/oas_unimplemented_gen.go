@@ -22,6 +22,42 @@ func (UnimplementedHandler) AcquireTelegramAccount(ctx context.Context, req *Acq
 	return r, ht.ErrNotImplemented
 }
 
+// AddTelegramAccount implements addTelegramAccount operation.
+//
+// Add telegram account.
+//
+// POST /api/admin/telegram/accounts
+func (UnimplementedHandler) AddTelegramAccount(ctx context.Context, req *AddTelegramAccountReq) (r *TelegramAccount, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// DeleteTelegramAccount implements deleteTelegramAccount operation.
+//
+// Delete telegram account.
+//
+// DELETE /api/admin/telegram/accounts/{id}
+func (UnimplementedHandler) DeleteTelegramAccount(ctx context.Context, params DeleteTelegramAccountParams) error {
+	return ht.ErrNotImplemented
+}
+
+// DisableTelegramAccount implements disableTelegramAccount operation.
+//
+// Disable telegram account, stopping its runner.
+//
+// POST /api/admin/telegram/accounts/{id}/disable
+func (UnimplementedHandler) DisableTelegramAccount(ctx context.Context, params DisableTelegramAccountParams) (r *TelegramAccount, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// EnableTelegramAccount implements enableTelegramAccount operation.
+//
+// Enable telegram account.
+//
+// POST /api/admin/telegram/accounts/{id}/enable
+func (UnimplementedHandler) EnableTelegramAccount(ctx context.Context, params EnableTelegramAccountParams) (r *TelegramAccount, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
 // GetHealth implements getHealth operation.
 //
 // Get health.
@@ -40,6 +76,33 @@ func (UnimplementedHandler) HeartbeatTelegramAccount(ctx context.Context, params
 	return ht.ErrNotImplemented
 }
 
+// ListLeaseServiceMessages implements listLeaseServiceMessages operation.
+//
+// List service messages received by leased account since lease start.
+//
+// GET /api/telegram/account/messages/{token}
+func (UnimplementedHandler) ListLeaseServiceMessages(ctx context.Context, params ListLeaseServiceMessagesParams) (r []TelegramServiceMessage, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// ListTelegramAccounts implements listTelegramAccounts operation.
+//
+// List telegram accounts.
+//
+// GET /api/admin/telegram/accounts
+func (UnimplementedHandler) ListTelegramAccounts(ctx context.Context) (r []TelegramAccount, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// ListTelegramServiceMessages implements listTelegramServiceMessages operation.
+//
+// List last service messages of telegram account, newest first.
+//
+// GET /api/admin/telegram/accounts/{id}/messages
+func (UnimplementedHandler) ListTelegramServiceMessages(ctx context.Context, params ListTelegramServiceMessagesParams) (r []TelegramServiceMessage, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
 // ReceiveTelegramCode implements receiveTelegramCode operation.
 //
 // Receive telegram code.
@@ -49,6 +112,15 @@ func (UnimplementedHandler) ReceiveTelegramCode(ctx context.Context, params Rece
 	return r, ht.ErrNotImplemented
 }
 
+// ReloginTelegramAccount implements reloginTelegramAccount operation.
+//
+// Drop telegram account session, forcing new login.
+//
+// POST /api/admin/telegram/accounts/{id}/relogin
+func (UnimplementedHandler) ReloginTelegramAccount(ctx context.Context, params ReloginTelegramAccountParams) (r *TelegramAccount, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
 // NewError creates *ErrorStatusCode from error returned by handler.
 //
 // Used for common default response.
@@ -0,0 +1,129 @@
+// Package secret implements encryption of secrets stored at rest.
+package secret
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+
+	"github.com/go-faster/errors"
+)
+
+const (
+	// KeySize is required key size, AES-256 is used.
+	KeySize = 32
+	// keyIDSize is size of key ID prefix of ciphertext.
+	keyIDSize = 4
+)
+
+// ErrUnknownKey means that ciphertext was encrypted with unknown key.
+var ErrUnknownKey = errors.New("unknown key")
+
+type keyID [keyIDSize]byte
+
+// Box encrypts and decrypts secrets using AES-GCM.
+//
+// Ciphertext is prefixed with ID of key, so it is possible to find out
+// which key was used for encryption. Secrets are always encrypted with
+// primary key, previous keys are only used for decryption, which allows
+// key rotation.
+type Box struct {
+	id   keyID
+	keys map[keyID]cipher.AEAD
+}
+
+// KeyID returns hex-encoded ID of primary key.
+func (b *Box) KeyID() string {
+	return hex.EncodeToString(b.id[:])
+}
+
+// Primary reports whether ciphertext was encrypted with primary key.
+//
+// Ciphertexts encrypted with other keys should be re-encrypted.
+func (b *Box) Primary(ciphertext []byte) bool {
+	return len(ciphertext) >= keyIDSize && string(ciphertext[:keyIDSize]) == string(b.id[:])
+}
+
+// Seal encrypts given plaintext with primary key.
+func (b *Box) Seal(plaintext []byte) ([]byte, error) {
+	aead := b.keys[b.id]
+	nonceSize := aead.NonceSize()
+	out := make([]byte, keyIDSize+nonceSize, keyIDSize+nonceSize+len(plaintext)+aead.Overhead())
+	copy(out, b.id[:])
+
+	nonce := out[keyIDSize:]
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, errors.Wrap(err, "generate nonce")
+	}
+
+	return aead.Seal(out, nonce, plaintext, b.id[:]), nil
+}
+
+// Open decrypts given ciphertext with key it was encrypted with.
+func (b *Box) Open(ciphertext []byte) ([]byte, error) {
+	if len(ciphertext) < keyIDSize {
+		return nil, errors.New("ciphertext too short")
+	}
+	var id keyID
+	copy(id[:], ciphertext)
+	aead, ok := b.keys[id]
+	if !ok {
+		return nil, errors.Wrapf(ErrUnknownKey, "key %x", id)
+	}
+
+	nonceSize := aead.NonceSize()
+	if len(ciphertext) < keyIDSize+nonceSize {
+		return nil, errors.New("ciphertext too short")
+	}
+	nonce, data := ciphertext[keyIDSize:keyIDSize+nonceSize], ciphertext[keyIDSize+nonceSize:]
+
+	plaintext, err := aead.Open(nil, nonce, data, id[:])
+	if err != nil {
+		return nil, errors.Wrap(err, "decrypt")
+	}
+	return plaintext, nil
+}
+
+func newAEAD(key []byte) (keyID, cipher.AEAD, error) {
+	if len(key) != KeySize {
+		return keyID{}, nil, errors.Errorf("invalid key size %d, expected %d", len(key), KeySize)
+	}
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return keyID{}, nil, errors.Wrap(err, "create cipher")
+	}
+	aead, err := cipher.NewGCM(block)
+	if err != nil {
+		return keyID{}, nil, errors.Wrap(err, "create gcm")
+	}
+
+	var id keyID
+	sum := sha256.Sum256(key)
+	copy(id[:], sum[:keyIDSize])
+	return id, aead, nil
+}
+
+// New creates new Box from given primary key and optional previous keys.
+func New(key []byte, previous ...[]byte) (*Box, error) {
+	id, aead, err := newAEAD(key)
+	if err != nil {
+		return nil, err
+	}
+	b := &Box{
+		id:   id,
+		keys: map[keyID]cipher.AEAD{id: aead},
+	}
+	for i, k := range previous {
+		id, aead, err := newAEAD(k)
+		if err != nil {
+			return nil, errors.Wrapf(err, "previous key %d", i)
+		}
+		if _, ok := b.keys[id]; ok {
+			continue
+		}
+		b.keys[id] = aead
+	}
+	return b, nil
+}
@@ -0,0 +1,70 @@
+package secret
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestBox(t *testing.T) {
+	a := require.New(t)
+
+	b, err := New(bytes.Repeat([]byte{1}, KeySize))
+	a.NoError(err)
+
+	data := []byte("hunter2")
+	sealed, err := b.Seal(data)
+	a.NoError(err)
+	a.NotContains(string(sealed), string(data))
+
+	opened, err := b.Open(sealed)
+	a.NoError(err)
+	a.Equal(data, opened)
+
+	// Tampered ciphertext.
+	sealed[len(sealed)-1] ^= 1
+	_, err = b.Open(sealed)
+	a.Error(err)
+
+	// Another key.
+	other, err := New(bytes.Repeat([]byte{2}, KeySize))
+	a.NoError(err)
+	a.NotEqual(b.KeyID(), other.KeyID())
+	sealed, err = other.Seal(data)
+	a.NoError(err)
+	_, err = b.Open(sealed)
+	a.ErrorIs(err, ErrUnknownKey)
+
+	_, err = New([]byte{1})
+	a.Error(err)
+}
+
+func TestBox_Rotation(t *testing.T) {
+	a := require.New(t)
+
+	oldKey := bytes.Repeat([]byte{1}, KeySize)
+	old, err := New(oldKey)
+	a.NoError(err)
+	b, err := New(bytes.Repeat([]byte{2}, KeySize), oldKey)
+	a.NoError(err)
+	a.NotEqual(old.KeyID(), b.KeyID())
+
+	data := []byte("hunter2")
+	sealed, err := old.Seal(data)
+	a.NoError(err)
+	a.False(b.Primary(sealed))
+
+	opened, err := b.Open(sealed)
+	a.NoError(err)
+	a.Equal(data, opened)
+
+	sealed, err = b.Seal(data)
+	a.NoError(err)
+	a.True(b.Primary(sealed))
+	_, err = old.Open(sealed)
+	a.ErrorIs(err, ErrUnknownKey)
+
+	_, err = New(bytes.Repeat([]byte{2}, KeySize), []byte{1})
+	a.Error(err)
+}
@@ -0,0 +1,85 @@
+package secret
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/go-faster/errors"
+	"github.com/gotd/td/session"
+)
+
+var _ session.Storage = SessionStorage{}
+
+// SessionStorage is session.Storage wrapper that encrypts session data
+// before passing it to underlying storage.
+//
+// Plaintext sessions stored before encryption was enabled are loaded as is
+// and encrypted on next store.
+type SessionStorage struct {
+	next session.Storage
+	box  *Box
+}
+
+// NewSessionStorage wraps given storage with encryption.
+func NewSessionStorage(next session.Storage, box *Box) SessionStorage {
+	return SessionStorage{next: next, box: box}
+}
+
+// plaintext reports whether data is unencrypted session.
+//
+// Sessions are stored as JSON, while ciphertext is random-looking
+// and is never valid JSON.
+func plaintext(data []byte) bool {
+	return len(data) > 0 && data[0] == '{' && json.Valid(data)
+}
+
+// LoadSession implements session.Storage.
+func (s SessionStorage) LoadSession(ctx context.Context) ([]byte, error) {
+	data, err := s.next.LoadSession(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if plaintext(data) {
+		return data, nil
+	}
+	out, err := s.box.Open(data)
+	if err != nil {
+		return nil, errors.Wrap(err, "decrypt session")
+	}
+	return out, nil
+}
+
+// StoreSession implements session.Storage.
+func (s SessionStorage) StoreSession(ctx context.Context, data []byte) error {
+	sealed, err := s.box.Seal(data)
+	if err != nil {
+		return errors.Wrap(err, "encrypt session")
+	}
+	return s.next.StoreSession(ctx, sealed)
+}
+
+// Rotate re-encrypts stored session with primary key if it is plaintext
+// or encrypted with previous key.
+//
+// Returns true if session was re-encrypted.
+func (s SessionStorage) Rotate(ctx context.Context) (bool, error) {
+	data, err := s.next.LoadSession(ctx)
+	if errors.Is(err, session.ErrNotFound) {
+		return false, nil
+	}
+	if err != nil {
+		return false, errors.Wrap(err, "load")
+	}
+	if s.box.Primary(data) && !plaintext(data) {
+		return false, nil
+	}
+	if !plaintext(data) {
+		if data, err = s.box.Open(data); err != nil {
+			return false, errors.Wrap(err, "decrypt session")
+		}
+	}
+	if err := s.StoreSession(ctx, data); err != nil {
+		return false, errors.Wrap(err, "store")
+	}
+	return true, nil
+}
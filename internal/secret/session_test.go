@@ -0,0 +1,69 @@
+package secret
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/gotd/td/session"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSessionStorage(t *testing.T) {
+	a := require.New(t)
+	ctx := context.Background()
+
+	oldKey := bytes.Repeat([]byte{1}, KeySize)
+	newKey := bytes.Repeat([]byte{2}, KeySize)
+	data := []byte(`{"Version":1,"Data":{"DC":2}}`)
+
+	mem := new(session.StorageMemory)
+	old, err := New(oldKey)
+	a.NoError(err)
+	box, err := New(newKey, oldKey)
+	a.NoError(err)
+
+	// Not found is passed through.
+	_, err = NewSessionStorage(mem, box).LoadSession(ctx)
+	a.ErrorIs(err, session.ErrNotFound)
+	rotated, err := NewSessionStorage(mem, box).Rotate(ctx)
+	a.NoError(err)
+	a.False(rotated)
+
+	// Plaintext session is loaded as is.
+	a.NoError(mem.StoreSession(ctx, data))
+	loaded, err := NewSessionStorage(mem, box).LoadSession(ctx)
+	a.NoError(err)
+	a.Equal(data, loaded)
+
+	// Session encrypted with previous key is decrypted and rotated.
+	a.NoError(NewSessionStorage(mem, old).StoreSession(ctx, data))
+	raw, err := mem.LoadSession(ctx)
+	a.NoError(err)
+	a.NotContains(string(raw), "Version")
+	a.False(box.Primary(raw))
+
+	s := NewSessionStorage(mem, box)
+	loaded, err = s.LoadSession(ctx)
+	a.NoError(err)
+	a.Equal(data, loaded)
+
+	rotated, err = s.Rotate(ctx)
+	a.NoError(err)
+	a.True(rotated)
+	raw, err = mem.LoadSession(ctx)
+	a.NoError(err)
+	a.True(box.Primary(raw))
+
+	rotated, err = s.Rotate(ctx)
+	a.NoError(err)
+	a.False(rotated)
+
+	loaded, err = s.LoadSession(ctx)
+	a.NoError(err)
+	a.Equal(data, loaded)
+
+	// Old key alone can't decrypt anymore.
+	_, err = NewSessionStorage(mem, old).LoadSession(ctx)
+	a.ErrorIs(err, ErrUnknownKey)
+}
@@ -2,55 +2,120 @@ package tgmanager
 
 import (
 	"context"
+	"hash/fnv"
 	"regexp"
+	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/go-faster/errors"
 	"github.com/gotd/td/telegram"
 	"github.com/gotd/td/telegram/auth"
-	"github.com/gotd/td/telegram/dcs"
 	"github.com/gotd/td/tg"
 	"go.opentelemetry.io/otel/trace"
 	"go.uber.org/zap"
 
 	"github.com/gotd/bot/internal/ent"
 	"github.com/gotd/bot/internal/ent/telegramaccount"
+	"github.com/gotd/bot/internal/secret"
 )
 
 type Account struct {
 	client *telegram.Client
 	number string
+	config accountConfig
 	lg     *zap.Logger
 	db     *ent.Client
 	tracer trace.Tracer
+	secret *secret.Box
+	notify *Notifier
+
+	// ready is set while account is authorized and last probe succeeded.
+	ready     atomic.Bool
+	healthMux sync.Mutex
+	health    Health
+}
+
+// Healthy reports whether account is authorized and last probe succeeded.
+func (a *Account) Healthy() bool {
+	return a.ready.Load()
 }
 
-// terminalAuth implements auth.UserAuthenticator prompting the terminal for
-// input.
+// codeAuth implements auth.UserAuthenticator waiting for code from
+// Telegram support messages.
 type codeAuth struct {
 	phone string
 	acc   *Account
+
+	// tos is terms of service to accept after sign up.
+	tos *tg.HelpTermsOfService
 }
 
-func (codeAuth) SignUp(ctx context.Context) (auth.UserInfo, error) {
-	return auth.UserInfo{}, errors.New("not implemented")
+// errSignUpForbidden is returned on attempt to sign up production account.
+var errSignUpForbidden = errors.New("sign up is not allowed on production DC")
+
+func (a *codeAuth) SignUp(ctx context.Context) (auth.UserInfo, error) {
+	if a.acc.config.production() {
+		// Production accounts are registered manually, so retrying is pointless.
+		a.acc.lg.Error("Production account is not registered")
+		if err := a.acc.db.TelegramAccount.UpdateOneID(a.phone).
+			SetDisabled(true).
+			SetState(telegramaccount.StateError).
+			SetStatus("Not registered, sign up is not allowed on production DC").
+			Exec(ctx); err != nil {
+			return auth.UserInfo{}, errors.Wrap(err, "update account")
+		}
+		return auth.UserInfo{}, errSignUpForbidden
+	}
+	info := generateUserInfo(a.phone)
+	a.acc.lg.Info("Signing up",
+		zap.String("first_name", info.FirstName),
+		zap.String("last_name", info.LastName),
+	)
+	return info, nil
 }
 
-func (codeAuth) AcceptTermsOfService(ctx context.Context, tos tg.HelpTermsOfService) error {
-	return &auth.SignUpRequired{TermsOfService: tos}
+func (a *codeAuth) AcceptTermsOfService(ctx context.Context, tos tg.HelpTermsOfService) error {
+	// Terms can be accepted only after sign up, when we are authorized.
+	a.tos = &tos
+	return nil
 }
 
-func (a codeAuth) Code(ctx context.Context, sentCode *tg.AuthSentCode) (string, error) {
+func (a *codeAuth) Code(ctx context.Context, sentCode *tg.AuthSentCode) (string, error) {
 	// Waiting for code.
 	return a.acc.WaitForCode(ctx, sentCode)
 }
 
-func (a codeAuth) Phone(_ context.Context) (string, error) {
+func (a *codeAuth) Phone(_ context.Context) (string, error) {
 	return a.phone, nil
 }
 
-func (codeAuth) Password(_ context.Context) (string, error) {
-	return "", errors.New("password not supported")
+func (a *codeAuth) Password(ctx context.Context) (string, error) {
+	return a.acc.Password(ctx)
+}
+
+var (
+	firstNames = []string{
+		"Alice", "Bob", "Carol", "Dave", "Eve", "Frank", "Grace", "Heidi",
+		"Ivan", "Judy", "Mallory", "Niaj", "Olivia", "Peggy", "Rupert", "Sybil",
+		"Trent", "Victor", "Walter",
+	}
+	lastNames = []string{
+		"Gopher", "Tester", "Canary", "Runner", "Checker", "Probe",
+	}
+)
+
+// generateUserInfo generates user info for sign up.
+//
+// Result is stable for the same phone.
+func generateUserInfo(phone string) auth.UserInfo {
+	h := fnv.New32a()
+	_, _ = h.Write([]byte(phone))
+	sum := h.Sum32()
+	return auth.UserInfo{
+		FirstName: firstNames[sum%uint32(len(firstNames))],
+		LastName:  lastNames[(sum/uint32(len(firstNames)))%uint32(len(lastNames))],
+	}
 }
 
 func extractCode(message string) string {
@@ -64,12 +129,20 @@ func extractCode(message string) string {
 	return ""
 }
 
-func NewAccount(lg *zap.Logger, db *ent.Client, tracer trace.Tracer, number string) *Account {
+// codePollInterval is interval of polling database for code in addition to
+// notifications.
+const codePollInterval = time.Second * 10
+
+func NewAccount(lg *zap.Logger, db *ent.Client, tracer trace.Tracer, box *secret.Box, notify *Notifier, account *ent.TelegramAccount) *Account {
+	number := account.ID
 	acc := &Account{
 		lg:     lg.Named("account"),
 		number: number,
+		config: newAccountConfig(account),
 		db:     db,
 		tracer: tracer,
+		secret: box,
+		notify: notify,
 	}
 
 	const supportID = 777000
@@ -94,36 +167,13 @@ func NewAccount(lg *zap.Logger, db *ent.Client, tracer trace.Tracer, number stri
 			return nil
 		}
 
-		text := msg.Message
-		code := extractCode(text)
-		if code == "" {
-			lg.Info("Code not found")
-			return nil
-		}
-
-		if err := db.TelegramAccount.UpdateOneID(number).
-			SetCode(code).
-			SetCodeAt(time.Now()).
-			SetStatus("New code received").
-			Exec(ctx); err != nil {
-			return errors.Wrap(err, "update account")
-		}
-		lg.Info("Code updated to",
-			zap.String("code", code),
-		)
-
-		return nil
+		return acc.onServiceMessage(ctx, msg)
 	})
 
-	// https://github.com/telegramdesktop/tdesktop/blob/dev/docs/api_credentials.md
-	client := telegram.NewClient(17349, "344583e45741c457fe1862106095a5eb", telegram.Options{
-		DCList:        dcs.Test(),
-		Logger:        lg.Named("client"),
-		UpdateHandler: dispatcher,
-		SessionStorage: &SessionStorage{
-			id: number,
-			db: db,
-		},
+	client := acc.config.newClient(telegram.Options{
+		Logger:         lg.Named("client"),
+		UpdateHandler:  dispatcher,
+		SessionStorage: newSessionStorage(db, box, number),
 	})
 	acc.withClient(client)
 
@@ -142,29 +192,64 @@ func (a *Account) setState(ctx context.Context, state telegramaccount.State) err
 		Exec(ctx)
 }
 
+func (a *Account) setError(ctx context.Context, status string) error {
+	return a.db.TelegramAccount.UpdateOneID(a.number).
+		SetState(telegramaccount.StateError).
+		SetStatus(status).
+		Exec(ctx)
+}
+
 func (a *Account) Run(ctx context.Context) error {
 	if a.client == nil {
 		return errors.New("client is not initialized")
 	}
 	a.lg.Info("Starting")
-	flow := auth.NewFlow(&codeAuth{
+	defer a.ready.Store(false)
+	ca := &codeAuth{
 		phone: a.number,
 		acc:   a,
-	}, auth.SendCodeOptions{})
+	}
+	flow := auth.NewFlow(ca, auth.SendCodeOptions{})
 	return a.client.Run(ctx, func(ctx context.Context) error {
 		a.lg.Info("Running")
 		if err := a.client.Auth().IfNecessary(ctx, flow); err != nil {
 			return errors.Wrap(err, "auth")
 		}
 		a.lg.Info("Auth ok")
+		if tos := ca.tos; tos != nil {
+			if _, err := a.client.API().HelpAcceptTermsOfService(ctx, tos.ID); err != nil {
+				return errors.Wrap(err, "accept terms of service")
+			}
+			a.lg.Info("Terms of service accepted", zap.String("id", tos.ID.Data))
+		}
 		if err := a.setState(ctx, telegramaccount.StateActive); err != nil {
 			return errors.Wrap(err, "update account")
 		}
-		<-ctx.Done()
-		return ctx.Err()
+		return a.runProbes(ctx)
 	})
 }
 
+// Password returns decrypted cloud password of account.
+//
+// If password is not set, returns auth.ErrPasswordNotProvided.
+func (a *Account) Password(ctx context.Context) (string, error) {
+	acc, err := a.db.TelegramAccount.Get(ctx, a.number)
+	if err != nil {
+		return "", errors.Wrap(err, "get account")
+	}
+	if acc.Password == nil || len(*acc.Password) == 0 {
+		return "", auth.ErrPasswordNotProvided
+	}
+	if a.secret == nil {
+		return "", errors.New("secret key is not configured")
+	}
+	password, err := a.secret.Open(*acc.Password)
+	if err != nil {
+		return "", errors.Wrap(err, "decrypt password")
+	}
+	return string(password), nil
+}
+
 func (a *Account) WaitForCode(ctx context.Context, code *tg.AuthSentCode) (ret string, rerr error) {
 	// Wait for code to be sent via API.
 	ctx, span := a.tracer.Start(ctx, "WaitForCode")
@@ -180,26 +265,34 @@ func (a *Account) WaitForCode(ctx context.Context, code *tg.AuthSentCode) (ret s
 		return "", errors.Wrap(err, "update account")
 	}
 
+	updates, unsubscribe := a.notify.Subscribe(a.number)
+	defer unsubscribe()
+
 	start := time.Now()
-	ticker := time.NewTicker(time.Second)
+	// Notification can be lost, e.g. if code is set directly in database,
+	// so also poll occasionally.
+	ticker := time.NewTicker(codePollInterval)
+	defer ticker.Stop()
 	for {
 		select {
 		case <-ctx.Done():
 			return "", ctx.Err()
+		case <-updates:
 		case <-ticker.C:
-			acc, err := a.db.TelegramAccount.Get(ctx, a.number)
-			if err != nil {
-				return "", errors.Wrap(err, "get account")
-			}
-			if acc.Code == nil || acc.CodeAt == nil || *acc.Code == "" {
-				a.lg.Info("Code not received")
-				continue
-			}
-			if acc.CodeAt.Before(start) {
-				a.lg.Info("Code expired")
-				continue
-			}
-			return *acc.Code, nil
 		}
+
+		acc, err := a.db.TelegramAccount.Get(ctx, a.number)
+		if err != nil {
+			return "", errors.Wrap(err, "get account")
+		}
+		if acc.Code == nil || acc.CodeAt == nil || *acc.Code == "" {
+			a.lg.Info("Code not received")
+			continue
+		}
+		if acc.CodeAt.Before(start) {
+			a.lg.Info("Code expired")
+			continue
+		}
+		return *acc.Code, nil
 	}
 }
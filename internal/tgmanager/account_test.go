@@ -1,9 +1,19 @@
 package tgmanager
 
 import (
+	"context"
 	"testing"
+	"time"
 
+	"github.com/go-faster/errors"
+	"github.com/gotd/td/tg"
 	"github.com/stretchr/testify/require"
+	tracenoop "go.opentelemetry.io/otel/trace/noop"
+	"go.uber.org/zap/zaptest"
+
+	"github.com/gotd/bot/internal/ent"
+	"github.com/gotd/bot/internal/ent/hook"
+	"github.com/gotd/bot/internal/ent/telegramservicemessage"
 )
 
 func Test_extractCode(t *testing.T) {
@@ -45,3 +55,118 @@ If you think that somebody logged in to your account against your will, you can
 		})
 	}
 }
+
+func Test_parseServiceMessage(t *testing.T) {
+	for _, tt := range []struct {
+		Name    string
+		Message string
+		Type    telegramservicemessage.Type
+		Code    string
+	}{
+		{
+			Name:    "Empty",
+			Message: "",
+			Type:    telegramservicemessage.TypeOther,
+		},
+		{
+			Name:    "Code",
+			Message: `Login code: 70021. Do not give this code to anyone, even if they say they are from Telegram!`,
+			Type:    telegramservicemessage.TypeCode,
+			Code:    "70021",
+		},
+		{
+			Name: "NewLogin",
+			Message: `New login. Dear Foo, we detected a login into your account from a new device on 08/12/2024 at 11:43:00 UTC.
+
+Device: tdesktop, v0.115.0, go1.23.3, Desktop, linux
+Location: Russia`,
+			Type: telegramservicemessage.TypeNewLogin,
+		},
+		{
+			Name:    "Email",
+			Message: `Your login email was changed. Code: 123456`,
+			Type:    telegramservicemessage.TypeEmailVerification,
+		},
+		{
+			Name:    "Frozen",
+			Message: `Your account was frozen for violating Telegram's Terms of Service.`,
+			Type:    telegramservicemessage.TypeFrozen,
+		},
+		{
+			Name:    "FrozenEmoji",
+			Message: "❄️ Your account has been frozen.\n\nYou can submit an appeal.",
+			Type:    telegramservicemessage.TypeFrozen,
+		},
+		{
+			Name:    "Limited",
+			Message: "Dear Foo,\nyour account is limited: you can only send messages to mutual contacts.",
+			Type:    telegramservicemessage.TypeFrozen,
+		},
+		{
+			Name:    "LimitedTimeOffer",
+			Message: `Limited-time offer: get Telegram Premium with 40% discount!`,
+			Type:    telegramservicemessage.TypeOther,
+		},
+		{
+			Name:    "RateLimits",
+			Message: `Messages sent by bots are limited to 30 per second, see rate limits.`,
+			Type:    telegramservicemessage.TypeOther,
+		},
+		{
+			Name:    "SuspendedMention",
+			Message: `Accounts which violate Terms of Service may be suspended or frozen.`,
+			Type:    telegramservicemessage.TypeOther,
+		},
+		{
+			Name:    "FutureTense",
+			Message: `Your account will be frozen if you continue sending spam.`,
+			Type:    telegramservicemessage.TypeOther,
+		},
+		{
+			Name:    "LimitedTime",
+			Message: `Your account is limited-time trial of Telegram Premium.`,
+			Type:    telegramservicemessage.TypeOther,
+		},
+	} {
+		t.Run(tt.Name, func(t *testing.T) {
+			typ, code := parseServiceMessage(tt.Message)
+			require.Equal(t, tt.Type, typ)
+			require.Equal(t, tt.Code, code)
+		})
+	}
+}
+
+func TestAccount_onServiceMessageSaveError(t *testing.T) {
+	a := require.New(t)
+	ctx := context.Background()
+	db := newTestDB(t)
+	const phone = "71234567890"
+	account := createTestAccount(t, db, phone)
+
+	db.TelegramServiceMessage.Use(func(next ent.Mutator) ent.Mutator {
+		return hook.TelegramServiceMessageFunc(func(ctx context.Context, m *ent.TelegramServiceMessageMutation) (ent.Value, error) {
+			return nil, errors.New("save failed")
+		})
+	})
+
+	acc := NewAccount(zaptest.NewLogger(t), db, tracenoop.NewTracerProvider().Tracer(""), nil, NewNotifier(), account)
+	a.NoError(acc.onServiceMessage(ctx, &tg.Message{
+		ID:      1,
+		Date:    int(time.Now().Unix()),
+		Message: "Login code: 70021. Do not give this code to anyone!",
+	}))
+
+	got, err := db.TelegramAccount.Get(ctx, phone)
+	a.NoError(err)
+	a.NotNil(got.Code)
+	a.Equal("70021", *got.Code)
+}
+
+func Test_generateUserInfo(t *testing.T) {
+	a := require.New(t)
+
+	info := generateUserInfo("71234567890")
+	a.NotEmpty(info.FirstName)
+	a.NotEmpty(info.LastName)
+	a.Equal(info, generateUserInfo("71234567890"))
+}
@@ -0,0 +1,246 @@
+package tgmanager
+
+import (
+	"context"
+
+	"github.com/go-faster/errors"
+
+	"github.com/gotd/bot/internal/ent"
+	"github.com/gotd/bot/internal/ent/telegramaccount"
+)
+
+// AccountInfo is account with its runtime state.
+type AccountInfo struct {
+	*ent.TelegramAccount
+
+	// Running is true if account runner is started.
+	Running bool
+	// Healthy is true if account is authorized and can be leased.
+	Healthy bool
+	// Leased is true if account is leased.
+	Leased bool
+	// Health is result of account health probes.
+	Health Health
+}
+
+func (m *Manager) info(acc *ent.TelegramAccount) AccountInfo {
+	m.mux.Lock()
+	defer m.mux.Unlock()
+
+	info := AccountInfo{
+		TelegramAccount: acc,
+	}
+	if r, ok := m.runners[acc.ID]; ok {
+		info.Running = true
+		info.Healthy = r.account.Healthy()
+		info.Health = r.account.Health()
+	}
+	_, info.Leased = m.leases[acc.ID]
+	return info
+}
+
+// Accounts returns all accounts.
+func (m *Manager) Accounts(ctx context.Context) ([]AccountInfo, error) {
+	accounts, err := m.db.TelegramAccount.Query().
+		Order(telegramaccount.ByID()).
+		All(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "query accounts")
+	}
+
+	r := make([]AccountInfo, 0, len(accounts))
+	for _, acc := range accounts {
+		r = append(r, m.info(acc))
+	}
+	return r, nil
+}
+
+// AccountStats is count of running accounts by lease state.
+type AccountStats struct {
+	// Running is count of started account runners.
+	Running int
+	// Leased is count of leased accounts.
+	Leased int
+	// Free is count of healthy accounts which can be leased.
+	Free int
+	// Unhealthy is count of accounts which are not leased and not healthy.
+	Unhealthy int
+}
+
+// Stats returns count of accounts by lease state.
+func (m *Manager) Stats() AccountStats {
+	m.mux.Lock()
+	defer m.mux.Unlock()
+
+	var r AccountStats
+	for phone, runner := range m.runners {
+		r.Running++
+		switch _, leased := m.leases[phone]; {
+		case leased:
+			r.Leased++
+		case runner.account.Healthy():
+			r.Free++
+		default:
+			r.Unhealthy++
+		}
+	}
+	return r
+}
+
+func (m *Manager) sealPassword(password string) ([]byte, error) {
+	if m.secret == nil {
+		return nil, errors.New("secret key is not configured")
+	}
+	data, err := m.secret.Seal([]byte(password))
+	if err != nil {
+		return nil, errors.Wrap(err, "encrypt password")
+	}
+	return data, nil
+}
+
+// AccountOptions is connection options of new account.
+type AccountOptions struct {
+	// DCList to connect to, test DCs are used if empty.
+	DCList telegramaccount.DcList
+	// DC to connect to first, e.g. home DC of account.
+	DC int
+	// AppID and AppHash are credentials of Telegram app.
+	//
+	// Telegram Desktop is used if not set.
+	AppID   int
+	AppHash string
+}
+
+// AddAccount adds new account.
+//
+// Password is optional.
+func (m *Manager) AddAccount(ctx context.Context, phone, password string, opts AccountOptions) (AccountInfo, error) {
+	if (opts.AppID == 0) != (opts.AppHash == "") {
+		return AccountInfo{}, errors.New("app_id and app_hash should be set together")
+	}
+	create := m.db.TelegramAccount.Create().
+		SetID(phone).
+		SetStatus("Added")
+	if opts.DCList != "" {
+		create.SetDcList(opts.DCList)
+	}
+	if opts.DC != 0 {
+		create.SetDc(opts.DC)
+	}
+	if opts.AppID != 0 {
+		create.SetAppID(opts.AppID).SetAppHash(opts.AppHash)
+	}
+	if password != "" {
+		data, err := m.sealPassword(password)
+		if err != nil {
+			return AccountInfo{}, err
+		}
+		create.SetPassword(data)
+	}
+
+	acc, err := create.Save(ctx)
+	if err != nil {
+		return AccountInfo{}, errors.Wrap(err, "create account")
+	}
+	return m.info(acc), nil
+}
+
+// SetPassword sets cloud password of account.
+//
+// Empty password removes it.
+func (m *Manager) SetPassword(ctx context.Context, phone, password string) error {
+	if password == "" {
+		if err := m.db.TelegramAccount.UpdateOneID(phone).
+			ClearPassword().
+			Exec(ctx); err != nil {
+			return errors.Wrap(err, "clear password")
+		}
+		return nil
+	}
+	data, err := m.sealPassword(password)
+	if err != nil {
+		return err
+	}
+	if err := m.db.TelegramAccount.UpdateOneID(phone).
+		SetPassword(data).
+		Exec(ctx); err != nil {
+		return errors.Wrap(err, "set password")
+	}
+	return nil
+}
+
+// SetDisabled disables or enables account.
+//
+// Runner of disabled account is stopped.
+func (m *Manager) SetDisabled(ctx context.Context, phone string, disabled bool) (AccountInfo, error) {
+	status := "Enabled"
+	if disabled {
+		status = "Disabled"
+	}
+	acc, err := m.db.TelegramAccount.UpdateOneID(phone).
+		SetDisabled(disabled).
+		SetStatus(status).
+		Save(ctx)
+	if err != nil {
+		return AccountInfo{}, errors.Wrap(err, "update account")
+	}
+	if disabled {
+		m.mux.Lock()
+		m.stop(phone)
+		m.mux.Unlock()
+	}
+	return m.info(acc), nil
+}
+
+// Relogin drops account session and restarts its runner,
+// so account will log in again.
+//
+// Runner is stopped before session is dropped, otherwise it can store
+// old session back.
+func (m *Manager) Relogin(ctx context.Context, phone string) (AccountInfo, error) {
+	m.mux.Lock()
+	m.relogins[phone] = struct{}{}
+	r, running := m.runners[phone]
+	m.stop(phone)
+	m.mux.Unlock()
+	defer func() {
+		m.mux.Lock()
+		delete(m.relogins, phone)
+		m.mux.Unlock()
+	}()
+
+	if running {
+		select {
+		case <-ctx.Done():
+			return AccountInfo{}, ctx.Err()
+		case <-r.done:
+		}
+	}
+
+	acc, err := m.db.TelegramAccount.UpdateOneID(phone).
+		ClearSessionData().
+		ClearCode().
+		ClearCodeAt().
+		SetState(telegramaccount.StateNew).
+		SetStatus("Relogin requested").
+		Save(ctx)
+	if err != nil {
+		return AccountInfo{}, errors.Wrap(err, "update account")
+	}
+
+	return m.info(acc), nil
+}
+
+// DeleteAccount deletes account and stops its runner.
+func (m *Manager) DeleteAccount(ctx context.Context, phone string) error {
+	if err := m.db.TelegramAccount.DeleteOneID(phone).Exec(ctx); err != nil {
+		return errors.Wrap(err, "delete account")
+	}
+
+	m.mux.Lock()
+	m.stop(phone)
+	delete(m.lastLeased, phone)
+	m.mux.Unlock()
+
+	return nil
+}
@@ -0,0 +1,58 @@
+package tgmanager
+
+import (
+	"github.com/gotd/td/telegram"
+	"github.com/gotd/td/telegram/dcs"
+
+	"github.com/gotd/bot/internal/ent"
+	"github.com/gotd/bot/internal/ent/telegramaccount"
+)
+
+// Telegram Desktop app credentials, used by accounts without own app.
+//
+// https://github.com/telegramdesktop/tdesktop/blob/dev/docs/api_credentials.md
+const (
+	defaultAppID   = 17349
+	defaultAppHash = "344583e45741c457fe1862106095a5eb"
+)
+
+// accountConfig is connection config of account.
+type accountConfig struct {
+	appID   int
+	appHash string
+	dcList  telegramaccount.DcList
+	dc      int
+}
+
+func newAccountConfig(acc *ent.TelegramAccount) accountConfig {
+	cfg := accountConfig{
+		appID:   defaultAppID,
+		appHash: defaultAppHash,
+		dcList:  acc.DcList,
+	}
+	if acc.AppID != nil && acc.AppHash != nil {
+		cfg.appID = *acc.AppID
+		cfg.appHash = *acc.AppHash
+	}
+	if acc.Dc != nil {
+		cfg.dc = *acc.Dc
+	}
+	return cfg
+}
+
+// production reports whether account uses production DCs.
+func (c accountConfig) production() bool {
+	return c.dcList == telegramaccount.DcListProduction
+}
+
+// newClient creates new client with given options, filling connection ones.
+func (c accountConfig) newClient(opts telegram.Options) *telegram.Client {
+	opts.DCList = dcs.Test()
+	if c.production() {
+		opts.DCList = dcs.Prod()
+	}
+	if c.dc != 0 {
+		opts.DC = c.dc
+	}
+	return telegram.NewClient(c.appID, c.appHash, opts)
+}
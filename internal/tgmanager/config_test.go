@@ -0,0 +1,36 @@
+package tgmanager
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/gotd/bot/internal/ent"
+	"github.com/gotd/bot/internal/ent/telegramaccount"
+)
+
+func Test_newAccountConfig(t *testing.T) {
+	a := require.New(t)
+
+	cfg := newAccountConfig(&ent.TelegramAccount{DcList: telegramaccount.DcListTest})
+	a.Equal(defaultAppID, cfg.appID)
+	a.Equal(defaultAppHash, cfg.appHash)
+	a.Zero(cfg.dc)
+	a.False(cfg.production())
+
+	var (
+		dc      = 4
+		appID   = 10
+		appHash = "hash"
+	)
+	cfg = newAccountConfig(&ent.TelegramAccount{
+		DcList:  telegramaccount.DcListProduction,
+		Dc:      &dc,
+		AppID:   &appID,
+		AppHash: &appHash,
+	})
+	a.Equal(appID, cfg.appID)
+	a.Equal(appHash, cfg.appHash)
+	a.Equal(dc, cfg.dc)
+	a.True(cfg.production())
+}
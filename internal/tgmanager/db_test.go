@@ -0,0 +1,38 @@
+package tgmanager
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/stretchr/testify/require"
+
+	"github.com/gotd/bot/internal/ent"
+	"github.com/gotd/bot/internal/ent/enttest"
+)
+
+// newTestDB creates in-memory database with migrated schema.
+func newTestDB(t *testing.T) *ent.Client {
+	t.Helper()
+
+	dsn := fmt.Sprintf("file:%s?mode=memory&cache=shared&_fk=1", t.Name())
+	db := enttest.Open(t, "sqlite3", dsn)
+	t.Cleanup(func() {
+		_ = db.Close()
+	})
+	return db
+}
+
+// createTestAccount creates account with session.
+func createTestAccount(t *testing.T, db *ent.Client, phone string) *ent.TelegramAccount {
+	t.Helper()
+
+	acc, err := db.TelegramAccount.Create().
+		SetID(phone).
+		SetStatus("Active").
+		SetSessionData([]byte("session")).
+		Save(context.Background())
+	require.NoError(t, err)
+	return acc
+}
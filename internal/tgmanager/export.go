@@ -0,0 +1,114 @@
+package tgmanager
+
+import (
+	"context"
+
+	"github.com/go-faster/errors"
+	"github.com/gotd/td/session"
+	"github.com/gotd/td/telegram"
+	"github.com/gotd/td/telegram/auth/qrlogin"
+	"go.uber.org/zap"
+)
+
+// ExportedSession is pre-authorized session of account.
+//
+// Each exported session is separate authorization of account, so it can be
+// revoked without logging out the account itself.
+type ExportedSession struct {
+	DC        int
+	Addr      string
+	AuthKey   []byte
+	AuthKeyID []byte
+	Salt      int64
+
+	config accountConfig
+}
+
+// data returns session in gotd session storage format.
+func (s *ExportedSession) data(ctx context.Context) (*session.StorageMemory, error) {
+	storage := new(session.StorageMemory)
+	if err := (&session.Loader{Storage: storage}).Save(ctx, &session.Data{
+		DC:        s.DC,
+		Addr:      s.Addr,
+		AuthKey:   s.AuthKey,
+		AuthKeyID: s.AuthKeyID,
+		Salt:      s.Salt,
+	}); err != nil {
+		return nil, errors.Wrap(err, "save session")
+	}
+	return storage, nil
+}
+
+// ExportSession creates new authorization of account and returns its session.
+//
+// New client is logged in with login token accepted by account, so no login
+// code is required. Session should be revoked with revokeSession.
+func (a *Account) ExportSession(ctx context.Context) (_ *ExportedSession, rerr error) {
+	ctx, span := a.tracer.Start(ctx, "ExportSession")
+	defer func() {
+		if rerr != nil {
+			span.RecordError(rerr)
+		}
+		span.End()
+	}()
+
+	storage := new(session.StorageMemory)
+	client := a.config.newClient(telegram.Options{
+		Logger:         a.lg.Named("export"),
+		SessionStorage: storage,
+		NoUpdates:      true,
+	})
+	if err := client.Run(ctx, func(ctx context.Context) error {
+		qr := client.QR()
+		token, err := qr.Export(ctx)
+		if err != nil {
+			return errors.Wrap(err, "export token")
+		}
+		authorization, err := qrlogin.AcceptQR(ctx, a.client.API(), token)
+		if err != nil {
+			return errors.Wrap(err, "accept token")
+		}
+		if _, err := qr.Import(ctx); err != nil {
+			// Do not leave dangling authorization.
+			if _, resetErr := a.client.API().AccountResetAuthorization(ctx, authorization.Hash); resetErr != nil {
+				a.lg.Warn("Failed to reset authorization", zap.Error(resetErr))
+			}
+			return errors.Wrap(err, "import token")
+		}
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	data, err := (&session.Loader{Storage: storage}).Load(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "load session")
+	}
+	return &ExportedSession{
+		DC:        data.DC,
+		Addr:      data.Addr,
+		AuthKey:   data.AuthKey,
+		AuthKeyID: data.AuthKeyID,
+		Salt:      data.Salt,
+		config:    a.config,
+	}, nil
+}
+
+// revokeSession logs out exported session.
+func revokeSession(ctx context.Context, lg *zap.Logger, s *ExportedSession) error {
+	storage, err := s.data(ctx)
+	if err != nil {
+		return err
+	}
+	client := s.config.newClient(telegram.Options{
+		Logger:         lg.Named("revoke"),
+		SessionStorage: storage,
+		NoUpdates:      true,
+	})
+	return client.Run(ctx, func(ctx context.Context) error {
+		if _, err := client.API().AuthLogOut(ctx); err != nil {
+			return errors.Wrap(err, "log out")
+		}
+		return nil
+	})
+}
@@ -0,0 +1,33 @@
+package tgmanager
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/gotd/td/session"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExportedSession_data(t *testing.T) {
+	a := require.New(t)
+	ctx := context.Background()
+
+	s := &ExportedSession{
+		DC:        2,
+		Addr:      "149.154.167.40:443",
+		AuthKey:   bytes.Repeat([]byte{1}, 256),
+		AuthKeyID: bytes.Repeat([]byte{2}, 8),
+		Salt:      10,
+	}
+	storage, err := s.data(ctx)
+	a.NoError(err)
+
+	data, err := (&session.Loader{Storage: storage}).Load(ctx)
+	a.NoError(err)
+	a.Equal(s.DC, data.DC)
+	a.Equal(s.Addr, data.Addr)
+	a.Equal(s.AuthKey, data.AuthKey)
+	a.Equal(s.AuthKeyID, data.AuthKeyID)
+	a.Equal(s.Salt, data.Salt)
+}
@@ -0,0 +1,116 @@
+package tgmanager
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-faster/errors"
+	"github.com/gotd/td/telegram/auth"
+	"github.com/gotd/td/tg"
+	"github.com/gotd/td/tgerr"
+	"go.uber.org/zap"
+
+	"github.com/gotd/bot/internal/ent/telegramaccount"
+)
+
+const (
+	// probeInterval is interval between account health probes.
+	probeInterval = time.Second * 30
+	// probeTimeout is timeout of single probe.
+	probeTimeout = time.Second * 10
+)
+
+// errAccountDeactivated means that account is deactivated by Telegram
+// and should not be restarted.
+var errAccountDeactivated = errors.New("account deactivated")
+
+// Health is result of account health probes.
+type Health struct {
+	// LastProbe is time of last probe.
+	LastProbe time.Time
+	// LastSuccess is time of last successful probe.
+	LastSuccess time.Time
+	// Latency of last successful probe.
+	Latency time.Duration
+	// Err is error of last probe, if any.
+	Err error
+}
+
+// Health returns result of account health probes.
+func (a *Account) Health() Health {
+	a.healthMux.Lock()
+	defer a.healthMux.Unlock()
+	return a.health
+}
+
+// probe checks that account session is authorized using lightweight RPC.
+func (a *Account) probe(ctx context.Context) error {
+	ctx, cancel := context.WithTimeout(ctx, probeTimeout)
+	defer cancel()
+
+	start := time.Now()
+	_, err := a.client.API().UsersGetUsers(ctx, []tg.InputUserClass{&tg.InputUserSelf{}})
+	now := time.Now()
+
+	a.healthMux.Lock()
+	a.health.LastProbe = now
+	a.health.Err = err
+	if err == nil {
+		a.health.LastSuccess = now
+		a.health.Latency = now.Sub(start)
+	}
+	a.healthMux.Unlock()
+	a.ready.Store(err == nil)
+
+	return err
+}
+
+// handleProbeError returns non-nil error if account can't continue
+// running after failed probe.
+func (a *Account) handleProbeError(ctx context.Context, probeErr error) error {
+	switch {
+	case tgerr.Is(probeErr, "USER_DEACTIVATED", "USER_DEACTIVATED_BAN"):
+		// Account is deleted or banned, there is no point in retrying.
+		a.lg.Error("Account deactivated", zap.Error(probeErr))
+		if err := a.db.TelegramAccount.UpdateOneID(a.number).
+			SetDisabled(true).
+			SetState(telegramaccount.StateError).
+			SetStatus("Deactivated by Telegram: " + probeErr.Error()).
+			Exec(ctx); err != nil {
+			return errors.Wrap(err, "update account")
+		}
+		return errors.Wrap(errAccountDeactivated, probeErr.Error())
+	case auth.IsUnauthorized(probeErr):
+		// Session is revoked, drop it to log in again on restart.
+		a.lg.Warn("Session is no longer authorized", zap.Error(probeErr))
+		if err := a.db.TelegramAccount.UpdateOneID(a.number).
+			ClearSessionData().
+			Exec(ctx); err != nil {
+			return errors.Wrap(err, "clear session")
+		}
+		return errors.Wrap(probeErr, "unauthorized")
+	default:
+		a.lg.Warn("Probe failed", zap.Error(probeErr))
+		return nil
+	}
+}
+
+// runProbes probes account health until ctx is done or account becomes
+// unusable.
+func (a *Account) runProbes(ctx context.Context) error {
+	ticker := time.NewTicker(probeInterval)
+	defer ticker.Stop()
+
+	for {
+		if err := a.probe(ctx); err != nil && ctx.Err() == nil {
+			if err := a.handleProbeError(ctx, err); err != nil {
+				return err
+			}
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
@@ -0,0 +1,89 @@
+package tgmanager
+
+import (
+	"context"
+	"testing"
+
+	"github.com/go-faster/errors"
+	"github.com/gotd/td/tgerr"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+
+	"github.com/gotd/bot/internal/ent/telegramaccount"
+)
+
+func TestAccount_handleProbeError(t *testing.T) {
+	const phone = "71234567890"
+	for _, tt := range []struct {
+		Name string
+		Err  error
+		// Deactivated means that account is disabled and not restarted.
+		Deactivated bool
+		// Unauthorized means that session is dropped and account is restarted
+		// to log in again.
+		Unauthorized bool
+	}{
+		{
+			Name:        "Deactivated",
+			Err:         tgerr.New(401, "USER_DEACTIVATED"),
+			Deactivated: true,
+		},
+		{
+			Name:        "Banned",
+			Err:         tgerr.New(401, "USER_DEACTIVATED_BAN"),
+			Deactivated: true,
+		},
+		{
+			Name:         "SessionRevoked",
+			Err:          tgerr.New(401, "SESSION_REVOKED"),
+			Unauthorized: true,
+		},
+		{
+			Name:         "AuthKeyUnregistered",
+			Err:          tgerr.New(401, "AUTH_KEY_UNREGISTERED"),
+			Unauthorized: true,
+		},
+		{
+			Name: "FloodWait",
+			Err:  tgerr.New(420, "FLOOD_WAIT_10"),
+		},
+		{
+			Name: "Network",
+			Err:  errors.New("connection reset"),
+		},
+	} {
+		t.Run(tt.Name, func(t *testing.T) {
+			a := require.New(t)
+			ctx := context.Background()
+			db := newTestDB(t)
+			createTestAccount(t, db, phone)
+
+			acc := &Account{
+				number: phone,
+				db:     db,
+				lg:     zaptest.NewLogger(t),
+			}
+			err := acc.handleProbeError(ctx, tt.Err)
+
+			got, dbErr := db.TelegramAccount.Get(ctx, phone)
+			a.NoError(dbErr)
+			switch {
+			case tt.Deactivated:
+				a.ErrorIs(err, errAccountDeactivated)
+				a.True(got.Disabled)
+				a.Equal(telegramaccount.StateError, got.State)
+			case tt.Unauthorized:
+				// Run is stopped, so account is not leased until it logs in again.
+				a.Error(err)
+				a.NotErrorIs(err, errAccountDeactivated)
+				a.False(got.Disabled)
+				a.True(got.SessionData == nil || len(*got.SessionData) == 0, "session should be cleared")
+			default:
+				a.NoError(err)
+				a.False(got.Disabled)
+				a.NotNil(got.SessionData)
+				a.Equal([]byte("session"), *got.SessionData)
+			}
+		})
+	}
+}
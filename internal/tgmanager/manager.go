@@ -2,16 +2,22 @@ package tgmanager
 
 import (
 	"context"
+	"crypto/subtle"
 	"sync"
 	"time"
 
 	"github.com/go-faster/errors"
 	"github.com/google/uuid"
+	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/metric"
 	"go.opentelemetry.io/otel/trace"
 	"go.uber.org/zap"
+	"golang.org/x/sync/errgroup"
+	"golang.org/x/time/rate"
 
 	"github.com/gotd/bot/internal/ent"
+	"github.com/gotd/bot/internal/ent/telegramaccount"
+	"github.com/gotd/bot/internal/secret"
 )
 
 // Manager manages telegram test accounts.
@@ -20,37 +26,111 @@ type Manager struct {
 	db     *ent.Client
 	meter  metric.Meter
 	tracer trace.Tracer
-
-	accounts map[string]*Account
-	leases   map[string]*Lease
+	secret *secret.Box
+
+	notifier *Notifier
+	relay    *PostgresRelay
+	runs     WorkflowRuns
+
+	runners map[string]*runner   // by phone
+	leases  map[string]*Lease    // by phone
+	tokens  map[uuid.UUID]*Lease // by token
+	// lastLeased is time of last lease, used to prefer least recently
+	// used accounts. Kept across runner restarts.
+	lastLeased map[string]time.Time // by phone
+	// relogins are accounts which session is being dropped, runners of
+	// such accounts are not started.
+	relogins map[string]struct{} // by phone
 	mux      sync.Mutex
 }
 
-var ErrNoLease = errors.New("no accounts available")
+var (
+	ErrNoLease      = errors.New("no accounts available")
+	ErrAllLeased    = errors.New("all accounts are leased")
+	ErrUnknownToken = errors.New("unknown lease token")
+	ErrLeaseHolder  = errors.New("lease is held by another client")
+	ErrRateLimited  = errors.New("rate limited")
+)
+
+// lease returns lease by token, checking that it belongs to holder.
+//
+// Must be called with m.mux held.
+func (m *Manager) lease(token uuid.UUID, holder string) (*Lease, error) {
+	lease, ok := m.tokens[token]
+	if !ok {
+		return nil, ErrUnknownToken
+	}
+	if subtle.ConstantTimeCompare([]byte(lease.Holder), []byte(holder)) != 1 {
+		return nil, ErrLeaseHolder
+	}
+	return lease, nil
+}
+
+// removeLease removes lease from all indexes.
+//
+// Must be called with m.mux held.
+func (m *Manager) removeLease(lease *Lease) {
+	delete(m.leases, lease.Account)
+	delete(m.tokens, lease.Token)
+	if lease.Session != nil {
+		go m.revokeSession(lease.Account, lease.Session)
+	}
+}
+
+// revokeSession revokes exported session of ended lease.
+func (m *Manager) revokeSession(phone string, s *ExportedSession) {
+	ctx, cancel := context.WithTimeout(context.Background(), revokeTimeout)
+	defer cancel()
+
+	lg := m.log.With(zap.String("phone", phone))
+	if err := revokeSession(ctx, lg, s); err != nil {
+		lg.Error("Failed to revoke session", zap.Error(err))
+		return
+	}
+	lg.Info("Session revoked")
+}
 
 // LeaseCode returns account code for lease.
-// If account is not leased, returns ErrNoLease.
-// If code is not received yet, returns empty string.
-func (m *Manager) LeaseCode(ctx context.Context, token uuid.UUID) (string, error) {
+// If lease is unknown, returns ErrUnknownToken.
+// If code is not received during wait, returns empty string.
+func (m *Manager) LeaseCode(ctx context.Context, token uuid.UUID, holder string, wait time.Duration) (string, error) {
 	ctx, span := m.tracer.Start(ctx, "LeaseCode")
 	defer func() {
 		span.End()
 	}()
 
 	m.mux.Lock()
-	var lease *Lease
-	for _, l := range m.leases {
-		if l.Token != token {
-			continue
-		}
-		lease = l
-	}
+	lease, err := m.lease(token, holder)
 	m.mux.Unlock()
+	if err != nil {
+		return "", err
+	}
+	if !lease.limiter.Allow() {
+		return "", ErrRateLimited
+	}
+
+	// Subscribe before first check to not miss the code.
+	updates, unsubscribe := m.notifier.Subscribe(lease.Account)
+	defer unsubscribe()
 
-	if lease == nil {
-		return "", errors.Wrap(ErrNoLease, "no account with token")
+	timer := time.NewTimer(wait)
+	defer timer.Stop()
+	for {
+		code, err := m.leaseCode(ctx, lease)
+		if err != nil || code != "" {
+			return code, err
+		}
+		select {
+		case <-ctx.Done():
+			return "", ctx.Err()
+		case <-timer.C:
+			return "", nil
+		case <-updates:
+		}
 	}
+}
 
+func (m *Manager) leaseCode(ctx context.Context, lease *Lease) (string, error) {
 	acc, err := m.db.TelegramAccount.Get(ctx, lease.Account)
 	if err != nil {
 		return "", errors.Wrap(err, "get account")
@@ -67,70 +147,142 @@ func (m *Manager) LeaseCode(ctx context.Context, token uuid.UUID) (string, error
 }
 
 // Heartbeat updates lease expiration time.
-func (m *Manager) Heartbeat(token uuid.UUID) error {
+func (m *Manager) Heartbeat(token uuid.UUID, holder string) error {
 	m.mux.Lock()
 	defer m.mux.Unlock()
 
-	for _, lease := range m.leases {
-		if lease.Token != token {
-			continue
-		}
-		lease.Until = time.Now().Add(time.Second * 15)
-		return nil
+	lease, err := m.lease(token, holder)
+	if err != nil {
+		return err
 	}
-	return errors.Wrap(ErrNoLease, "no account with token")
+	lease.Until = time.Now().Add(leaseTTL)
+	return nil
 }
 
 // Forget lease.
-func (m *Manager) Forget(token uuid.UUID) {
+//
+// Forgetting unknown lease is not an error.
+func (m *Manager) Forget(token uuid.UUID, holder string) error {
 	m.mux.Lock()
 	defer m.mux.Unlock()
 
-	var toForget string
-	for phone, lease := range m.leases {
-		if lease.Token == token {
-			toForget = phone
-			break
-		}
-	}
-	if toForget == "" {
-		return
+	lease, err := m.lease(token, holder)
+	switch {
+	case errors.Is(err, ErrUnknownToken):
+		return nil
+	case err != nil:
+		return err
 	}
-	delete(m.leases, toForget)
+	m.removeLease(lease)
+	return nil
+}
+
+// AcquireOptions describes requested lease.
+type AcquireOptions struct {
+	// Holder identifies client that acquires the lease.
+	Holder string
+	// DCList of account.
+	DCList telegramaccount.DcList
+	// Run is GitHub workflow run that acquires the lease, optional.
+	//
+	// Lease is released when run is completed.
+	Run *WorkflowRun
 }
 
 // Acquire new lease.
-func (m *Manager) Acquire() (*Lease, error) {
+func (m *Manager) Acquire(opts AcquireOptions) (*Lease, error) {
 	m.mux.Lock()
 	defer m.mux.Unlock()
 
-	if len(m.accounts) == 0 {
-		return nil, errors.Wrap(ErrNoLease, "no accounts")
-	}
-
-	for phone := range m.accounts {
+	var (
+		total     int
+		unhealthy int
+		candidate *runner
+	)
+	for phone, r := range m.runners {
+		if r.account.config.dcList != opts.DCList {
+			continue
+		}
+		total++
 		if _, ok := m.leases[phone]; ok {
 			// Already leased.
 			continue
 		}
+		if !r.account.Healthy() {
+			unhealthy++
+			continue
+		}
+		if candidate == nil || m.lastLeased[phone].Before(m.lastLeased[candidate.account.number]) {
+			// Prefer least recently used account.
+			candidate = r
+		}
+	}
+	if candidate != nil {
+		now := time.Now()
 		lease := &Lease{
-			Account: phone,
+			Account: candidate.account.number,
 			Token:   uuid.New(),
-			Start:   time.Now(),
-			Until:   time.Now().Add(time.Second * 15),
+			Holder:  opts.Holder,
+			Run:     opts.Run,
+			Start:   now,
+			Until:   now.Add(leaseTTL),
+			limiter: rate.NewLimiter(codeRateLimit, codeRateBurst),
 		}
-		m.leases[phone] = lease
+		m.lastLeased[lease.Account] = now
+		m.leases[lease.Account] = lease
+		m.tokens[lease.Token] = lease
 		return lease, nil
 	}
 
-	return nil, errors.Wrap(ErrNoLease, "all accounts leased")
+	if total == 0 {
+		return nil, errors.Wrapf(ErrNoLease, "no %s accounts", opts.DCList)
+	}
+	if leased := total - unhealthy; leased > 0 {
+		return nil, errors.Wrapf(ErrAllLeased, "%d leased, %d unhealthy", leased, unhealthy)
+	}
+	return nil, errors.Wrapf(ErrNoLease, "all %d accounts unhealthy", unhealthy)
+}
+
+// AcquireSession acquires new lease for holder with pre-authorized session
+// of leased account.
+//
+// Session is revoked when lease ends.
+func (m *Manager) AcquireSession(ctx context.Context, opts AcquireOptions) (*Lease, error) {
+	lease, err := m.Acquire(opts)
+	if err != nil {
+		return nil, err
+	}
+
+	m.mux.Lock()
+	r, ok := m.runners[lease.Account]
+	m.mux.Unlock()
+	if !ok {
+		_ = m.Forget(lease.Token, opts.Holder)
+		return nil, errors.Wrap(ErrNoLease, "account stopped")
+	}
+
+	s, err := r.account.ExportSession(ctx)
+	if err != nil {
+		_ = m.Forget(lease.Token, opts.Holder)
+		return nil, errors.Wrap(err, "export session")
+	}
+
+	m.mux.Lock()
+	defer m.mux.Unlock()
+	if m.tokens[lease.Token] != lease {
+		// Lease ended during export.
+		go m.revokeSession(lease.Account, s)
+		return nil, errors.Wrap(ErrNoLease, "lease expired")
+	}
+	lease.Session = s
+	return lease, nil
 }
 
 func (m *Manager) tickLease(now time.Time) {
 	m.mux.Lock()
 	defer m.mux.Unlock()
 
-	var toDelete []string
+	var toDelete []*Lease
 	for phone, lease := range m.leases {
 		if lease.Until.After(now) {
 			continue
@@ -139,37 +291,65 @@ func (m *Manager) tickLease(now time.Time) {
 			zap.String("phone", phone),
 			zap.Stringer("token", lease.Token),
 		)
-		toDelete = append(toDelete, phone)
+		toDelete = append(toDelete, lease)
 	}
 
-	for _, phone := range toDelete {
-		delete(m.leases, phone)
+	for _, lease := range toDelete {
+		m.removeLease(lease)
 	}
 	m.log.Info("Lease cleanup done",
 		zap.Int("deleted", len(toDelete)),
 		zap.Int("total", len(m.leases)),
-		zap.Int("accounts", len(m.accounts)),
+		zap.Int("accounts", len(m.runners)),
 	)
 }
 
+const (
+	// leaseTTL is duration of lease without heartbeat.
+	leaseTTL = time.Second * 15
+	// codeRateLimit and codeRateBurst limit code polling per lease.
+	codeRateLimit = rate.Limit(1)
+	codeRateBurst = 5
+	// revokeTimeout is timeout of exported session revocation.
+	revokeTimeout = time.Second * 30
+)
+
 // Lease for telegram account.
 type Lease struct {
 	Account string
 	Token   uuid.UUID
-	Start   time.Time
-	Until   time.Time
+	// Holder identifies client that acquired the lease.
+	Holder string
+	Start  time.Time
+	Until  time.Time
+	// Run is GitHub workflow run that holds the lease, if known.
+	Run *WorkflowRun
+	// Session is pre-authorized session of account, if requested.
+	Session *ExportedSession
+
+	limiter *rate.Limiter
 }
 
-func NewManager(log *zap.Logger, db *ent.Client, meterProvider metric.MeterProvider, tracerProvider trace.TracerProvider) (*Manager, error) {
+func NewManager(log *zap.Logger, db *ent.Client, meterProvider metric.MeterProvider, tracerProvider trace.TracerProvider, opts Options) (*Manager, error) {
 	meter := meterProvider.Meter("bot.gotd.dev/tgmanager")
 	tracer := tracerProvider.Tracer("bot.gotd.dev/tgmanager")
 	mgr := &Manager{
-		log:      log,
-		db:       db,
-		meter:    meter,
-		tracer:   tracer,
-		accounts: make(map[string]*Account),
-		leases:   make(map[string]*Lease),
+		log:        log,
+		db:         db,
+		meter:      meter,
+		tracer:     tracer,
+		secret:     opts.Secret,
+		runs:       opts.WorkflowRuns,
+		notifier:   NewNotifier(),
+		runners:    make(map[string]*runner),
+		leases:     make(map[string]*Lease),
+		tokens:     make(map[uuid.UUID]*Lease),
+		lastLeased: make(map[string]time.Time),
+		relogins:   make(map[string]struct{}),
+	}
+
+	if opts.Postgres != nil {
+		mgr.relay = NewPostgresRelay(log.Named("relay"), opts.Postgres, mgr.notifier)
 	}
 
 	accountsTotal, err := meter.Int64ObservableGauge("accounts.total")
@@ -184,21 +364,51 @@ func NewManager(log *zap.Logger, db *ent.Client, meterProvider metric.MeterProvi
 	if err != nil {
 		return nil, errors.Wrap(err, "create observable gauge")
 	}
+	accountsHealthy, err := meter.Int64ObservableGauge("accounts.healthy")
+	if err != nil {
+		return nil, errors.Wrap(err, "create observable gauge")
+	}
+	probeLatency, err := meter.Float64ObservableGauge("accounts.probe.latency",
+		metric.WithUnit("s"),
+		metric.WithDescription("Latency of last successful account health probe"),
+	)
+	if err != nil {
+		return nil, errors.Wrap(err, "create observable gauge")
+	}
 
-	if _, err := meter.RegisterCallback(func(ctx context.Context, observer metric.Observer) error {
+	observe := func(ctx context.Context, observer metric.Observer) error {
 		mgr.mux.Lock()
 		defer mgr.mux.Unlock()
 
-		total := int64(len(mgr.accounts))
+		total := int64(len(mgr.runners))
 		leased := int64(len(mgr.leases))
 		free := total - leased
+		var healthy int64
+		for phone, r := range mgr.runners {
+			if r.account.Healthy() {
+				healthy++
+			}
+			if h := r.account.Health(); !h.LastSuccess.IsZero() {
+				observer.ObserveFloat64(probeLatency, h.Latency.Seconds(),
+					metric.WithAttributes(attribute.String("account", phone)),
+				)
+			}
+		}
 
 		observer.ObserveInt64(accountsTotal, total)
 		observer.ObserveInt64(accountsLeased, leased)
 		observer.ObserveInt64(accountsFree, free)
+		observer.ObserveInt64(accountsHealthy, healthy)
 
 		return nil
-	}); err != nil {
+	}
+	if _, err := meter.RegisterCallback(observe,
+		accountsTotal,
+		accountsLeased,
+		accountsFree,
+		accountsHealthy,
+		probeLatency,
+	); err != nil {
 		return nil, errors.Wrap(err, "register callback")
 	}
 
@@ -220,37 +430,59 @@ func (m *Manager) tick(baseCtx context.Context) (rerr error) {
 	now := time.Now()
 	m.tickLease(now)
 
-	accounts, err := m.db.TelegramAccount.Query().All(ctx)
+	accounts, err := m.db.TelegramAccount.Query().
+		Where(telegramaccount.Disabled(false)).
+		All(ctx)
 	if err != nil {
 		return errors.Wrap(err, "query accounts")
 	}
+	enabled := make(map[string]struct{}, len(accounts))
+	for _, account := range accounts {
+		enabled[account.ID] = struct{}{}
+	}
+
+	m.mux.Lock()
+	defer m.mux.Unlock()
+
+	for phone := range m.runners {
+		if _, ok := enabled[phone]; ok {
+			continue
+		}
+		m.log.Info("Account removed or disabled", zap.String("phone", phone))
+		m.stop(phone)
+	}
 	for _, account := range accounts {
-		if _, ok := m.accounts[account.ID]; ok {
+		if _, ok := m.runners[account.ID]; ok {
+			continue
+		}
+		if _, ok := m.relogins[account.ID]; ok {
 			continue
 		}
 		lg := m.log.With(zap.String("phone", account.ID))
-		a := NewAccount(lg, m.db, m.tracer, account.ID)
-
-		m.mux.Lock()
-		m.accounts[account.ID] = a
-		m.mux.Unlock()
-
-		go func() {
-			lg.Info("Starting account runner")
-			defer func() {
-				m.mux.Lock()
-				delete(m.accounts, account.ID)
-				m.mux.Unlock()
-			}()
-			if err := a.Run(baseCtx); err != nil {
-				lg.Error("Account run failed", zap.Error(err))
-			}
-		}()
+		m.start(baseCtx, NewAccount(lg, m.db, m.tracer, m.secret, m.notifier, account))
 	}
 	return nil
 }
 
 func (m *Manager) Run(ctx context.Context) error {
+	g, ctx := errgroup.WithContext(ctx)
+	if m.relay != nil {
+		g.Go(func() error {
+			return m.relay.Run(ctx)
+		})
+	}
+	if m.runs != nil {
+		g.Go(func() error {
+			return m.watchRuns(ctx)
+		})
+	}
+	g.Go(func() error {
+		return m.run(ctx)
+	})
+	return g.Wait()
+}
+
+func (m *Manager) run(ctx context.Context) error {
 	ticker := time.NewTicker(time.Second)
 	defer ticker.Stop()
 	m.log.Info("Starting manager")
@@ -0,0 +1,317 @@
+package tgmanager
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/go-faster/errors"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/stretchr/testify/require"
+	metricnoop "go.opentelemetry.io/otel/metric/noop"
+	tracenoop "go.opentelemetry.io/otel/trace/noop"
+	"go.uber.org/zap/zaptest"
+
+	"github.com/gotd/bot/internal/ent/telegramaccount"
+)
+
+func newTestManager(t *testing.T, phones ...string) *Manager {
+	t.Helper()
+
+	m, err := NewManager(zaptest.NewLogger(t), nil, metricnoop.NewMeterProvider(), tracenoop.NewTracerProvider(), Options{})
+	require.NoError(t, err)
+	for _, phone := range phones {
+		a := &Account{
+			number: phone,
+			config: accountConfig{dcList: telegramaccount.DcListTest},
+		}
+		a.ready.Store(true)
+		m.runners[phone] = &runner{
+			account: a,
+			cancel:  func() {},
+		}
+	}
+	return m
+}
+
+func TestManager_Lease(t *testing.T) {
+	a := require.New(t)
+	m := newTestManager(t, "71234567890")
+
+	lease, err := m.Acquire(AcquireOptions{Holder: "holder", DCList: telegramaccount.DcListTest})
+	a.NoError(err)
+	a.Equal("71234567890", lease.Account)
+	a.Equal(lease, m.tokens[lease.Token])
+
+	_, err = m.Acquire(AcquireOptions{Holder: "holder", DCList: telegramaccount.DcListTest})
+	a.ErrorIs(err, ErrAllLeased)
+
+	a.NoError(m.Heartbeat(lease.Token, "holder"))
+	a.ErrorIs(m.Heartbeat(lease.Token, "other"), ErrLeaseHolder)
+	a.ErrorIs(m.Heartbeat(uuid.New(), "holder"), ErrUnknownToken)
+
+	a.ErrorIs(m.Forget(lease.Token, "other"), ErrLeaseHolder)
+	a.NoError(m.Forget(lease.Token, "holder"))
+	a.NoError(m.Forget(lease.Token, "holder"))
+	a.Empty(m.leases)
+	a.Empty(m.tokens)
+}
+
+func TestManager_AcquireUnhealthy(t *testing.T) {
+	a := require.New(t)
+	m := newTestManager(t, "71234567890", "71234567891")
+	m.runners["71234567890"].account.ready.Store(false)
+
+	lease, err := m.Acquire(AcquireOptions{Holder: "holder", DCList: telegramaccount.DcListTest})
+	a.NoError(err)
+	a.Equal("71234567891", lease.Account)
+
+	_, err = m.Acquire(AcquireOptions{Holder: "holder", DCList: telegramaccount.DcListTest})
+	a.ErrorIs(err, ErrAllLeased)
+
+	// No account can be leased soon.
+	a.NoError(m.Forget(lease.Token, "holder"))
+	m.runners["71234567891"].account.ready.Store(false)
+	_, err = m.Acquire(AcquireOptions{Holder: "holder", DCList: telegramaccount.DcListTest})
+	a.ErrorIs(err, ErrNoLease)
+}
+
+func TestManager_AcquireLeastRecentlyUsed(t *testing.T) {
+	a := require.New(t)
+	m := newTestManager(t, "71234567890", "71234567891")
+	m.lastLeased["71234567890"] = time.Now()
+
+	lease, err := m.Acquire(AcquireOptions{Holder: "holder", DCList: telegramaccount.DcListTest})
+	a.NoError(err)
+	a.Equal("71234567891", lease.Account)
+	a.NoError(m.Forget(lease.Token, "holder"))
+
+	lease, err = m.Acquire(AcquireOptions{Holder: "holder", DCList: telegramaccount.DcListTest})
+	a.NoError(err)
+	a.Equal("71234567890", lease.Account)
+}
+
+func TestManager_AcquireRestarted(t *testing.T) {
+	a := require.New(t)
+	m := newTestManager(t, "71234567890", "71234567891")
+
+	lease, err := m.Acquire(AcquireOptions{Holder: "holder", DCList: telegramaccount.DcListTest})
+	a.NoError(err)
+	a.NoError(m.Forget(lease.Token, "holder"))
+	leased := lease.Account
+
+	// Runner of leased account is restarted.
+	restarted := *m.runners[leased]
+	m.runners[leased] = &restarted
+
+	lease, err = m.Acquire(AcquireOptions{Holder: "holder", DCList: telegramaccount.DcListTest})
+	a.NoError(err)
+	a.NotEqual(leased, lease.Account, "restarted account should not be preferred")
+}
+
+func TestManager_tickLease(t *testing.T) {
+	a := require.New(t)
+	m := newTestManager(t, "71234567890")
+
+	lease, err := m.Acquire(AcquireOptions{Holder: "holder", DCList: telegramaccount.DcListTest})
+	a.NoError(err)
+
+	m.tickLease(time.Now())
+	a.NoError(m.Heartbeat(lease.Token, "holder"))
+
+	m.tickLease(time.Now().Add(leaseTTL * 2))
+	a.ErrorIs(m.Heartbeat(lease.Token, "holder"), ErrUnknownToken)
+	a.Empty(m.tokens)
+}
+
+func TestManager_stop(t *testing.T) {
+	a := require.New(t)
+	m := newTestManager(t, "71234567890")
+
+	lease, err := m.Acquire(AcquireOptions{Holder: "holder", DCList: telegramaccount.DcListTest})
+	a.NoError(err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	m.runners[lease.Account].cancel = cancel
+
+	m.mux.Lock()
+	m.stop(lease.Account)
+	m.mux.Unlock()
+
+	a.ErrorIs(ctx.Err(), context.Canceled)
+	a.ErrorIs(m.Heartbeat(lease.Token, "holder"), ErrUnknownToken)
+}
+
+func TestManager_AcquireDCList(t *testing.T) {
+	a := require.New(t)
+	m := newTestManager(t, "71234567890", "71234567891")
+	m.runners["71234567891"].account.config.dcList = telegramaccount.DcListProduction
+
+	lease, err := m.Acquire(AcquireOptions{Holder: "holder", DCList: telegramaccount.DcListProduction})
+	a.NoError(err)
+	a.Equal("71234567891", lease.Account)
+
+	lease, err = m.Acquire(AcquireOptions{Holder: "holder", DCList: telegramaccount.DcListTest})
+	a.NoError(err)
+	a.Equal("71234567890", lease.Account)
+
+	_, err = m.Acquire(AcquireOptions{Holder: "holder", DCList: telegramaccount.DcListTest})
+	a.ErrorIs(err, ErrAllLeased)
+}
+
+func TestManager_AcquireNoAccounts(t *testing.T) {
+	a := require.New(t)
+	m := newTestManager(t, "71234567890")
+
+	_, err := m.Acquire(AcquireOptions{Holder: "holder", DCList: telegramaccount.DcListProduction})
+	a.ErrorIs(err, ErrNoLease)
+}
+
+type runsFunc func(ctx context.Context, run WorkflowRun) (bool, error)
+
+func (f runsFunc) Completed(ctx context.Context, run WorkflowRun) (bool, error) {
+	return f(ctx, run)
+}
+
+func TestManager_tickRuns(t *testing.T) {
+	a := require.New(t)
+	m := newTestManager(t, "71234567890", "71234567891", "71234567892")
+
+	var (
+		running   = WorkflowRun{Owner: "gotd", Repo: "td", ID: 1}
+		completed = WorkflowRun{Owner: "gotd", Repo: "td", ID: 2}
+		failing   = WorkflowRun{Owner: "gotd", Repo: "td", ID: 3}
+	)
+	m.runs = runsFunc(func(ctx context.Context, run WorkflowRun) (bool, error) {
+		switch run {
+		case completed:
+			return true, nil
+		case failing:
+			return false, errors.New("failed")
+		default:
+			return false, nil
+		}
+	})
+
+	var leases []*Lease
+	for _, run := range []WorkflowRun{running, completed, failing} {
+		lease, err := m.Acquire(AcquireOptions{Holder: "holder", DCList: telegramaccount.DcListTest, Run: &run})
+		a.NoError(err)
+		leases = append(leases, lease)
+	}
+
+	m.tickRuns(context.Background())
+	a.NoError(m.Heartbeat(leases[0].Token, "holder"))
+	a.ErrorIs(m.Heartbeat(leases[1].Token, "holder"), ErrUnknownToken)
+	a.NoError(m.Heartbeat(leases[2].Token, "holder"))
+}
+
+func TestManager_Stats(t *testing.T) {
+	a := require.New(t)
+	m := newTestManager(t, "71234567890", "71234567891", "71234567892")
+	m.runners["71234567892"].account.ready.Store(false)
+
+	_, err := m.Acquire(AcquireOptions{Holder: "holder", DCList: telegramaccount.DcListTest})
+	a.NoError(err)
+
+	a.Equal(AccountStats{
+		Running:   3,
+		Leased:    1,
+		Free:      1,
+		Unhealthy: 1,
+	}, m.Stats())
+}
+
+func TestManager_LeaseCodePostgres(t *testing.T) {
+	const phone = "71234567890"
+	a := require.New(t)
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*10)
+	defer cancel()
+
+	db := newTestDB(t)
+	account := createTestAccount(t, db, phone)
+
+	// Pool connects lazily, so relay is created without database.
+	pool, err := pgxpool.New(ctx, "postgres://test@127.0.0.1:1/test?connect_timeout=1")
+	a.NoError(err)
+	defer pool.Close()
+
+	m, err := NewManager(zaptest.NewLogger(t), db, metricnoop.NewMeterProvider(), tracenoop.NewTracerProvider(), Options{
+		Postgres: pool,
+	})
+	a.NoError(err)
+	a.NotNil(m.relay)
+
+	acc := NewAccount(zaptest.NewLogger(t), db, tracenoop.NewTracerProvider().Tracer(""), nil, m.notifier, account)
+	acc.ready.Store(true)
+	m.runners[phone] = &runner{account: acc, cancel: func() {}}
+
+	lease, err := m.Acquire(AcquireOptions{Holder: "holder", DCList: telegramaccount.DcListTest})
+	a.NoError(err)
+
+	type result struct {
+		code string
+		err  error
+	}
+	done := make(chan result, 1)
+	go func() {
+		code, err := m.LeaseCode(ctx, lease.Token, "holder", time.Minute)
+		done <- result{code: code, err: err}
+	}()
+
+	// Wait for long-poll to subscribe.
+	a.Eventually(func() bool {
+		m.notifier.mux.Lock()
+		defer m.notifier.mux.Unlock()
+		return len(m.notifier.subs[phone]) > 0
+	}, time.Second*5, time.Millisecond*10)
+
+	// Code is delivered in-process even if publishing to Postgres fails.
+	a.NoError(acc.setCode(ctx, "12345"))
+
+	select {
+	case r := <-done:
+		a.NoError(r.err)
+		a.Equal("12345", r.code)
+	case <-ctx.Done():
+		t.Fatal("code is not received")
+	}
+}
+
+func TestManager_Relogin(t *testing.T) {
+	a := require.New(t)
+	ctx := context.Background()
+	db := newTestDB(t)
+	const phone = "71234567890"
+	createTestAccount(t, db, phone)
+
+	m, err := NewManager(zaptest.NewLogger(t), db, metricnoop.NewMeterProvider(), tracenoop.NewTracerProvider(), Options{})
+	a.NoError(err)
+
+	// Runner stores session on shutdown, like client does.
+	runCtx, cancel := context.WithCancel(ctx)
+	r := &runner{cancel: cancel, done: make(chan struct{})}
+	m.runners[phone] = r
+	go func() {
+		<-runCtx.Done()
+		a.NoError(db.TelegramAccount.UpdateOneID(phone).
+			SetSessionData([]byte("old")).
+			Exec(ctx))
+
+		m.mux.Lock()
+		delete(m.runners, phone)
+		m.mux.Unlock()
+		close(r.done)
+	}()
+
+	info, err := m.Relogin(ctx, phone)
+	a.NoError(err)
+	a.False(info.Running)
+	a.Equal(telegramaccount.StateNew, info.State)
+
+	got, err := db.TelegramAccount.Get(ctx, phone)
+	a.NoError(err)
+	a.True(got.SessionData == nil || len(*got.SessionData) == 0, "session should be dropped")
+	a.Empty(m.relogins)
+}
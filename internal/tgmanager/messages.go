@@ -0,0 +1,136 @@
+package tgmanager
+
+import (
+	"context"
+	"regexp"
+	"time"
+
+	"github.com/go-faster/errors"
+	"github.com/google/uuid"
+	"github.com/gotd/td/tg"
+	"go.uber.org/zap"
+
+	"github.com/gotd/bot/internal/ent"
+	"github.com/gotd/bot/internal/ent/telegramaccount"
+	"github.com/gotd/bot/internal/ent/telegramservicemessage"
+)
+
+var (
+	// frozenRe matches notice about account restriction, e.g. "Your account
+	// was frozen for violating Telegram's Terms of Service". Only line starting
+	// with this phrase is matched, so mentions of limits do not disable account.
+	frozenRe   = regexp.MustCompile(`(?im)^\W*your account (?:was|has been|is|is now) (?:frozen|suspended|limited)(?:[\s.,!:;]|$)`)
+	newLoginRe = regexp.MustCompile(`(?i)\bnew login\b|\bdetected a login\b`)
+	emailRe    = regexp.MustCompile(`(?i)\be-?mail\b`)
+	codeRe     = regexp.MustCompile(`(?i)\bcode\b`)
+)
+
+// parseServiceMessage detects type of service message and extracts login
+// code, if any.
+func parseServiceMessage(text string) (telegramservicemessage.Type, string) {
+	switch {
+	case frozenRe.MatchString(text):
+		return telegramservicemessage.TypeFrozen, ""
+	case newLoginRe.MatchString(text):
+		return telegramservicemessage.TypeNewLogin, ""
+	case emailRe.MatchString(text):
+		return telegramservicemessage.TypeEmailVerification, ""
+	case codeRe.MatchString(text):
+		if code := extractCode(text); code != "" {
+			return telegramservicemessage.TypeCode, code
+		}
+	}
+	return telegramservicemessage.TypeOther, ""
+}
+
+// onServiceMessage saves service message and handles it.
+func (a *Account) onServiceMessage(ctx context.Context, msg *tg.Message) error {
+	typ, code := parseServiceMessage(msg.Message)
+	a.lg.Info("Service message", zap.Stringer("type", typ))
+
+	if err := a.db.TelegramServiceMessage.Create().
+		SetAccountID(a.number).
+		SetMessageID(msg.ID).
+		SetType(typ).
+		SetText(msg.Message).
+		SetDate(time.Unix(int64(msg.Date), 0)).
+		OnConflictColumns(
+			telegramservicemessage.FieldAccountID,
+			telegramservicemessage.FieldMessageID,
+		).
+		Ignore().
+		Exec(ctx); err != nil {
+		// Message is saved only for history, so code is still delivered.
+		a.lg.Error("Save service message", zap.Error(err))
+	}
+
+	switch typ {
+	case telegramservicemessage.TypeCode:
+		return a.setCode(ctx, code)
+	case telegramservicemessage.TypeFrozen:
+		// Do not hand out accounts restricted by Telegram.
+		a.lg.Warn("Account flagged by Telegram", zap.String("message", msg.Message))
+		if err := a.db.TelegramAccount.UpdateOneID(a.number).
+			SetDisabled(true).
+			SetState(telegramaccount.StateError).
+			SetStatus("Flagged by Telegram, see service messages").
+			Exec(ctx); err != nil {
+			return errors.Wrap(err, "update account")
+		}
+	}
+	return nil
+}
+
+func (a *Account) setCode(ctx context.Context, code string) error {
+	if err := a.db.TelegramAccount.UpdateOneID(a.number).
+		SetCode(code).
+		SetCodeAt(time.Now()).
+		SetStatus("New code received").
+		Exec(ctx); err != nil {
+		return errors.Wrap(err, "update account")
+	}
+	a.lg.Info("Code updated to",
+		zap.String("code", code),
+	)
+	if err := a.notify.Notify(ctx, a.number); err != nil {
+		a.lg.Warn("Notify about code", zap.Error(err))
+	}
+	return nil
+}
+
+// LeaseMessages returns service messages received by leased account since
+// lease start.
+func (m *Manager) LeaseMessages(ctx context.Context, token uuid.UUID, holder string) ([]*ent.TelegramServiceMessage, error) {
+	m.mux.Lock()
+	lease, err := m.lease(token, holder)
+	m.mux.Unlock()
+	if err != nil {
+		return nil, err
+	}
+
+	msgs, err := m.db.TelegramServiceMessage.Query().
+		Where(
+			telegramservicemessage.AccountID(lease.Account),
+			// Message date has second precision.
+			telegramservicemessage.DateGTE(lease.Start.Truncate(time.Second)),
+		).
+		Order(telegramservicemessage.ByDate()).
+		All(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "query messages")
+	}
+	return msgs, nil
+}
+
+// AccountMessages returns last service messages of account, newest first.
+func (m *Manager) AccountMessages(ctx context.Context, phone string, limit int) ([]*ent.TelegramServiceMessage, error) {
+	msgs, err := m.db.TelegramServiceMessage.Query().
+		Where(telegramservicemessage.AccountID(phone)).
+		Order(ent.Desc(telegramservicemessage.FieldDate)).
+		Limit(limit).
+		All(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "query messages")
+	}
+	return msgs, nil
+}
@@ -0,0 +1,149 @@
+package tgmanager
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/cenkalti/backoff/v4"
+	"github.com/go-faster/errors"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"go.uber.org/zap"
+)
+
+// Notifier notifies waiters about new login codes of accounts.
+type Notifier struct {
+	mux  sync.Mutex
+	subs map[string]map[chan struct{}]struct{}
+
+	// publish sends notification to other replicas, optional.
+	publish func(ctx context.Context, phone string) error
+}
+
+// NewNotifier creates new in-process Notifier.
+func NewNotifier() *Notifier {
+	return &Notifier{
+		subs: make(map[string]map[chan struct{}]struct{}),
+	}
+}
+
+// Subscribe subscribes to code notifications of account.
+//
+// Returned function must be called to unsubscribe.
+func (n *Notifier) Subscribe(phone string) (<-chan struct{}, func()) {
+	ch := make(chan struct{}, 1)
+
+	n.mux.Lock()
+	defer n.mux.Unlock()
+	subs, ok := n.subs[phone]
+	if !ok {
+		subs = make(map[chan struct{}]struct{})
+		n.subs[phone] = subs
+	}
+	subs[ch] = struct{}{}
+
+	return ch, func() {
+		n.mux.Lock()
+		defer n.mux.Unlock()
+		delete(subs, ch)
+		if len(subs) == 0 {
+			delete(n.subs, phone)
+		}
+	}
+}
+
+// Notify notifies subscribers that account received new code.
+func (n *Notifier) Notify(ctx context.Context, phone string) error {
+	n.broadcast(phone)
+	if n.publish == nil {
+		return nil
+	}
+	if err := n.publish(ctx, phone); err != nil {
+		return errors.Wrap(err, "publish")
+	}
+	return nil
+}
+
+func (n *Notifier) broadcast(phone string) {
+	n.mux.Lock()
+	defer n.mux.Unlock()
+	for ch := range n.subs[phone] {
+		select {
+		case ch <- struct{}{}:
+		default:
+			// Already notified.
+		}
+	}
+}
+
+// postgresChannel is Postgres LISTEN/NOTIFY channel for code notifications.
+const postgresChannel = "telegram_account_code"
+
+// PostgresRelay relays code notifications between replicas using
+// Postgres LISTEN/NOTIFY.
+type PostgresRelay struct {
+	pool     *pgxpool.Pool
+	notifier *Notifier
+	log      *zap.Logger
+}
+
+// NewPostgresRelay creates new PostgresRelay and attaches it to notifier.
+func NewPostgresRelay(log *zap.Logger, pool *pgxpool.Pool, notifier *Notifier) *PostgresRelay {
+	notifier.publish = func(ctx context.Context, phone string) error {
+		_, err := pool.Exec(ctx, "SELECT pg_notify($1, $2)", postgresChannel, phone)
+		return err
+	}
+	return &PostgresRelay{
+		pool:     pool,
+		notifier: notifier,
+		log:      log,
+	}
+}
+
+func (r *PostgresRelay) listen(ctx context.Context) error {
+	conn, err := r.pool.Acquire(ctx)
+	if err != nil {
+		return errors.Wrap(err, "acquire")
+	}
+	defer conn.Release()
+
+	if _, err := conn.Exec(ctx, "LISTEN "+postgresChannel); err != nil {
+		return errors.Wrap(err, "listen")
+	}
+	r.log.Info("Listening for code notifications")
+
+	for {
+		n, err := conn.Conn().WaitForNotification(ctx)
+		if err != nil {
+			return errors.Wrap(err, "wait")
+		}
+		r.notifier.broadcast(n.Payload)
+	}
+}
+
+// Run listens for notifications from other replicas until ctx is done.
+func (r *PostgresRelay) Run(ctx context.Context) error {
+	bo := backoff.NewExponentialBackOff()
+	bo.MaxInterval = time.Minute
+	bo.MaxElapsedTime = 0
+	for {
+		start := time.Now()
+		err := r.listen(ctx)
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		if time.Since(start) > time.Minute {
+			bo.Reset()
+		}
+		delay := bo.NextBackOff()
+		r.log.Error("Listen failed", zap.Error(err), zap.Duration("retry_in", delay))
+
+		timer := time.NewTimer(delay)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return ctx.Err()
+		case <-timer.C:
+		}
+	}
+}
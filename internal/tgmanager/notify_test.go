@@ -0,0 +1,28 @@
+package tgmanager
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestNotifier(t *testing.T) {
+	a := require.New(t)
+	ctx := context.Background()
+	n := NewNotifier()
+
+	ch, unsubscribe := n.Subscribe("71234567890")
+	other, unsubscribeOther := n.Subscribe("71234567891")
+	defer unsubscribeOther()
+
+	a.NoError(n.Notify(ctx, "71234567890"))
+	// Notifications are coalesced.
+	a.NoError(n.Notify(ctx, "71234567890"))
+	a.Len(ch, 1)
+	a.Empty(other)
+
+	unsubscribe()
+	a.NotContains(n.subs, "71234567890")
+	a.Contains(n.subs, "71234567891")
+}
@@ -0,0 +1,25 @@
+package tgmanager
+
+import (
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/gotd/bot/internal/secret"
+)
+
+// Options is Manager options.
+type Options struct {
+	// Secret is used to encrypt and decrypt account passwords and sessions.
+	//
+	// If nil, sessions are stored unencrypted and accounts with cloud
+	// password are not supported.
+	Secret *secret.Box
+	// Postgres is used to relay code notifications between replicas
+	// using LISTEN/NOTIFY.
+	//
+	// If nil, notifications are delivered only within the process.
+	Postgres *pgxpool.Pool
+	// WorkflowRuns is used to release leases of completed workflow runs.
+	//
+	// If nil, leases are released only by holder or on expiration.
+	WorkflowRuns WorkflowRuns
+}
@@ -0,0 +1,52 @@
+package tgmanager
+
+import (
+	"context"
+
+	"github.com/go-faster/errors"
+	"go.uber.org/zap"
+
+	"github.com/gotd/bot/internal/ent"
+	"github.com/gotd/bot/internal/secret"
+)
+
+// Reencrypt re-encrypts sessions and passwords of all accounts with primary
+// key of box.
+//
+// Plaintext sessions are encrypted, secrets encrypted with previous keys
+// are decrypted and encrypted again. Returns count of updated accounts.
+func Reencrypt(ctx context.Context, lg *zap.Logger, db *ent.Client, box *secret.Box) (int, error) {
+	accounts, err := db.TelegramAccount.Query().All(ctx)
+	if err != nil {
+		return 0, errors.Wrap(err, "query accounts")
+	}
+
+	var updated int
+	for _, acc := range accounts {
+		rotated, err := secret.NewSessionStorage(SessionStorage{db: db, id: acc.ID}, box).Rotate(ctx)
+		if err != nil {
+			return updated, errors.Wrapf(err, "rotate session of %s", acc.ID)
+		}
+		if acc.Password != nil && len(*acc.Password) > 0 && !box.Primary(*acc.Password) {
+			password, err := box.Open(*acc.Password)
+			if err != nil {
+				return updated, errors.Wrapf(err, "decrypt password of %s", acc.ID)
+			}
+			sealed, err := box.Seal(password)
+			if err != nil {
+				return updated, errors.Wrapf(err, "encrypt password of %s", acc.ID)
+			}
+			if err := db.TelegramAccount.UpdateOneID(acc.ID).
+				SetPassword(sealed).
+				Exec(ctx); err != nil {
+				return updated, errors.Wrapf(err, "update password of %s", acc.ID)
+			}
+			rotated = true
+		}
+		if rotated {
+			lg.Info("Re-encrypted account", zap.String("phone", acc.ID))
+			updated++
+		}
+	}
+	return updated, nil
+}
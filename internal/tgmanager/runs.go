@@ -0,0 +1,89 @@
+package tgmanager
+
+import (
+	"context"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+// WorkflowRun identifies GitHub workflow run that holds lease.
+type WorkflowRun struct {
+	Owner string
+	Repo  string
+	ID    int64
+}
+
+// WorkflowRuns reports status of GitHub workflow runs.
+type WorkflowRuns interface {
+	// Completed reports whether workflow run is completed.
+	Completed(ctx context.Context, run WorkflowRun) (bool, error)
+}
+
+const (
+	// runsPollInterval is interval of polling status of workflow runs that
+	// hold leases.
+	runsPollInterval = time.Second * 30
+	// runsPollTimeout limits single workflow run status check.
+	runsPollTimeout = time.Second * 10
+)
+
+// tickRuns releases leases of completed workflow runs.
+func (m *Manager) tickRuns(ctx context.Context) {
+	m.mux.Lock()
+	runs := make(map[WorkflowRun]struct{})
+	for _, lease := range m.leases {
+		if lease.Run != nil {
+			runs[*lease.Run] = struct{}{}
+		}
+	}
+	m.mux.Unlock()
+
+	for run := range runs {
+		lg := m.log.With(
+			zap.String("repo", run.Owner+"/"+run.Repo),
+			zap.Int64("run_id", run.ID),
+		)
+		checkCtx, cancel := context.WithTimeout(ctx, runsPollTimeout)
+		completed, err := m.runs.Completed(checkCtx, run)
+		cancel()
+		if err != nil {
+			lg.Warn("Failed to check workflow run", zap.Error(err))
+			continue
+		}
+		if completed {
+			m.releaseRun(lg, run)
+		}
+	}
+}
+
+// releaseRun removes all leases held by workflow run.
+func (m *Manager) releaseRun(lg *zap.Logger, run WorkflowRun) {
+	m.mux.Lock()
+	defer m.mux.Unlock()
+
+	for phone, lease := range m.leases {
+		if lease.Run == nil || *lease.Run != run {
+			continue
+		}
+		lg.Info("Workflow run completed, releasing lease",
+			zap.String("phone", phone),
+			zap.Stringer("token", lease.Token),
+		)
+		m.removeLease(lease)
+	}
+}
+
+// watchRuns periodically releases leases of completed workflow runs.
+func (m *Manager) watchRuns(ctx context.Context) error {
+	ticker := time.NewTicker(runsPollInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+			m.tickRuns(ctx)
+		}
+	}
+}
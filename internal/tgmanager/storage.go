@@ -7,6 +7,7 @@ import (
 	"github.com/gotd/td/session"
 
 	"github.com/gotd/bot/internal/ent"
+	"github.com/gotd/bot/internal/secret"
 )
 
 type SessionStorage struct {
@@ -14,6 +15,16 @@ type SessionStorage struct {
 	id string
 }
 
+// newSessionStorage returns session storage of account, encrypted if box
+// is set.
+func newSessionStorage(db *ent.Client, box *secret.Box, id string) session.Storage {
+	s := SessionStorage{db: db, id: id}
+	if box == nil {
+		return s
+	}
+	return secret.NewSessionStorage(s, box)
+}
+
 func (s SessionStorage) LoadSession(ctx context.Context) ([]byte, error) {
 	acc, err := s.db.TelegramAccount.Get(ctx, s.id)
 	if err != nil {
@@ -0,0 +1,129 @@
+package tgmanager
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/cenkalti/backoff/v4"
+	"github.com/go-faster/errors"
+	"go.uber.org/zap"
+)
+
+const (
+	// runnerMaxBackoff is maximum delay between account runner restarts.
+	runnerMaxBackoff = time.Minute * 5
+	// runnerResetAfter is duration of successful run after which backoff is reset.
+	runnerResetAfter = time.Minute * 5
+)
+
+// runner is supervised account runner.
+type runner struct {
+	account *Account
+	cancel  context.CancelFunc
+	// done is closed when runner exits and is removed from m.runners.
+	done chan struct{}
+}
+
+// start starts supervised runner of account.
+//
+// Must be called with m.mux held.
+func (m *Manager) start(ctx context.Context, a *Account) {
+	ctx, cancel := context.WithCancel(ctx)
+	r := &runner{
+		account: a,
+		cancel:  cancel,
+		done:    make(chan struct{}),
+	}
+	m.runners[a.number] = r
+
+	go func() {
+		defer func() {
+			cancel()
+
+			m.mux.Lock()
+			delete(m.runners, a.number)
+			m.mux.Unlock()
+			close(r.done)
+		}()
+		newSupervisor(a).Run(ctx)
+	}()
+}
+
+// supervisor runs account until ctx is done, restarting it with backoff on
+// failure.
+type supervisor struct {
+	lg       *zap.Logger
+	run      func(ctx context.Context) error
+	setError func(ctx context.Context, status string) error
+	backoff  backoff.BackOff
+}
+
+func newSupervisor(a *Account) supervisor {
+	bo := backoff.NewExponentialBackOff()
+	bo.MaxInterval = runnerMaxBackoff
+	bo.MaxElapsedTime = 0
+	return supervisor{
+		lg:       a.lg,
+		run:      a.Run,
+		setError: a.setError,
+		backoff:  bo,
+	}
+}
+
+// Run runs account until ctx is done or account fails permanently.
+func (s supervisor) Run(ctx context.Context) {
+	lg := s.lg
+	lg.Info("Starting account runner")
+	defer lg.Info("Account runner stopped")
+
+	s.backoff.Reset()
+	for {
+		start := time.Now()
+		err := s.run(ctx)
+		if ctx.Err() != nil {
+			return
+		}
+		if errors.Is(err, errAccountDeactivated) || errors.Is(err, errSignUpForbidden) {
+			lg.Error("Account disabled, not restarting", zap.Error(err))
+			return
+		}
+		if err == nil {
+			err = errors.New("runner stopped unexpectedly")
+		}
+		if time.Since(start) > runnerResetAfter {
+			s.backoff.Reset()
+		}
+
+		delay := s.backoff.NextBackOff()
+		lg.Error("Account run failed",
+			zap.Error(err),
+			zap.Duration("retry_in", delay),
+		)
+		status := fmt.Sprintf("Failed: %s (retry in %s)", err, delay.Round(time.Second))
+		if err := s.setError(ctx, status); err != nil {
+			lg.Error("Update account state", zap.Error(err))
+		}
+
+		timer := time.NewTimer(delay)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return
+		case <-timer.C:
+		}
+	}
+}
+
+// stop stops account runner and removes its lease.
+//
+// Runner removes itself from m.runners on exit.
+// Must be called with m.mux held.
+func (m *Manager) stop(phone string) {
+	if lease, ok := m.leases[phone]; ok {
+		m.removeLease(lease)
+	}
+	if r, ok := m.runners[phone]; ok {
+		r.cancel()
+	}
+}
@@ -0,0 +1,106 @@
+package tgmanager
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/cenkalti/backoff/v4"
+	"github.com/go-faster/errors"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+)
+
+// recordBackOff records returned delays.
+type recordBackOff struct {
+	backoff.BackOff
+	delays []time.Duration
+}
+
+func (r *recordBackOff) NextBackOff() time.Duration {
+	d := r.BackOff.NextBackOff()
+	r.delays = append(r.delays, d)
+	return d
+}
+
+func newTestSupervisor(t *testing.T, run func(ctx context.Context) error) (*supervisor, *recordBackOff, *[]string) {
+	bo := backoff.NewExponentialBackOff()
+	bo.InitialInterval = time.Millisecond
+	bo.RandomizationFactor = 0
+	bo.Multiplier = 2
+	bo.MaxElapsedTime = 0
+	rec := &recordBackOff{BackOff: bo}
+
+	var statuses []string
+	return &supervisor{
+		lg:  zaptest.NewLogger(t),
+		run: run,
+		setError: func(ctx context.Context, status string) error {
+			statuses = append(statuses, status)
+			return nil
+		},
+		backoff: rec,
+	}, rec, &statuses
+}
+
+func TestSupervisor_Restart(t *testing.T) {
+	for _, permanent := range []error{
+		errAccountDeactivated,
+		errSignUpForbidden,
+	} {
+		t.Run(permanent.Error(), func(t *testing.T) {
+			a := require.New(t)
+
+			calls := 0
+			s, bo, statuses := newTestSupervisor(t, func(ctx context.Context) error {
+				calls++
+				if calls > 3 {
+					return errors.Wrap(permanent, "run")
+				}
+				return errors.New("failed")
+			})
+			s.Run(context.Background())
+
+			a.Equal(4, calls)
+			a.Equal([]time.Duration{
+				time.Millisecond,
+				2 * time.Millisecond,
+				4 * time.Millisecond,
+			}, bo.delays)
+			a.Len(*statuses, 3)
+			a.Contains((*statuses)[0], "Failed: failed")
+		})
+	}
+}
+
+func TestSupervisor_Cancel(t *testing.T) {
+	a := require.New(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	calls := 0
+	s, bo, _ := newTestSupervisor(t, func(ctx context.Context) error {
+		calls++
+		if calls == 2 {
+			cancel()
+			<-ctx.Done()
+			return ctx.Err()
+		}
+		return nil
+	})
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		s.Run(ctx)
+	}()
+	select {
+	case <-done:
+	case <-time.After(time.Second * 5):
+		t.Fatal("supervisor is not stopped")
+	}
+
+	a.Equal(2, calls)
+	// Runner exited without error is restarted too.
+	a.Len(bo.delays, 1)
+}
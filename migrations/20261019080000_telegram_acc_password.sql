@@ -0,0 +1,2 @@
+-- Modify "telegram_accounts" table
+ALTER TABLE "telegram_accounts" ADD COLUMN "password" bytea NULL;
@@ -0,0 +1,6 @@
+-- Create "telegram_service_messages" table
+CREATE TABLE "telegram_service_messages" ("id" bigint NOT NULL GENERATED BY DEFAULT AS IDENTITY, "message_id" bigint NOT NULL, "type" character varying NOT NULL, "text" character varying NOT NULL, "date" timestamptz NOT NULL, "account_id" character varying NOT NULL, PRIMARY KEY ("id"), CONSTRAINT "telegram_service_messages_telegram_accounts_service_messages" FOREIGN KEY ("account_id") REFERENCES "telegram_accounts" ("id") ON UPDATE NO ACTION ON DELETE CASCADE);
+-- Create index "telegramservicemessage_account_id_date" to table: "telegram_service_messages"
+CREATE INDEX "telegramservicemessage_account_id_date" ON "telegram_service_messages" ("account_id", "date");
+-- Create index "telegramservicemessage_account_id_message_id" to table: "telegram_service_messages"
+CREATE UNIQUE INDEX "telegramservicemessage_account_id_message_id" ON "telegram_service_messages" ("account_id", "message_id");
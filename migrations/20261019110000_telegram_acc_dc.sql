@@ -0,0 +1,2 @@
+-- Modify "telegram_accounts" table
+ALTER TABLE "telegram_accounts" ADD COLUMN "dc_list" character varying NOT NULL DEFAULT 'Test', ADD COLUMN "dc" bigint NULL, ADD COLUMN "app_id" bigint NULL, ADD COLUMN "app_hash" character varying NULL;
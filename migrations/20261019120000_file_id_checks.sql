@@ -0,0 +1,6 @@
+-- Create "file_id_checks" table
+CREATE TABLE "file_id_checks" ("id" bigint NOT NULL GENERATED BY DEFAULT AS IDENTITY, "type" character varying NOT NULL, "dc" bigint NOT NULL, "file_id" character varying NULL, "bot_api_file_id" character varying NULL, "result" character varying NOT NULL, "error" character varying NULL, "td_version" character varying NOT NULL, "created_at" timestamptz NOT NULL, PRIMARY KEY ("id"));
+-- Create index "fileidcheck_type_created_at" to table: "file_id_checks"
+CREATE INDEX "fileidcheck_type_created_at" ON "file_id_checks" ("type", "created_at");
+-- Create index "fileidcheck_type_td_version" to table: "file_id_checks"
+CREATE INDEX "fileidcheck_type_td_version" ON "file_id_checks" ("type", "td_version");
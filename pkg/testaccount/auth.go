@@ -0,0 +1,68 @@
+package testaccount
+
+import (
+	"context"
+	"time"
+
+	"github.com/cenkalti/backoff/v4"
+	"github.com/go-faster/errors"
+	"github.com/gotd/td/telegram/auth"
+	"github.com/gotd/td/tg"
+
+	"github.com/gotd/bot/internal/oas"
+)
+
+const (
+	// codeTimeout limits waiting for login code.
+	codeTimeout = time.Minute * 2
+	// codeWait is server-side long polling duration, in seconds.
+	codeWait = 30
+)
+
+// Authenticator returns auth.UserAuthenticator that receives login code of
+// leased account from pool.
+//
+// Sign up is not supported, accounts in pool are already registered.
+func (l *Lease) Authenticator() auth.UserAuthenticator {
+	return codeAuth{lease: l}
+}
+
+type codeAuth struct {
+	lease *Lease
+}
+
+func (codeAuth) SignUp(ctx context.Context) (auth.UserInfo, error) {
+	return auth.UserInfo{}, errors.New("sign up is not supported")
+}
+
+func (codeAuth) AcceptTermsOfService(ctx context.Context, tos tg.HelpTermsOfService) error {
+	return &auth.SignUpRequired{TermsOfService: tos}
+}
+
+func (a codeAuth) Code(ctx context.Context, sentCode *tg.AuthSentCode) (string, error) {
+	bo := backoff.NewExponentialBackOff()
+	bo.MaxElapsedTime = codeTimeout
+	bo.MaxInterval = time.Second * 5
+
+	return backoff.RetryWithData(func() (string, error) {
+		res, err := a.lease.client.api.ReceiveTelegramCode(ctx, oas.ReceiveTelegramCodeParams{
+			Token:   a.lease.Token,
+			Timeout: oas.NewOptInt(codeWait),
+		})
+		if err != nil {
+			return "", err
+		}
+		if res.Code.Value == "" {
+			return "", errors.New("no code")
+		}
+		return res.Code.Value, nil
+	}, backoff.WithContext(bo, ctx))
+}
+
+func (a codeAuth) Phone(_ context.Context) (string, error) {
+	return a.lease.Phone, nil
+}
+
+func (codeAuth) Password(_ context.Context) (string, error) {
+	return "", auth.ErrPasswordNotProvided
+}
@@ -0,0 +1,100 @@
+package testaccount
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+
+	"github.com/go-faster/errors"
+	"github.com/google/uuid"
+
+	"github.com/gotd/bot/internal/oas"
+)
+
+// Environment variables used to pass lease acquired by testaccount command
+// to wrapped command.
+const (
+	// EnvServer is URL of bot API.
+	EnvServer = "TESTACCOUNT_SERVER"
+	// EnvPhone is phone number of leased account.
+	EnvPhone = "TESTACCOUNT_PHONE"
+	// EnvToken is lease token.
+	EnvToken = "TESTACCOUNT_TOKEN"
+	// EnvSession is path to file with pre-authorized session, if requested.
+	EnvSession = "TESTACCOUNT_SESSION"
+)
+
+// Environ returns environment variables which pass lease to child process,
+// so Acquire in child process returns this lease instead of acquiring new
+// one.
+//
+// Pre-authorized session, if any, is written to file in dir.
+func (l *Lease) Environ(dir string) ([]string, error) {
+	env := []string{
+		EnvServer + "=" + l.client.opts.Server,
+		EnvPhone + "=" + l.Phone,
+		EnvToken + "=" + l.Token.String(),
+	}
+	if l.session == nil {
+		return env, nil
+	}
+
+	data, err := json.Marshal(l.session)
+	if err != nil {
+		return nil, errors.Wrap(err, "encode session")
+	}
+	name := filepath.Join(dir, "session.json")
+	if err := os.WriteFile(name, data, 0o600); err != nil {
+		return nil, errors.Wrap(err, "write session")
+	}
+	return append(env, EnvSession+"="+name), nil
+}
+
+// attach returns lease passed by parent process via environment, if any.
+//
+// Parent process sends heartbeats and releases the lease.
+func (c *Client) attach() (*Lease, bool, error) {
+	phone, token := os.Getenv(EnvPhone), os.Getenv(EnvToken)
+	if phone == "" || token == "" {
+		return nil, false, nil
+	}
+	id, err := uuid.Parse(token)
+	if err != nil {
+		return nil, false, errors.Wrap(err, "parse token")
+	}
+
+	done := make(chan struct{})
+	l := &Lease{
+		Phone:  phone,
+		Token:  id,
+		client: c,
+		held:   true,
+		cancel: func() { close(done) },
+		done:   done,
+	}
+	if name := os.Getenv(EnvSession); name != "" {
+		data, err := os.ReadFile(name)
+		if err != nil {
+			return nil, false, errors.Wrap(err, "read session")
+		}
+		var s oas.TelegramSession
+		if err := json.Unmarshal(data, &s); err != nil {
+			return nil, false, errors.Wrap(err, "decode session")
+		}
+		l.session = &s
+	}
+	return l, true, nil
+}
+
+// acquire returns lease passed by parent process or acquires new one.
+func (c *Client) acquire(ctx context.Context) (*Lease, error) {
+	l, ok, err := c.attach()
+	if err != nil {
+		return nil, errors.Wrap(err, "attach")
+	}
+	if ok {
+		return l, nil
+	}
+	return c.Acquire(ctx)
+}
@@ -0,0 +1,151 @@
+package testaccount
+
+import (
+	"context"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/go-faster/errors"
+	"github.com/google/uuid"
+	"github.com/gotd/td/session"
+	"go.uber.org/zap"
+
+	"github.com/gotd/bot/internal/oas"
+)
+
+// ErrLeaseLost means that lease is expired on server or heartbeats failed
+// for too long.
+var ErrLeaseLost = errors.New("lease lost")
+
+// leaseTTL is server-side lease TTL without heartbeat.
+const leaseTTL = time.Second * 15
+
+// Lease of test account.
+type Lease struct {
+	// Phone number of leased account, without +.
+	Phone string
+	// Token of lease.
+	Token uuid.UUID
+
+	client  *Client
+	session *oas.TelegramSession
+	// held is true if lease is held by parent process, which sends
+	// heartbeats and releases it.
+	held bool
+
+	cancel  context.CancelFunc
+	done    chan struct{}
+	mux     sync.Mutex
+	err     error
+	release sync.Once
+}
+
+func newLease(c *Client, res *oas.AcquireTelegramAccountOK) *Lease {
+	ctx, cancel := context.WithCancel(context.Background())
+	l := &Lease{
+		Phone:  string(res.AccountID),
+		Token:  res.Token,
+		client: c,
+		cancel: cancel,
+		done:   make(chan struct{}),
+	}
+	if s, ok := res.Session.Get(); ok {
+		l.session = &s
+	}
+	go l.heartbeat(ctx)
+	return l
+}
+
+func (l *Lease) heartbeat(ctx context.Context) {
+	defer close(l.done)
+
+	lg := l.client.opts.Logger
+	ticker := time.NewTicker(l.client.opts.HeartbeatInterval)
+	defer ticker.Stop()
+
+	lastSuccess := time.Now()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		if err := l.client.api.HeartbeatTelegramAccount(ctx, oas.HeartbeatTelegramAccountParams{
+			Token: l.Token,
+		}); err != nil {
+			if ctx.Err() != nil {
+				return
+			}
+			lg.Warn("Heartbeat failed", zap.Error(err))
+			if statusCode(err) == http.StatusNotFound || time.Since(lastSuccess) > leaseTTL {
+				l.setErr(errors.Wrap(ErrLeaseLost, err.Error()))
+				return
+			}
+			continue
+		}
+		lastSuccess = time.Now()
+	}
+}
+
+func (l *Lease) setErr(err error) {
+	l.mux.Lock()
+	defer l.mux.Unlock()
+	l.err = err
+}
+
+// Done is closed when heartbeats are stopped, either by Release or because
+// lease is lost.
+func (l *Lease) Done() <-chan struct{} {
+	return l.done
+}
+
+// Err returns ErrLeaseLost if heartbeats failed for too long.
+func (l *Lease) Err() error {
+	l.mux.Lock()
+	defer l.mux.Unlock()
+	return l.err
+}
+
+// SessionStorage returns session storage to use with leased account.
+//
+// If pre-authorized session was requested, storage contains it, so auth flow
+// is not needed. Otherwise, storage is empty.
+func (l *Lease) SessionStorage() session.Storage {
+	storage := new(session.StorageMemory)
+	if l.session == nil {
+		return storage
+	}
+	// Saving to memory storage never fails.
+	_ = (&session.Loader{Storage: storage}).Save(context.Background(), &session.Data{
+		DC:        l.session.DC,
+		Addr:      l.session.Addr,
+		AuthKey:   l.session.AuthKey,
+		AuthKeyID: l.session.AuthKeyID,
+		Salt:      l.session.Salt,
+	})
+	return storage
+}
+
+// Release stops heartbeats and returns account to the pool.
+//
+// Lease held by parent process is released by parent.
+// Release is idempotent.
+func (l *Lease) Release(ctx context.Context) error {
+	var err error
+	l.release.Do(func() {
+		l.cancel()
+		<-l.done
+		if l.held {
+			return
+		}
+		if rerr := l.client.api.HeartbeatTelegramAccount(ctx, oas.HeartbeatTelegramAccountParams{
+			Token:  l.Token,
+			Forget: oas.NewOptBool(true),
+		}); rerr != nil {
+			err = errors.Wrap(rerr, "forget")
+		}
+	})
+	return err
+}
@@ -0,0 +1,87 @@
+package testaccount
+
+import (
+	"net/http"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+// Options of Client.
+//
+// Zero values are filled from GitHub Actions environment.
+type Options struct {
+	// Server is URL of bot API, TESTACCOUNT_SERVER or https://bot.gotd.dev
+	// by default.
+	Server string
+	// Token is GitHub token, GITHUB_TOKEN by default.
+	Token string
+
+	// RepoOwner and RepoName are taken from GITHUB_REPOSITORY by default.
+	RepoOwner string
+	RepoName  string
+	// RunID is GITHUB_RUN_ID by default.
+	RunID int64
+	// RunAttempt is GITHUB_RUN_ATTEMPT by default.
+	RunAttempt int
+	// Job is GITHUB_JOB by default.
+	Job string
+
+	// Session requests pre-authorized session, so auth flow is not needed.
+	Session bool
+	// Production requests account on production DC instead of test one.
+	Production bool
+
+	// HeartbeatInterval is interval between lease heartbeats.
+	HeartbeatInterval time.Duration
+	// AcquireTimeout limits waiting for free account.
+	AcquireTimeout time.Duration
+
+	HTTPClient *http.Client
+	Logger     *zap.Logger
+}
+
+const (
+	defaultServer            = "https://bot.gotd.dev"
+	defaultHeartbeatInterval = time.Second * 5
+	defaultAcquireTimeout    = time.Minute
+)
+
+func (o *Options) setDefaults() {
+	if o.Server == "" {
+		o.Server = os.Getenv(EnvServer)
+	}
+	if o.Server == "" {
+		o.Server = defaultServer
+	}
+	if o.Token == "" {
+		o.Token = os.Getenv("GITHUB_TOKEN")
+	}
+	if o.RepoOwner == "" && o.RepoName == "" {
+		o.RepoOwner, o.RepoName, _ = strings.Cut(os.Getenv("GITHUB_REPOSITORY"), "/")
+	}
+	if o.RunID == 0 {
+		o.RunID, _ = strconv.ParseInt(os.Getenv("GITHUB_RUN_ID"), 10, 64)
+	}
+	if o.RunAttempt == 0 {
+		o.RunAttempt, _ = strconv.Atoi(os.Getenv("GITHUB_RUN_ATTEMPT"))
+	}
+	if o.Job == "" {
+		o.Job = os.Getenv("GITHUB_JOB")
+	}
+	if o.HeartbeatInterval == 0 {
+		o.HeartbeatInterval = defaultHeartbeatInterval
+	}
+	if o.AcquireTimeout == 0 {
+		o.AcquireTimeout = defaultAcquireTimeout
+	}
+	if o.HTTPClient == nil {
+		o.HTTPClient = http.DefaultClient
+	}
+	if o.Logger == nil {
+		o.Logger = zap.NewNop()
+	}
+}
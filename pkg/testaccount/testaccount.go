@@ -0,0 +1,121 @@
+// Package testaccount implements client of gotd bot test account pool.
+//
+// Typical usage in tests:
+//
+//	lease, err := testaccount.Acquire(ctx)
+//	if err != nil {
+//		t.Fatal(err)
+//	}
+//	defer func() { _ = lease.Release(ctx) }()
+//
+//	client := telegram.NewClient(appID, appHash, telegram.Options{
+//		DCList:         dcs.Test(),
+//		SessionStorage: lease.SessionStorage(),
+//	})
+//	err = client.Run(ctx, func(ctx context.Context) error {
+//		return client.Auth().IfNecessary(ctx, auth.NewFlow(lease.Authenticator(), auth.SendCodeOptions{}))
+//	})
+package testaccount
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/cenkalti/backoff/v4"
+	"github.com/go-faster/errors"
+
+	"github.com/gotd/bot/internal/oas"
+)
+
+// Client of test account pool.
+type Client struct {
+	api  *oas.Client
+	opts Options
+}
+
+type securitySource struct {
+	token string
+}
+
+func (s securitySource) TokenAuth(ctx context.Context, operationName oas.OperationName) (oas.TokenAuth, error) {
+	return oas.TokenAuth{APIKey: s.token}, nil
+}
+
+func (s securitySource) AdminAuth(ctx context.Context, operationName oas.OperationName) (oas.AdminAuth, error) {
+	return oas.AdminAuth{}, errors.New("admin operations are not supported")
+}
+
+// NewClient creates new Client.
+func NewClient(opts Options) (*Client, error) {
+	opts.setDefaults()
+	if opts.Token == "" {
+		return nil, errors.New("no token provided")
+	}
+	api, err := oas.NewClient(opts.Server, securitySource{token: opts.Token},
+		oas.WithClient(opts.HTTPClient),
+	)
+	if err != nil {
+		return nil, errors.Wrap(err, "create client")
+	}
+	return &Client{api: api, opts: opts}, nil
+}
+
+// statusCode returns HTTP status code of API error, or 0.
+func statusCode(err error) int {
+	if e, ok := errors.Into[*oas.ErrorStatusCode](err); ok {
+		return e.StatusCode
+	}
+	return 0
+}
+
+// Acquire acquires test account using options from environment.
+//
+// If called by command wrapped with testaccount command (e.g. in GitHub
+// Action), returns lease of wrapper.
+func Acquire(ctx context.Context) (*Lease, error) {
+	c, err := NewClient(Options{})
+	if err != nil {
+		return nil, err
+	}
+	return c.acquire(ctx)
+}
+
+// Acquire acquires test account, retrying until AcquireTimeout if all
+// accounts are busy.
+//
+// Lease is kept alive by background heartbeats until Release is called.
+func (c *Client) Acquire(ctx context.Context) (*Lease, error) {
+	dcList := oas.TelegramDCListTest
+	if c.opts.Production {
+		dcList = oas.TelegramDCListProduction
+	}
+	req := &oas.AcquireTelegramAccountReq{
+		RepoOwner:  c.opts.RepoOwner,
+		RepoName:   c.opts.RepoName,
+		RunID:      c.opts.RunID,
+		Job:        c.opts.Job,
+		RunAttempt: c.opts.RunAttempt,
+		DCList:     oas.NewOptTelegramDCList(dcList),
+		Session:    oas.NewOptBool(c.opts.Session),
+	}
+
+	bo := backoff.NewExponentialBackOff()
+	bo.MaxElapsedTime = c.opts.AcquireTimeout
+	bo.MaxInterval = time.Second * 5
+	res, err := backoff.RetryNotifyWithData(func() (*oas.AcquireTelegramAccountOK, error) {
+		res, err := c.api.AcquireTelegramAccount(ctx, req)
+		if code := statusCode(err); code == http.StatusUnauthorized || code == http.StatusForbidden {
+			// Retrying will not help.
+			return nil, backoff.Permanent(err)
+		}
+		return res, err
+	}, backoff.WithContext(bo, ctx), func(err error, d time.Duration) {
+		c.opts.Logger.Sugar().Infof("Acquire failed: %v, retrying in %s", err, d)
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "acquire")
+	}
+
+	return newLease(c, res), nil
+}
@@ -0,0 +1,219 @@
+package testaccount
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/go-faster/errors"
+	"github.com/google/uuid"
+	"github.com/gotd/td/session"
+	"github.com/stretchr/testify/require"
+
+	"github.com/gotd/bot/internal/oas"
+)
+
+type fakePool struct {
+	oas.UnimplementedHandler
+
+	mux        sync.Mutex
+	token      uuid.UUID
+	req        *oas.AcquireTelegramAccountReq
+	heartbeats int
+	forgotten  bool
+}
+
+func (f *fakePool) AcquireTelegramAccount(ctx context.Context, req *oas.AcquireTelegramAccountReq) (*oas.AcquireTelegramAccountOK, error) {
+	f.mux.Lock()
+	defer f.mux.Unlock()
+
+	f.req = req
+	res := &oas.AcquireTelegramAccountOK{
+		AccountID: "71234567890",
+		Token:     f.token,
+	}
+	if req.Session.Value {
+		res.Session.SetTo(oas.TelegramSession{
+			DC:        2,
+			Addr:      "149.154.167.40:443",
+			AuthKey:   make([]byte, 256),
+			AuthKeyID: make([]byte, 8),
+		})
+	}
+	return res, nil
+}
+
+func (f *fakePool) HeartbeatTelegramAccount(ctx context.Context, params oas.HeartbeatTelegramAccountParams) error {
+	f.mux.Lock()
+	defer f.mux.Unlock()
+
+	if params.Token != f.token {
+		return &oas.ErrorStatusCode{
+			StatusCode: http.StatusNotFound,
+			Response:   oas.Error{ErrorMessage: "unknown token"},
+		}
+	}
+	if params.Forget.Value {
+		f.forgotten = true
+	} else {
+		f.heartbeats++
+	}
+	return nil
+}
+
+func (f *fakePool) ReceiveTelegramCode(ctx context.Context, params oas.ReceiveTelegramCodeParams) (*oas.ReceiveTelegramCodeOK, error) {
+	return &oas.ReceiveTelegramCodeOK{Code: oas.NewOptString("12345")}, nil
+}
+
+func (f *fakePool) HandleTokenAuth(ctx context.Context, operationName oas.OperationName, t oas.TokenAuth) (context.Context, error) {
+	if t.APIKey != "token" {
+		return nil, errors.New("invalid token")
+	}
+	return ctx, nil
+}
+
+func (f *fakePool) HandleAdminAuth(ctx context.Context, operationName oas.OperationName, t oas.AdminAuth) (context.Context, error) {
+	return nil, errors.New("not implemented")
+}
+
+func TestClient(t *testing.T) {
+	a := require.New(t)
+	ctx := context.Background()
+
+	pool := &fakePool{token: uuid.New()}
+	srv, err := oas.NewServer(pool, pool)
+	a.NoError(err)
+	s := httptest.NewServer(srv)
+	t.Cleanup(s.Close)
+
+	c, err := NewClient(Options{
+		Server:            s.URL,
+		Token:             "token",
+		RepoOwner:         "gotd",
+		RepoName:          "td",
+		Session:           true,
+		HeartbeatInterval: time.Millisecond * 10,
+	})
+	a.NoError(err)
+
+	lease, err := c.Acquire(ctx)
+	a.NoError(err)
+	a.Equal("71234567890", lease.Phone)
+	a.Equal(pool.token, lease.Token)
+	a.Equal("td", pool.req.RepoName)
+	a.Equal(oas.TelegramDCListTest, pool.req.DCList.Value)
+
+	data, err := (&session.Loader{Storage: lease.SessionStorage()}).Load(ctx)
+	a.NoError(err)
+	a.Equal(2, data.DC)
+
+	au := lease.Authenticator()
+	phone, err := au.Phone(ctx)
+	a.NoError(err)
+	a.Equal(lease.Phone, phone)
+	code, err := au.Code(ctx, nil)
+	a.NoError(err)
+	a.Equal("12345", code)
+
+	a.Eventually(func() bool {
+		pool.mux.Lock()
+		defer pool.mux.Unlock()
+		return pool.heartbeats > 0
+	}, time.Second, time.Millisecond*10)
+
+	a.NoError(lease.Release(ctx))
+	a.NoError(lease.Release(ctx))
+	a.True(pool.forgotten)
+	a.NoError(lease.Err())
+	select {
+	case <-lease.Done():
+	default:
+		t.Fatal("heartbeats are not stopped")
+	}
+}
+
+func TestLease_Lost(t *testing.T) {
+	a := require.New(t)
+	ctx := context.Background()
+
+	pool := &fakePool{token: uuid.New()}
+	srv, err := oas.NewServer(pool, pool)
+	a.NoError(err)
+	s := httptest.NewServer(srv)
+	t.Cleanup(s.Close)
+
+	c, err := NewClient(Options{
+		Server:            s.URL,
+		Token:             "token",
+		HeartbeatInterval: time.Millisecond * 10,
+	})
+	a.NoError(err)
+
+	lease, err := c.Acquire(ctx)
+	a.NoError(err)
+
+	// Server forgets lease.
+	pool.mux.Lock()
+	pool.token = uuid.New()
+	pool.mux.Unlock()
+
+	select {
+	case <-lease.Done():
+	case <-time.After(time.Second):
+		t.Fatal("lease is not lost")
+	}
+	a.ErrorIs(lease.Err(), ErrLeaseLost)
+}
+
+func TestLease_Environ(t *testing.T) {
+	a := require.New(t)
+	ctx := context.Background()
+
+	pool := &fakePool{token: uuid.New()}
+	srv, err := oas.NewServer(pool, pool)
+	a.NoError(err)
+	s := httptest.NewServer(srv)
+	t.Cleanup(s.Close)
+
+	c, err := NewClient(Options{
+		Server:  s.URL,
+		Token:   "token",
+		Session: true,
+	})
+	a.NoError(err)
+	lease, err := c.Acquire(ctx)
+	a.NoError(err)
+
+	env, err := lease.Environ(t.TempDir())
+	a.NoError(err)
+	a.Len(env, 4)
+
+	// Child process attaches to lease of parent.
+	t.Setenv("GITHUB_TOKEN", "token")
+	for _, v := range env {
+		k, v, _ := strings.Cut(v, "=")
+		t.Setenv(k, v)
+	}
+	child, err := Acquire(ctx)
+	a.NoError(err)
+	a.Equal(lease.Phone, child.Phone)
+	a.Equal(lease.Token, child.Token)
+
+	data, err := (&session.Loader{Storage: child.SessionStorage()}).Load(ctx)
+	a.NoError(err)
+	a.Equal(2, data.DC)
+
+	code, err := child.Authenticator().Code(ctx, nil)
+	a.NoError(err)
+	a.Equal("12345", code)
+
+	// Lease is released only by parent.
+	a.NoError(child.Release(ctx))
+	a.False(pool.forgotten)
+	a.NoError(lease.Release(ctx))
+	a.True(pool.forgotten)
+}